@@ -1,6 +1,8 @@
 package config
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
@@ -8,21 +10,30 @@ import (
 	"github.com/labstack/gommon/log"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 	"vnc-api/adapters/api/endpoints/middlewares"
 	"vnc-api/adapters/api/endpoints/router"
 	"vnc-api/adapters/api/utils"
+	"vnc-api/adapters/workers"
 )
 
 type Api interface {
 	Serve()
 	loadRoutes()
+	startWorkers()
+	stopWorkers()
+	shutdown()
 }
 
 type Options struct{}
 
 type api struct {
-	group        *echo.Group
-	echoInstance *echo.Echo
+	group         *echo.Group
+	echoInstance  *echo.Echo
+	workers       workers.Workers
+	cancelWorkers context.CancelFunc
 }
 
 func NewApi() Api {
@@ -35,7 +46,7 @@ func NewApi() Api {
 	}
 
 	echoInstance := echo.New()
-	return &api{echoInstance.Group("/api"), echoInstance}
+	return &api{group: echoInstance.Group("/api"), echoInstance: echoInstance}
 }
 
 func (instance *api) Serve() {
@@ -44,14 +55,49 @@ func (instance *api) Serve() {
 	instance.echoInstance.Use(instance.getCORSSettings())
 	instance.echoInstance.Use(middlewares.GuardMiddleware)
 	instance.loadRoutes()
+	instance.startWorkers()
 	address := getServerAddress()
-	instance.echoInstance.Logger.Fatal(instance.echoInstance.Start(address))
+	go func() {
+		err := instance.echoInstance.Start(address)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			instance.echoInstance.Logger.Fatal(err)
+		}
+	}()
+	instance.shutdown()
 }
 
 func (instance *api) loadRoutes() {
 	router.New().Load(instance.group)
 }
 
+func (instance *api) startWorkers() {
+	var workersContext context.Context
+	workersContext, instance.cancelWorkers = context.WithCancel(context.Background())
+	instance.workers = workers.New()
+	instance.workers.Start(workersContext)
+}
+
+func (instance *api) stopWorkers() {
+	instance.cancelWorkers()
+	instance.workers.Wait()
+}
+
+func (instance *api) shutdown() {
+	signalContext, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	<-signalContext.Done()
+
+	log.Info("Shutting down the server")
+	shutdownContext, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err := instance.echoInstance.Shutdown(shutdownContext)
+	if err != nil {
+		log.Error("Error shutting down the server: ", err.Error())
+	}
+
+	instance.stopWorkers()
+}
+
 func (instance *api) getCORSSettings() echo.MiddlewareFunc {
 	return middleware.CORSWithConfig(middleware.CORSConfig{
 		Skipper:         middlewares.OriginInspectSkipper,
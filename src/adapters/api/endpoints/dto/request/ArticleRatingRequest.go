@@ -0,0 +1,8 @@
+package request
+
+import "github.com/google/uuid"
+
+type ArticleRating struct {
+	ArticleId uuid.UUID `json:"article_id" example:"b27947d6-3224-4479-8da4-7917ae16b34d"`
+	Rating    *int      `json:"rating"     example:"3"`
+}
@@ -0,0 +1,5 @@
+package request
+
+type EmailDigest struct {
+	Frequency string `json:"frequency" example:"weekly"`
+}
@@ -0,0 +1,5 @@
+package request
+
+type NotificationPreference struct {
+	Enabled bool `json:"enabled" example:"false"`
+}
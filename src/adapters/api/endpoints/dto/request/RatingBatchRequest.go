@@ -0,0 +1,5 @@
+package request
+
+type RatingBatch struct {
+	Articles []ArticleRating `json:"articles"`
+}
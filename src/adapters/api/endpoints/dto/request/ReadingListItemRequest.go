@@ -0,0 +1,5 @@
+package request
+
+type ReadingListItem struct {
+	Note *string `json:"note" example:"Rever antes da votação no plenário"`
+}
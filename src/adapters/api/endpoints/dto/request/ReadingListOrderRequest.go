@@ -0,0 +1,7 @@
+package request
+
+import "github.com/google/uuid"
+
+type ReadingListOrder struct {
+	ArticleIds []uuid.UUID `json:"article_ids" example:"b27947d6-3224-4479-8da4-7917ae16b34d"`
+}
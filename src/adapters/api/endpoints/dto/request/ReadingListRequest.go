@@ -0,0 +1,7 @@
+package request
+
+type ReadingList struct {
+	Name        string `json:"name"        example:"Reforma tributária"`
+	Description string `json:"description" example:"Proposições e votações sobre a reforma tributária"`
+	IsPublic    bool   `json:"is_public"   example:"true"`
+}
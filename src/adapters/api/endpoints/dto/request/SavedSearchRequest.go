@@ -0,0 +1,7 @@
+package request
+
+type SavedSearch struct {
+	Name          string `json:"name"            example:"Reforma tributária aprovada"`
+	Query         string `json:"query"           example:"content=reforma%20tribut%C3%A1ria&votingResult=approved"`
+	NotifyByEmail bool   `json:"notify_by_email" example:"true"`
+}
@@ -0,0 +1,5 @@
+package request
+
+type ViewLaterBatch struct {
+	Articles []ArticleViewLater `json:"articles"`
+}
@@ -0,0 +1,187 @@
+package response
+
+import (
+	"encoding/xml"
+	"fmt"
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"os"
+	"time"
+)
+
+const articleFeedTimeZone = "America/Sao_Paulo"
+
+// The dates are stored as wall-clock times of São Paulo without a time zone, so they are read as if they were in UTC
+var articleFeedLocation = getArticleFeedLocation()
+
+type RssFeed struct {
+	XMLName       xml.Name   `xml:"rss"`
+	Version       string     `xml:"version,attr"`
+	AtomNamespace string     `xml:"xmlns:atom,attr"`
+	Channel       RssChannel `xml:"channel"`
+}
+
+type RssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	SelfLink      RssLink   `xml:"atom:link"`
+	Items         []RssItem `xml:"item"`
+}
+
+type RssLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type RssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Guid        RssGuid `xml:"guid"`
+	Description string  `xml:"description"`
+	Category    string  `xml:"category,omitempty"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type RssGuid struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type AtomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Id      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Author  AtomAuthor  `xml:"author"`
+	Links   []AtomLink  `xml:"link"`
+	Entries []AtomEntry `xml:"entry"`
+}
+
+type AtomAuthor struct {
+	Name string `xml:"name"`
+	Uri  string `xml:"uri,omitempty"`
+}
+
+type AtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type AtomEntry struct {
+	Id        string        `xml:"id"`
+	Title     string        `xml:"title"`
+	Link      AtomLink      `xml:"link"`
+	Published string        `xml:"published"`
+	Updated   string        `xml:"updated"`
+	Summary   AtomText      `xml:"summary"`
+	Category  *AtomCategory `xml:"category,omitempty"`
+}
+
+type AtomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type AtomCategory struct {
+	Term string `xml:"term,attr"`
+}
+
+func NewArticleRssFeed(articles []article.Article, title string, description string, feedUrl string,
+	lastModified time.Time) *RssFeed {
+	applicationUrl := os.Getenv("APPLICATION_URL")
+
+	var lastBuildDate string
+	if !lastModified.IsZero() {
+		lastBuildDate = lastModified.Format(time.RFC1123Z)
+	}
+
+	items := make([]RssItem, 0)
+	for _, articleData := range articles {
+		articleType := articleData.Type()
+		articleId := articleData.Id()
+		items = append(items, RssItem{
+			Title:       articleData.Title(),
+			Link:        getArticleFeedEntryUrl(applicationUrl, articleData),
+			Guid:        RssGuid{IsPermaLink: false, Value: articleId.String()},
+			Description: articleData.Content(),
+			Category:    articleType.Description(),
+			PubDate:     GetArticleFeedDateTime(articleData.CreatedAt()).Format(time.RFC1123Z),
+		})
+	}
+
+	return &RssFeed{
+		Version:       "2.0",
+		AtomNamespace: "http://www.w3.org/2005/Atom",
+		Channel: RssChannel{
+			Title:         title,
+			Link:          applicationUrl,
+			Description:   description,
+			Language:      "pt-BR",
+			LastBuildDate: lastBuildDate,
+			SelfLink:      RssLink{Href: feedUrl, Rel: "self", Type: "application/rss+xml"},
+			Items:         items,
+		},
+	}
+}
+
+func NewArticleAtomFeed(articles []article.Article, title string, feedUrl string, lastModified time.Time) *AtomFeed {
+	applicationUrl := os.Getenv("APPLICATION_URL")
+
+	entries := make([]AtomEntry, 0)
+	for _, articleData := range articles {
+		var category *AtomCategory
+		articleType := articleData.Type()
+		if articleType.Description() != "" {
+			category = &AtomCategory{Term: articleType.Description()}
+		}
+
+		articleId := articleData.Id()
+		entries = append(entries, AtomEntry{
+			Id:        fmt.Sprint("urn:uuid:", articleId),
+			Title:     articleData.Title(),
+			Link:      AtomLink{Href: getArticleFeedEntryUrl(applicationUrl, articleData), Rel: "alternate"},
+			Published: GetArticleFeedDateTime(articleData.CreatedAt()).Format(time.RFC3339),
+			Updated:   GetArticleFeedDateTime(articleData.UpdatedAt()).Format(time.RFC3339),
+			Summary:   AtomText{Type: "text", Value: articleData.Content()},
+			Category:  category,
+		})
+	}
+
+	if lastModified.IsZero() {
+		lastModified = time.Now()
+	}
+
+	return &AtomFeed{
+		Id:      feedUrl,
+		Title:   title,
+		Updated: lastModified.Format(time.RFC3339),
+		Author:  AtomAuthor{Name: "Você na Câmara", Uri: applicationUrl},
+		Links: []AtomLink{
+			{Href: feedUrl, Rel: "self", Type: "application/atom+xml"},
+			{Href: applicationUrl, Rel: "alternate", Type: "text/html"},
+		},
+		Entries: entries,
+	}
+}
+
+func GetArticleFeedDateTime(dateTime time.Time) time.Time {
+	return time.Date(dateTime.Year(), dateTime.Month(), dateTime.Day(), dateTime.Hour(), dateTime.Minute(),
+		dateTime.Second(), dateTime.Nanosecond(), articleFeedLocation)
+}
+
+func getArticleFeedLocation() *time.Location {
+	location, err := time.LoadLocation(articleFeedTimeZone)
+	if err != nil {
+		return time.FixedZone("-03", -3*60*60)
+	}
+
+	return location
+}
+
+func getArticleFeedEntryUrl(applicationUrl string, articleData article.Article) string {
+	return fmt.Sprint(applicationUrl, "/articles/", articleData.Id())
+}
@@ -0,0 +1,28 @@
+package response
+
+import (
+	"github.com/google/uuid"
+	"vnc-api/core/domains/articleoperation"
+)
+
+type ArticleOperation struct {
+	ArticleId uuid.UUID `json:"article_id"`
+	Status    string    `json:"status"`
+	Message   string    `json:"message,omitempty"`
+}
+
+func NewArticleOperation(articleOperation articleoperation.ArticleOperation) *ArticleOperation {
+	var message string
+	switch articleOperation.Status() {
+	case articleoperation.NotFoundStatus:
+		message = "Article not found"
+	case articleoperation.ForbiddenStatus:
+		message = "Access denied"
+	}
+
+	return &ArticleOperation{
+		ArticleId: articleOperation.ArticleId(),
+		Status:    articleOperation.Status(),
+		Message:   message,
+	}
+}
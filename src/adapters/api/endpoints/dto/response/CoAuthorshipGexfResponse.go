@@ -0,0 +1,114 @@
+package response
+
+import (
+	"encoding/xml"
+	"strconv"
+	"vnc-api/core/domains/coauthorshipedge"
+	"vnc-api/core/domains/coauthorshipnode"
+)
+
+type gexf struct {
+	XMLName xml.Name  `xml:"gexf"`
+	Xmlns   string    `xml:"xmlns,attr"`
+	Version string    `xml:"version,attr"`
+	Meta    gexfMeta  `xml:"meta"`
+	Graph   gexfGraph `xml:"graph"`
+}
+
+type gexfMeta struct {
+	Creator     string `xml:"creator"`
+	Description string `xml:"description"`
+}
+
+type gexfGraph struct {
+	Mode            string         `xml:"mode,attr"`
+	DefaultEdgeType string         `xml:"defaultedgetype,attr"`
+	Attributes      gexfAttributes `xml:"attributes"`
+	Nodes           []gexfNode     `xml:"nodes>node"`
+	Edges           []gexfEdge     `xml:"edges>edge"`
+}
+
+type gexfAttributes struct {
+	Class      string          `xml:"class,attr"`
+	Attributes []gexfAttribute `xml:"attribute"`
+}
+
+type gexfAttribute struct {
+	Id    string `xml:"id,attr"`
+	Title string `xml:"title,attr"`
+	Type  string `xml:"type,attr"`
+}
+
+type gexfNode struct {
+	Id              string               `xml:"id,attr"`
+	Label           string               `xml:"label,attr"`
+	AttributeValues []gexfAttributeValue `xml:"attvalues>attvalue"`
+}
+
+type gexfAttributeValue struct {
+	For   string `xml:"for,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type gexfEdge struct {
+	Id     string `xml:"id,attr"`
+	Source string `xml:"source,attr"`
+	Target string `xml:"target,attr"`
+	Weight int    `xml:"weight,attr"`
+}
+
+func NewCoAuthorshipGexf(nodes []coauthorshipnode.CoAuthorshipNode,
+	edges []coauthorshipedge.CoAuthorshipEdge) ([]byte, error) {
+	document := gexf{
+		Xmlns:   "http://gexf.net/1.3",
+		Version: "1.3",
+		Meta: gexfMeta{
+			Creator:     "Você na Câmara",
+			Description: "Rede de coautoria das proposições",
+		},
+		Graph: gexfGraph{
+			Mode:            "static",
+			DefaultEdgeType: "undirected",
+			Attributes: gexfAttributes{
+				Class: "node",
+				Attributes: []gexfAttribute{
+					{Id: "type", Title: "type", Type: "string"},
+					{Id: "party_acronym", Title: "party_acronym", Type: "string"},
+					{Id: "federated_unit", Title: "federated_unit", Type: "string"},
+					{Id: "external_author_type", Title: "external_author_type", Type: "string"},
+					{Id: "number_of_propositions", Title: "number_of_propositions", Type: "integer"},
+				},
+			},
+		},
+	}
+
+	for _, node := range nodes {
+		document.Graph.Nodes = append(document.Graph.Nodes, gexfNode{
+			Id:    node.Id().String(),
+			Label: node.Label(),
+			AttributeValues: []gexfAttributeValue{
+				{For: "type", Value: node.Type()},
+				{For: "party_acronym", Value: node.PartyAcronym()},
+				{For: "federated_unit", Value: node.FederatedUnit()},
+				{For: "external_author_type", Value: node.ExternalAuthorType()},
+				{For: "number_of_propositions", Value: strconv.Itoa(node.NumberOfPropositions())},
+			},
+		})
+	}
+
+	for index, edge := range edges {
+		document.Graph.Edges = append(document.Graph.Edges, gexfEdge{
+			Id:     strconv.Itoa(index),
+			Source: edge.SourceId().String(),
+			Target: edge.TargetId().String(),
+			Weight: edge.Weight(),
+		})
+	}
+
+	content, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), content...), nil
+}
@@ -0,0 +1,58 @@
+package response
+
+import (
+	"github.com/google/uuid"
+	"vnc-api/core/domains/coauthorshipedge"
+	"vnc-api/core/domains/coauthorshipnode"
+)
+
+type CoAuthorshipGraph struct {
+	Nodes []CoAuthorshipNode `json:"nodes"`
+	Edges []CoAuthorshipEdge `json:"edges"`
+}
+
+type CoAuthorshipNode struct {
+	Id                   uuid.UUID `json:"id"`
+	Label                string    `json:"label"`
+	Type                 string    `json:"type"`
+	PartyAcronym         string    `json:"party_acronym,omitempty"`
+	FederatedUnit        string    `json:"federated_unit,omitempty"`
+	ExternalAuthorType   string    `json:"external_author_type,omitempty"`
+	NumberOfPropositions int       `json:"number_of_propositions"`
+}
+
+type CoAuthorshipEdge struct {
+	Source uuid.UUID `json:"source"`
+	Target uuid.UUID `json:"target"`
+	Weight int       `json:"weight"`
+}
+
+func NewCoAuthorshipGraph(nodes []coauthorshipnode.CoAuthorshipNode,
+	edges []coauthorshipedge.CoAuthorshipEdge) *CoAuthorshipGraph {
+	graph := &CoAuthorshipGraph{
+		Nodes: make([]CoAuthorshipNode, 0),
+		Edges: make([]CoAuthorshipEdge, 0),
+	}
+
+	for _, node := range nodes {
+		graph.Nodes = append(graph.Nodes, CoAuthorshipNode{
+			Id:                   node.Id(),
+			Label:                node.Label(),
+			Type:                 node.Type(),
+			PartyAcronym:         node.PartyAcronym(),
+			FederatedUnit:        node.FederatedUnit(),
+			ExternalAuthorType:   node.ExternalAuthorType(),
+			NumberOfPropositions: node.NumberOfPropositions(),
+		})
+	}
+
+	for _, edge := range edges {
+		graph.Edges = append(graph.Edges, CoAuthorshipEdge{
+			Source: edge.SourceId(),
+			Target: edge.TargetId(),
+			Weight: edge.Weight(),
+		})
+	}
+
+	return graph
+}
@@ -0,0 +1,94 @@
+package response
+
+import (
+	"encoding/xml"
+	"strconv"
+	"vnc-api/core/domains/coauthorshipedge"
+	"vnc-api/core/domains/coauthorshipnode"
+)
+
+type graphml struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphmlKey `xml:"key"`
+	Graph   graphmlGraph `xml:"graph"`
+}
+
+type graphmlKey struct {
+	Id   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphmlGraph struct {
+	Id          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphmlNode `xml:"node"`
+	Edges       []graphmlEdge `xml:"edge"`
+}
+
+type graphmlNode struct {
+	Id   string        `xml:"id,attr"`
+	Data []graphmlData `xml:"data"`
+}
+
+type graphmlEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphmlData `xml:"data"`
+}
+
+type graphmlData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+func NewCoAuthorshipGraphml(nodes []coauthorshipnode.CoAuthorshipNode,
+	edges []coauthorshipedge.CoAuthorshipEdge) ([]byte, error) {
+	document := graphml{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphmlKey{
+			{Id: "label", For: "node", Name: "label", Type: "string"},
+			{Id: "type", For: "node", Name: "type", Type: "string"},
+			{Id: "party_acronym", For: "node", Name: "party_acronym", Type: "string"},
+			{Id: "federated_unit", For: "node", Name: "federated_unit", Type: "string"},
+			{Id: "external_author_type", For: "node", Name: "external_author_type", Type: "string"},
+			{Id: "number_of_propositions", For: "node", Name: "number_of_propositions", Type: "int"},
+			{Id: "weight", For: "edge", Name: "weight", Type: "int"},
+		},
+		Graph: graphmlGraph{
+			Id:          "coauthorship",
+			EdgeDefault: "undirected",
+		},
+	}
+
+	for _, node := range nodes {
+		document.Graph.Nodes = append(document.Graph.Nodes, graphmlNode{
+			Id: node.Id().String(),
+			Data: []graphmlData{
+				{Key: "label", Value: node.Label()},
+				{Key: "type", Value: node.Type()},
+				{Key: "party_acronym", Value: node.PartyAcronym()},
+				{Key: "federated_unit", Value: node.FederatedUnit()},
+				{Key: "external_author_type", Value: node.ExternalAuthorType()},
+				{Key: "number_of_propositions", Value: strconv.Itoa(node.NumberOfPropositions())},
+			},
+		})
+	}
+
+	for _, edge := range edges {
+		document.Graph.Edges = append(document.Graph.Edges, graphmlEdge{
+			Source: edge.SourceId().String(),
+			Target: edge.TargetId().String(),
+			Data:   []graphmlData{{Key: "weight", Value: strconv.Itoa(edge.Weight())}},
+		})
+	}
+
+	content, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), content...), nil
+}
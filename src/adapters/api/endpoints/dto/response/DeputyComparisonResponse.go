@@ -0,0 +1,12 @@
+package response
+
+type DeputyComparison struct {
+	Deputies []ComparedDeputy `json:"deputies"`
+	Pairs    []DeputyPair     `json:"pairs"`
+}
+
+type ComparedDeputy struct {
+	*Deputy
+	ChangedParty bool              `json:"changed_party"`
+	Statistics   *DeputyStatistics `json:"statistics"`
+}
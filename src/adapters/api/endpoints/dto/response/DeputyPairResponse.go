@@ -0,0 +1,26 @@
+package response
+
+import (
+	"github.com/google/uuid"
+	"vnc-api/core/domains/deputypair"
+)
+
+type DeputyPair struct {
+	FirstDeputyId                  uuid.UUID `json:"first_deputy_id"`
+	SecondDeputyId                 uuid.UUID `json:"second_deputy_id"`
+	NumberOfCoAuthoredPropositions int       `json:"number_of_co_authored_propositions"`
+	NumberOfCommonVotes            int       `json:"number_of_common_votes"`
+	NumberOfMatchingVotes          int       `json:"number_of_matching_votes"`
+	VoteAgreementRate              float64   `json:"vote_agreement_rate"`
+}
+
+func NewDeputyPair(deputyPair deputypair.DeputyPair) *DeputyPair {
+	return &DeputyPair{
+		FirstDeputyId:                  deputyPair.FirstDeputyId(),
+		SecondDeputyId:                 deputyPair.SecondDeputyId(),
+		NumberOfCoAuthoredPropositions: deputyPair.NumberOfCoAuthoredPropositions(),
+		NumberOfCommonVotes:            deputyPair.NumberOfCommonVotes(),
+		NumberOfMatchingVotes:          deputyPair.NumberOfMatchingVotes(),
+		VoteAgreementRate:              deputyPair.VoteAgreementRate(),
+	}
+}
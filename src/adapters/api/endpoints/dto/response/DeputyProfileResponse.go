@@ -0,0 +1,8 @@
+package response
+
+type DeputyProfile struct {
+	*Deputy
+	Statistics      *DeputyStatistics `json:"statistics"`
+	Propositions    *Pagination       `json:"propositions"`
+	Rapporteurships *Pagination       `json:"rapporteurships"`
+}
@@ -0,0 +1,30 @@
+package response
+
+import (
+	"vnc-api/core/domains/deputystatistics"
+)
+
+type DeputyStatistics struct {
+	NumberOfPropositions         int                    `json:"number_of_propositions"`
+	PropositionTypes             []PropositionTypeCount `json:"proposition_types"`
+	NumberOfRapporteurships      int                    `json:"number_of_rapporteurships"`
+	NumberOfVotedPropositions    int                    `json:"number_of_voted_propositions"`
+	NumberOfApprovedPropositions int                    `json:"number_of_approved_propositions"`
+	ApprovalRate                 float64                `json:"approval_rate"`
+}
+
+func NewDeputyStatistics(deputyStatistics deputystatistics.DeputyStatistics) *DeputyStatistics {
+	propositionTypes := make([]PropositionTypeCount, 0)
+	for _, propositionTypeCount := range deputyStatistics.PropositionTypes() {
+		propositionTypes = append(propositionTypes, *NewPropositionTypeCount(propositionTypeCount))
+	}
+
+	return &DeputyStatistics{
+		NumberOfPropositions:         deputyStatistics.NumberOfPropositions(),
+		PropositionTypes:             propositionTypes,
+		NumberOfRapporteurships:      deputyStatistics.NumberOfRapporteurships(),
+		NumberOfVotedPropositions:    deputyStatistics.NumberOfVotedPropositions(),
+		NumberOfApprovedPropositions: deputyStatistics.NumberOfApprovedPropositions(),
+		ApprovalRate:                 deputyStatistics.ApprovalRate(),
+	}
+}
@@ -0,0 +1,20 @@
+package response
+
+import (
+	"github.com/google/uuid"
+	"vnc-api/core/domains/deputyvote"
+)
+
+type DeputyVote struct {
+	Id     uuid.UUID `json:"id"`
+	Deputy *Deputy   `json:"deputy"`
+	Vote   string    `json:"vote"`
+}
+
+func NewDeputyVote(deputyVote deputyvote.DeputyVote) *DeputyVote {
+	return &DeputyVote{
+		Id:     deputyVote.Id(),
+		Deputy: NewDeputy(deputyVote.Deputy()),
+		Vote:   deputyVote.Vote(),
+	}
+}
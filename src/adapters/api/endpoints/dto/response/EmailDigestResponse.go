@@ -0,0 +1,22 @@
+package response
+
+import (
+	"time"
+	"vnc-api/core/domains/emaildigest"
+)
+
+type EmailDigest struct {
+	Frequency  string    `json:"frequency"`
+	LastSentAt time.Time `json:"last_sent_at"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+func NewEmailDigest(emailDigest emaildigest.EmailDigest) *EmailDigest {
+	return &EmailDigest{
+		Frequency:  emailDigest.Frequency(),
+		LastSentAt: emailDigest.LastSentAt(),
+		CreatedAt:  emailDigest.CreatedAt(),
+		UpdatedAt:  emailDigest.UpdatedAt(),
+	}
+}
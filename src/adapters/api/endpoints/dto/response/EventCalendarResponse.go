@@ -0,0 +1,136 @@
+package response
+
+import (
+	"fmt"
+	"github.com/devlucassantos/vnc-domains/src/domains/event"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	eventCalendarTimeZone        = "America/Sao_Paulo"
+	eventCalendarLocalTimeLayout = "20060102T150405"
+	eventCalendarUtcTimeLayout   = "20060102T150405Z"
+	eventCalendarMaximumLineSize = 75
+)
+
+// The dates are stored in the time zone of Brasília (UTC-03:00, without daylight saving time since 2019)
+var eventCalendarLocation = time.FixedZone("-03", -3*60*60)
+
+func NewEventCalendar(events []event.Event, name string, description string) string {
+	applicationUrl := os.Getenv("APPLICATION_URL")
+
+	var calendar strings.Builder
+	writeEventCalendarLine(&calendar, "BEGIN:VCALENDAR")
+	writeEventCalendarLine(&calendar, "VERSION:2.0")
+	writeEventCalendarLine(&calendar, "PRODID:-//Você na Câmara//Eventos//PT-BR")
+	writeEventCalendarLine(&calendar, "CALSCALE:GREGORIAN")
+	writeEventCalendarLine(&calendar, "METHOD:PUBLISH")
+	writeEventCalendarLine(&calendar, fmt.Sprint("NAME:", escapeEventCalendarText(name)))
+	writeEventCalendarLine(&calendar, fmt.Sprint("X-WR-CALNAME:", escapeEventCalendarText(name)))
+	writeEventCalendarLine(&calendar, fmt.Sprint("DESCRIPTION:", escapeEventCalendarText(description)))
+	writeEventCalendarLine(&calendar, fmt.Sprint("X-WR-CALDESC:", escapeEventCalendarText(description)))
+	writeEventCalendarLine(&calendar, fmt.Sprint("X-WR-TIMEZONE:", eventCalendarTimeZone))
+	writeEventCalendarLine(&calendar, "REFRESH-INTERVAL;VALUE=DURATION:PT1H")
+	writeEventCalendarLine(&calendar, "X-PUBLISHED-TTL:PT1H")
+
+	writeEventCalendarLine(&calendar, "BEGIN:VTIMEZONE")
+	writeEventCalendarLine(&calendar, fmt.Sprint("TZID:", eventCalendarTimeZone))
+	writeEventCalendarLine(&calendar, "BEGIN:STANDARD")
+	writeEventCalendarLine(&calendar, "DTSTART:19700101T000000")
+	writeEventCalendarLine(&calendar, "TZOFFSETFROM:-0300")
+	writeEventCalendarLine(&calendar, "TZOFFSETTO:-0300")
+	writeEventCalendarLine(&calendar, "TZNAME:-03")
+	writeEventCalendarLine(&calendar, "END:STANDARD")
+	writeEventCalendarLine(&calendar, "END:VTIMEZONE")
+
+	for _, eventData := range events {
+		eventArticle := eventData.Article()
+		eventUrl := fmt.Sprint(applicationUrl, "/articles/", eventArticle.Id())
+
+		eventDescription := eventData.Description()
+		if eventData.VideoUrl() != "" {
+			eventDescription = fmt.Sprint(eventDescription, "\n\nTransmissão: ", eventData.VideoUrl())
+		}
+		eventDescription = fmt.Sprint(eventDescription, "\n\n", eventUrl)
+
+		eventType := eventData.Type()
+		eventSituation := eventData.Situation()
+
+		writeEventCalendarLine(&calendar, "BEGIN:VEVENT")
+		writeEventCalendarLine(&calendar, fmt.Sprint("UID:", eventData.Id()))
+		writeEventCalendarLine(&calendar, fmt.Sprint("DTSTAMP:", formatEventCalendarUtcTime(eventData.UpdatedAt())))
+		writeEventCalendarLine(&calendar, fmt.Sprint("LAST-MODIFIED:",
+			formatEventCalendarUtcTime(eventData.UpdatedAt())))
+		writeEventCalendarLine(&calendar, fmt.Sprint("CREATED:", formatEventCalendarUtcTime(eventData.CreatedAt())))
+		// The sequence must increase whenever the event is changed, so it is the number of seconds between the
+		// creation and the last update of the event
+		writeEventCalendarLine(&calendar, fmt.Sprint("SEQUENCE:",
+			int64(eventData.UpdatedAt().Sub(eventData.CreatedAt()).Seconds())))
+		writeEventCalendarLine(&calendar, fmt.Sprintf("DTSTART;TZID=%s:%s", eventCalendarTimeZone,
+			eventData.StartsAt().Format(eventCalendarLocalTimeLayout)))
+		if !eventData.EndsAt().IsZero() && eventData.EndsAt().After(eventData.StartsAt()) {
+			writeEventCalendarLine(&calendar, fmt.Sprintf("DTEND;TZID=%s:%s", eventCalendarTimeZone,
+				eventData.EndsAt().Format(eventCalendarLocalTimeLayout)))
+		}
+		writeEventCalendarLine(&calendar, fmt.Sprint("SUMMARY:", escapeEventCalendarText(eventData.Title())))
+		writeEventCalendarLine(&calendar, fmt.Sprint("DESCRIPTION:", escapeEventCalendarText(eventDescription)))
+		if eventData.Location() != "" {
+			writeEventCalendarLine(&calendar, fmt.Sprint("LOCATION:", escapeEventCalendarText(eventData.Location())))
+		}
+		if eventType.Description() != "" {
+			writeEventCalendarLine(&calendar, fmt.Sprint("CATEGORIES:",
+				escapeEventCalendarText(eventType.Description())))
+		}
+		writeEventCalendarLine(&calendar, fmt.Sprint("STATUS:", getEventCalendarStatus(eventSituation.Description())))
+		writeEventCalendarLine(&calendar, fmt.Sprint("URL:", eventUrl))
+		writeEventCalendarLine(&calendar, "END:VEVENT")
+	}
+
+	writeEventCalendarLine(&calendar, "END:VCALENDAR")
+
+	return calendar.String()
+}
+
+func getEventCalendarStatus(situation string) string {
+	situation = strings.ToLower(situation)
+	if strings.Contains(situation, "cancelad") {
+		return "CANCELLED"
+	} else if strings.Contains(situation, "não confirmad") {
+		return "TENTATIVE"
+	}
+
+	return "CONFIRMED"
+}
+
+func formatEventCalendarUtcTime(dateTime time.Time) string {
+	localDateTime := time.Date(dateTime.Year(), dateTime.Month(), dateTime.Day(), dateTime.Hour(),
+		dateTime.Minute(), dateTime.Second(), 0, eventCalendarLocation)
+	return localDateTime.UTC().Format(eventCalendarUtcTimeLayout)
+}
+
+func escapeEventCalendarText(text string) string {
+	text = strings.ReplaceAll(text, "\\", "\\\\")
+	text = strings.ReplaceAll(text, ";", "\\;")
+	text = strings.ReplaceAll(text, ",", "\\,")
+	text = strings.ReplaceAll(text, "\r\n", "\\n")
+	return strings.ReplaceAll(text, "\n", "\\n")
+}
+
+// writeEventCalendarLine folds the lines longer than 75 octets, as required by RFC 5545, without splitting
+// multibyte characters
+func writeEventCalendarLine(calendar *strings.Builder, line string) {
+	lineSize := 0
+	for _, character := range line {
+		characterSize := utf8.RuneLen(character)
+		if lineSize+characterSize > eventCalendarMaximumLineSize {
+			calendar.WriteString("\r\n ")
+			lineSize = 1
+		}
+		calendar.WriteRune(character)
+		lineSize += characterSize
+	}
+	calendar.WriteString("\r\n")
+}
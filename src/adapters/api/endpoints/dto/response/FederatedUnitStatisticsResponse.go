@@ -0,0 +1,60 @@
+package response
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"vnc-api/core/domains/federatedunitactivity"
+)
+
+type FederatedUnitStatistics struct {
+	FederatedUnits []FederatedUnitActivity `json:"federated_units"`
+}
+
+type FederatedUnitActivity struct {
+	FederatedUnit        string `json:"federated_unit"`
+	NumberOfPropositions int    `json:"number_of_propositions"`
+	NumberOfDeputies     int    `json:"number_of_deputies"`
+}
+
+func NewFederatedUnitStatistics(activities []federatedunitactivity.FederatedUnitActivity) *FederatedUnitStatistics {
+	federatedUnitStatistics := &FederatedUnitStatistics{FederatedUnits: make([]FederatedUnitActivity, 0)}
+	for _, federatedUnitActivity := range activities {
+		federatedUnitStatistics.FederatedUnits = append(federatedUnitStatistics.FederatedUnits,
+			*NewFederatedUnitActivity(federatedUnitActivity))
+	}
+
+	return federatedUnitStatistics
+}
+
+func NewFederatedUnitActivity(activity federatedunitactivity.FederatedUnitActivity) *FederatedUnitActivity {
+	return &FederatedUnitActivity{
+		FederatedUnit:        activity.FederatedUnit(),
+		NumberOfPropositions: activity.NumberOfPropositions(),
+		NumberOfDeputies:     activity.NumberOfDeputies(),
+	}
+}
+
+func NewFederatedUnitActivitiesCsv(activities []federatedunitactivity.FederatedUnitActivity) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+
+	err := writer.Write([]string{"federated_unit", "number_of_propositions", "number_of_deputies"})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, federatedUnitActivity := range activities {
+		err = writer.Write([]string{
+			federatedUnitActivity.FederatedUnit(),
+			strconv.Itoa(federatedUnitActivity.NumberOfPropositions()),
+			strconv.Itoa(federatedUnitActivity.NumberOfDeputies()),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	return buffer.Bytes(), writer.Error()
+}
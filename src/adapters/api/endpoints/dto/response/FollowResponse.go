@@ -0,0 +1,23 @@
+package response
+
+import (
+	"github.com/google/uuid"
+	"time"
+	"vnc-api/core/domains/follow"
+)
+
+type Follow struct {
+	ResourceType string    `json:"resource_type"`
+	ResourceId   uuid.UUID `json:"resource_id"`
+	ResourceName string    `json:"resource_name"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func NewFollow(follow follow.Follow) *Follow {
+	return &Follow{
+		ResourceType: follow.ResourceType(),
+		ResourceId:   follow.ResourceId(),
+		ResourceName: follow.ResourceName(),
+		CreatedAt:    follow.CreatedAt(),
+	}
+}
@@ -0,0 +1,17 @@
+package response
+
+import (
+	"vnc-api/core/domains/frequentrapporteur"
+)
+
+type FrequentRapporteur struct {
+	Deputy                  *Deputy `json:"deputy"`
+	NumberOfRapporteurships int     `json:"number_of_rapporteurships"`
+}
+
+func NewFrequentRapporteur(frequentRapporteur frequentrapporteur.FrequentRapporteur) *FrequentRapporteur {
+	return &FrequentRapporteur{
+		Deputy:                  NewDeputy(frequentRapporteur.Deputy()),
+		NumberOfRapporteurships: frequentRapporteur.NumberOfRapporteurships(),
+	}
+}
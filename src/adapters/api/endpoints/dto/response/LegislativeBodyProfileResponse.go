@@ -0,0 +1,9 @@
+package response
+
+type LegislativeBodyProfile struct {
+	*LegislativeBody
+	UpcomingEvents      []Article            `json:"upcoming_events"`
+	RecentEvents        []Article            `json:"recent_events"`
+	RecentVotes         []Article            `json:"recent_votes"`
+	FrequentRapporteurs []FrequentRapporteur `json:"frequent_rapporteurs"`
+}
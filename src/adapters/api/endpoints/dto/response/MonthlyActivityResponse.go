@@ -0,0 +1,23 @@
+package response
+
+import (
+	"vnc-api/core/domains/monthlyactivity"
+)
+
+type MonthlyActivity struct {
+	Month                        string `json:"month"`
+	NumberOfPropositions         int    `json:"number_of_propositions"`
+	NumberOfVotedPropositions    int    `json:"number_of_voted_propositions"`
+	NumberOfApprovedPropositions int    `json:"number_of_approved_propositions"`
+	NumberOfRejectedPropositions int    `json:"number_of_rejected_propositions"`
+}
+
+func NewMonthlyActivity(monthlyActivity monthlyactivity.MonthlyActivity) *MonthlyActivity {
+	return &MonthlyActivity{
+		Month:                        monthlyActivity.Month().Format("2006-01"),
+		NumberOfPropositions:         monthlyActivity.NumberOfPropositions(),
+		NumberOfVotedPropositions:    monthlyActivity.NumberOfVotedPropositions(),
+		NumberOfApprovedPropositions: monthlyActivity.NumberOfApprovedPropositions(),
+		NumberOfRejectedPropositions: monthlyActivity.NumberOfRejectedPropositions(),
+	}
+}
@@ -0,0 +1,15 @@
+package response
+
+import "vnc-api/core/domains/notificationpreference"
+
+type NotificationPreference struct {
+	Type    string `json:"type"`
+	Enabled bool   `json:"enabled"`
+}
+
+func NewNotificationPreference(notificationPreference notificationpreference.NotificationPreference) *NotificationPreference {
+	return &NotificationPreference{
+		Type:    notificationPreference.Type(),
+		Enabled: notificationPreference.Enabled(),
+	}
+}
@@ -0,0 +1,52 @@
+package response
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+	"vnc-api/core/domains/notification"
+)
+
+type Notification struct {
+	Id               uuid.UUID  `json:"id"`
+	Type             string     `json:"type"`
+	Description      string     `json:"description"`
+	Article          *Article   `json:"article"`
+	PropositionId    uuid.UUID  `json:"proposition_id"`
+	PropositionTitle string     `json:"proposition_title"`
+	IsRead           bool       `json:"is_read"`
+	ReadAt           *time.Time `json:"read_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+func NewNotification(notificationData notification.Notification) *Notification {
+	var description string
+	switch notificationData.Type() {
+	case notification.PropositionVotingType:
+		description = fmt.Sprintf("A proposição \"%s\" teve uma nova votação", notificationData.PropositionTitle())
+	case notification.PropositionEventType:
+		description = fmt.Sprintf("A proposição \"%s\" entrou na pauta de um evento",
+			notificationData.PropositionTitle())
+	default:
+		description = fmt.Sprintf("A proposição \"%s\" foi mencionada no boletim do dia",
+			notificationData.PropositionTitle())
+	}
+
+	var readAt *time.Time
+	if !notificationData.ReadAt().IsZero() {
+		notificationReadAt := notificationData.ReadAt()
+		readAt = &notificationReadAt
+	}
+
+	return &Notification{
+		Id:               notificationData.Id(),
+		Type:             notificationData.Type(),
+		Description:      description,
+		Article:          NewArticle(notificationData.Article()),
+		PropositionId:    notificationData.PropositionArticleId(),
+		PropositionTitle: notificationData.PropositionTitle(),
+		IsRead:           notificationData.IsRead(),
+		ReadAt:           readAt,
+		CreatedAt:        notificationData.CreatedAt(),
+	}
+}
@@ -1,8 +1,11 @@
 package response
 
 type Pagination struct {
-	Page         int         `json:"page"`
-	ItemsPerPage int         `json:"items_per_page"`
-	Total        int         `json:"total"`
-	Data         interface{} `json:"data"`
+	Page           int         `json:"page"`
+	ItemsPerPage   int         `json:"items_per_page"`
+	Total          int         `json:"total"`
+	Data           interface{} `json:"data"`
+	Suggestions    []string    `json:"suggestions,omitempty"`
+	CorrectedQuery string      `json:"corrected_query,omitempty"`
+	UnreadCount    *int        `json:"unread_count,omitempty"`
 }
@@ -0,0 +1,17 @@
+package response
+
+import (
+	"vnc-api/core/domains/partyorientation"
+)
+
+type PartyOrientation struct {
+	Party       *Party `json:"party"`
+	Orientation string `json:"orientation"`
+}
+
+func NewPartyOrientation(partyOrientation partyorientation.PartyOrientation) *PartyOrientation {
+	return &PartyOrientation{
+		Party:       NewParty(partyOrientation.Party()),
+		Orientation: partyOrientation.Orientation(),
+	}
+}
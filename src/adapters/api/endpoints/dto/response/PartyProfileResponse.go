@@ -0,0 +1,8 @@
+package response
+
+type PartyProfile struct {
+	*Party
+	Deputies     []Deputy         `json:"deputies"`
+	Statistics   *PartyStatistics `json:"statistics"`
+	Propositions *Pagination      `json:"propositions"`
+}
@@ -0,0 +1,41 @@
+package response
+
+import (
+	"vnc-api/core/domains/partystatistics"
+)
+
+type PartyStatistics struct {
+	NumberOfDeputies             int                    `json:"number_of_deputies"`
+	NumberOfPropositions         int                    `json:"number_of_propositions"`
+	PropositionTypes             []PropositionTypeCount `json:"proposition_types"`
+	NumberOfVotedPropositions    int                    `json:"number_of_voted_propositions"`
+	NumberOfApprovedPropositions int                    `json:"number_of_approved_propositions"`
+	NumberOfRejectedPropositions int                    `json:"number_of_rejected_propositions"`
+	ApprovalRate                 float64                `json:"approval_rate"`
+	RejectionRate                float64                `json:"rejection_rate"`
+	MonthlyActivity              []MonthlyActivity      `json:"monthly_activity"`
+}
+
+func NewPartyStatistics(partyStatistics partystatistics.PartyStatistics) *PartyStatistics {
+	propositionTypes := make([]PropositionTypeCount, 0)
+	for _, propositionTypeCount := range partyStatistics.PropositionTypes() {
+		propositionTypes = append(propositionTypes, *NewPropositionTypeCount(propositionTypeCount))
+	}
+
+	monthlyActivity := make([]MonthlyActivity, 0)
+	for _, activity := range partyStatistics.MonthlyActivity() {
+		monthlyActivity = append(monthlyActivity, *NewMonthlyActivity(activity))
+	}
+
+	return &PartyStatistics{
+		NumberOfDeputies:             partyStatistics.NumberOfDeputies(),
+		NumberOfPropositions:         partyStatistics.NumberOfPropositions(),
+		PropositionTypes:             propositionTypes,
+		NumberOfVotedPropositions:    partyStatistics.NumberOfVotedPropositions(),
+		NumberOfApprovedPropositions: partyStatistics.NumberOfApprovedPropositions(),
+		NumberOfRejectedPropositions: partyStatistics.NumberOfRejectedPropositions(),
+		ApprovalRate:                 partyStatistics.ApprovalRate(),
+		RejectionRate:                partyStatistics.RejectionRate(),
+		MonthlyActivity:              monthlyActivity,
+	}
+}
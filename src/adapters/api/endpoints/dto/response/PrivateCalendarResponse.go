@@ -0,0 +1,26 @@
+package response
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+type PrivateCalendar struct {
+	Url       string `json:"url"`
+	WebcalUrl string `json:"webcal_url"`
+}
+
+func NewPrivateCalendar(token string) *PrivateCalendar {
+	calendarUrl := fmt.Sprint(os.Getenv("API_URL"), "/api/v1/calendar/private/", token, "/events.ics")
+
+	webcalUrl := calendarUrl
+	if _, address, found := strings.Cut(calendarUrl, "://"); found {
+		webcalUrl = fmt.Sprint("webcal://", address)
+	}
+
+	return &PrivateCalendar{
+		Url:       calendarUrl,
+		WebcalUrl: webcalUrl,
+	}
+}
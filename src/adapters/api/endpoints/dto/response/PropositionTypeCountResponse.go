@@ -0,0 +1,17 @@
+package response
+
+import (
+	"vnc-api/core/domains/propositiontypecount"
+)
+
+type PropositionTypeCount struct {
+	PropositionType      *PropositionType `json:"proposition_type"`
+	NumberOfPropositions int              `json:"number_of_propositions"`
+}
+
+func NewPropositionTypeCount(propositionTypeCount propositiontypecount.PropositionTypeCount) *PropositionTypeCount {
+	return &PropositionTypeCount{
+		PropositionType:      NewPropositionType(propositionTypeCount.PropositionType()),
+		NumberOfPropositions: propositionTypeCount.NumberOfPropositions(),
+	}
+}
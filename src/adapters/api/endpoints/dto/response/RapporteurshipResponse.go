@@ -0,0 +1,35 @@
+package response
+
+import (
+	"github.com/google/uuid"
+	"time"
+	"vnc-api/core/domains/rapporteurship"
+)
+
+type Rapporteurship struct {
+	Id                   uuid.UUID `json:"id"`
+	Title                string    `json:"title"`
+	Topic                string    `json:"topic,omitempty"`
+	Regime               string    `json:"regime"`
+	Situation            string    `json:"situation,omitempty"`
+	PropositionArticleId uuid.UUID `json:"proposition_article_id"`
+	PropositionTitle     string    `json:"proposition_title"`
+	EventArticleId       uuid.UUID `json:"event_article_id"`
+	EventTitle           string    `json:"event_title"`
+	EventStartsAt        time.Time `json:"event_starts_at"`
+}
+
+func NewRapporteurship(rapporteurship rapporteurship.Rapporteurship) *Rapporteurship {
+	return &Rapporteurship{
+		Id:                   rapporteurship.Id(),
+		Title:                rapporteurship.Title(),
+		Topic:                rapporteurship.Topic(),
+		Regime:               rapporteurship.Regime(),
+		Situation:            rapporteurship.Situation(),
+		PropositionArticleId: rapporteurship.PropositionArticleId(),
+		PropositionTitle:     rapporteurship.PropositionTitle(),
+		EventArticleId:       rapporteurship.EventArticleId(),
+		EventTitle:           rapporteurship.EventTitle(),
+		EventStartsAt:        rapporteurship.EventStartsAt(),
+	}
+}
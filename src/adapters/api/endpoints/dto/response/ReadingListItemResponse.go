@@ -0,0 +1,22 @@
+package response
+
+import (
+	"time"
+	"vnc-api/core/domains/readinglistitem"
+)
+
+type ReadingListItem struct {
+	Article  *Article  `json:"article"`
+	Position int       `json:"position"`
+	Note     string    `json:"note,omitempty"`
+	AddedAt  time.Time `json:"added_at"`
+}
+
+func NewReadingListItem(readingListItem readinglistitem.ReadingListItem) *ReadingListItem {
+	return &ReadingListItem{
+		Article:  NewArticle(readingListItem.Article()),
+		Position: readingListItem.Position(),
+		Note:     readingListItem.Note(),
+		AddedAt:  readingListItem.CreatedAt(),
+	}
+}
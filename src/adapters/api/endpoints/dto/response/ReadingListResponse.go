@@ -0,0 +1,40 @@
+package response
+
+import (
+	"github.com/google/uuid"
+	"os"
+	"time"
+	"vnc-api/core/domains/readinglist"
+)
+
+type ReadingList struct {
+	Id               uuid.UUID   `json:"id"`
+	Name             string      `json:"name"`
+	Description      string      `json:"description,omitempty"`
+	IsDefault        bool        `json:"is_default"`
+	IsPublic         bool        `json:"is_public"`
+	ShareUrl         string      `json:"share_url,omitempty"`
+	NumberOfArticles int         `json:"number_of_articles"`
+	Articles         *Pagination `json:"articles,omitempty"`
+	CreatedAt        time.Time   `json:"created_at"`
+	UpdatedAt        time.Time   `json:"updated_at"`
+}
+
+func NewReadingList(readingList readinglist.ReadingList) *ReadingList {
+	var shareUrl string
+	if readingList.IsPublic() && readingList.ShareToken() != "" {
+		shareUrl = os.Getenv("APPLICATION_URL") + "/reading-lists/shared/" + readingList.ShareToken()
+	}
+
+	return &ReadingList{
+		Id:               readingList.Id(),
+		Name:             readingList.Name(),
+		Description:      readingList.Description(),
+		IsDefault:        readingList.IsDefault(),
+		IsPublic:         readingList.IsPublic(),
+		ShareUrl:         shareUrl,
+		NumberOfArticles: readingList.NumberOfArticles(),
+		CreatedAt:        readingList.CreatedAt(),
+		UpdatedAt:        readingList.UpdatedAt(),
+	}
+}
@@ -0,0 +1,36 @@
+package response
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"vnc-api/core/domains/recommendation"
+)
+
+type RecommendationReason struct {
+	Type         string     `json:"type"`
+	Description  string     `json:"description"`
+	ArticleId    *uuid.UUID `json:"article_id,omitempty"`
+	ArticleTitle string     `json:"article_title,omitempty"`
+}
+
+func NewRecommendationReason(recommendationData recommendation.Recommendation) *RecommendationReason {
+	recommendationReason := &RecommendationReason{Type: recommendationData.Reason()}
+
+	switch recommendationData.Reason() {
+	case recommendation.SimilarReadersReason:
+		recommendationReason.Description = fmt.Sprintf("Quem leu \"%s\" também leu",
+			recommendationData.ReasonArticleTitle())
+	case recommendation.SimilarContentReason:
+		recommendationReason.Description = fmt.Sprintf("Porque você leu \"%s\"",
+			recommendationData.ReasonArticleTitle())
+	default:
+		recommendationReason.Description = "Em alta na plataforma"
+		return recommendationReason
+	}
+
+	reasonArticleId := recommendationData.ReasonArticleId()
+	recommendationReason.ArticleId = &reasonArticleId
+	recommendationReason.ArticleTitle = recommendationData.ReasonArticleTitle()
+
+	return recommendationReason
+}
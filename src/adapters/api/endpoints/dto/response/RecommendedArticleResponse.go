@@ -0,0 +1,15 @@
+package response
+
+import "vnc-api/core/domains/recommendation"
+
+type RecommendedArticle struct {
+	Article *Article              `json:"article"`
+	Reason  *RecommendationReason `json:"reason"`
+}
+
+func NewRecommendedArticle(recommendation recommendation.Recommendation) *RecommendedArticle {
+	return &RecommendedArticle{
+		Article: NewArticle(recommendation.Article()),
+		Reason:  NewRecommendationReason(recommendation),
+	}
+}
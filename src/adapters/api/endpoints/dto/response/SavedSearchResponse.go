@@ -0,0 +1,84 @@
+package response
+
+import (
+	"github.com/google/uuid"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"vnc-api/core/domains/savedsearch"
+	"vnc-api/core/filters"
+)
+
+type SavedSearch struct {
+	Id                  uuid.UUID `json:"id"`
+	Name                string    `json:"name"`
+	Query               string    `json:"query"`
+	NotifyByEmail       bool      `json:"notify_by_email"`
+	NumberOfNewArticles int       `json:"number_of_new_articles"`
+	LastOpenedAt        time.Time `json:"last_opened_at"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+func NewSavedSearch(savedSearch savedsearch.SavedSearch) *SavedSearch {
+	return &SavedSearch{
+		Id:                  savedSearch.Id(),
+		Name:                savedSearch.Name(),
+		Query:               getArticleQueryFromFilter(savedSearch.Filter()),
+		NotifyByEmail:       savedSearch.NotifyByEmail(),
+		NumberOfNewArticles: savedSearch.NumberOfNewArticles(),
+		LastOpenedAt:        savedSearch.LastOpenedAt(),
+		CreatedAt:           savedSearch.CreatedAt(),
+		UpdatedAt:           savedSearch.UpdatedAt(),
+	}
+}
+
+func getArticleQueryFromFilter(filter filters.Article) string {
+	queryParameters := url.Values{}
+
+	setUuidQueryParameter(queryParameters, "typeId", filter.TypeId)
+	setUuidQueryParameter(queryParameters, "specificTypeId", filter.SpecificTypeId)
+	if filter.Content != "" {
+		queryParameters.Set("content", filter.Content)
+	}
+	setDateQueryParameter(queryParameters, "startDate", filter.StartDate)
+	setDateQueryParameter(queryParameters, "endDate", filter.EndDate)
+	if filter.ExcludeRead {
+		queryParameters.Set("excludeRead", strconv.FormatBool(filter.ExcludeRead))
+	}
+	setUuidQueryParameter(queryParameters, "propositionDeputyId", filter.Proposition.DeputyId)
+	setUuidQueryParameter(queryParameters, "propositionPartyId", filter.Proposition.PartyId)
+	setUuidQueryParameter(queryParameters, "propositionExternalAuthorId", filter.Proposition.ExternalAuthorId)
+	if len(filter.Proposition.FederatedUnits) > 0 {
+		queryParameters.Set("propositionFederatedUnit", strings.Join(filter.Proposition.FederatedUnits, ","))
+	}
+	setDateQueryParameter(queryParameters, "votingStartDate", filter.Voting.StartDate)
+	setDateQueryParameter(queryParameters, "votingEndDate", filter.Voting.EndDate)
+	if filter.Voting.Result != "" {
+		queryParameters.Set("votingResult", filter.Voting.Result)
+	}
+	setUuidQueryParameter(queryParameters, "votingLegislativeBodyId", filter.Voting.LegislativeBodyId)
+	setDateQueryParameter(queryParameters, "eventStartDate", filter.Event.StartDate)
+	setDateQueryParameter(queryParameters, "eventEndDate", filter.Event.EndDate)
+	setUuidQueryParameter(queryParameters, "eventSituationId", filter.Event.SituationId)
+	setUuidQueryParameter(queryParameters, "eventLegislativeBodyId", filter.Event.LegislativeBodyId)
+	setUuidQueryParameter(queryParameters, "eventRapporteurId", filter.Event.RapporteurId)
+	if filter.Event.RemoveEventsInTheFuture != nil {
+		queryParameters.Set("removeEventsInTheFuture", strconv.FormatBool(*filter.Event.RemoveEventsInTheFuture))
+	}
+
+	return queryParameters.Encode()
+}
+
+func setUuidQueryParameter(queryParameters url.Values, parameter string, value *uuid.UUID) {
+	if value != nil {
+		queryParameters.Set(parameter, value.String())
+	}
+}
+
+func setDateQueryParameter(queryParameters url.Values, parameter string, value *time.Time) {
+	if value != nil {
+		queryParameters.Set(parameter, value.Format("2006-01-02"))
+	}
+}
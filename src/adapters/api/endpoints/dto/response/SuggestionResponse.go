@@ -0,0 +1,37 @@
+package response
+
+import (
+	"github.com/google/uuid"
+	"vnc-api/core/domains/suggestion"
+)
+
+type Suggestion struct {
+	Id               uuid.UUID `json:"id"`
+	Type             string    `json:"type"`
+	Label            string    `json:"label"`
+	Description      string    `json:"description,omitempty"`
+	FilterParameters []string  `json:"filter_parameters,omitempty"`
+}
+
+func NewSuggestion(suggestion suggestion.Suggestion) *Suggestion {
+	return &Suggestion{
+		Id:               suggestion.Id(),
+		Type:             suggestion.Type(),
+		Label:            suggestion.Label(),
+		Description:      suggestion.Description(),
+		FilterParameters: getSuggestionFilterParameters(suggestion.Type()),
+	}
+}
+
+func getSuggestionFilterParameters(suggestionType string) []string {
+	switch suggestionType {
+	case suggestion.DeputyType:
+		return []string{"propositionDeputyId", "eventRapporteurId"}
+	case suggestion.PartyType:
+		return []string{"propositionPartyId"}
+	case suggestion.LegislativeBodyType:
+		return []string{"votingLegislativeBodyId", "eventLegislativeBodyId"}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,37 @@
+package response
+
+import (
+	"github.com/google/uuid"
+	"time"
+	"vnc-api/core/domains/timelineitem"
+)
+
+type TimelineItem struct {
+	Type                string    `json:"type"`
+	Date                time.Time `json:"date"`
+	Title               string    `json:"title"`
+	Description         string    `json:"description,omitempty"`
+	ArticleId           uuid.UUID `json:"article_id"`
+	LegislativeBody     string    `json:"legislative_body,omitempty"`
+	AgendaItemRegime    string    `json:"agenda_item_regime,omitempty"`
+	AgendaItemSituation string    `json:"agenda_item_situation,omitempty"`
+	EventSituation      string    `json:"event_situation,omitempty"`
+	VotingResult        string    `json:"voting_result,omitempty"`
+	VotingIsApproved    *bool     `json:"voting_is_approved,omitempty"`
+}
+
+func NewTimelineItem(timelineItem timelineitem.TimelineItem) *TimelineItem {
+	return &TimelineItem{
+		Type:                timelineItem.Type(),
+		Date:                timelineItem.Date(),
+		Title:               timelineItem.Title(),
+		Description:         timelineItem.Description(),
+		ArticleId:           timelineItem.ArticleId(),
+		LegislativeBody:     timelineItem.LegislativeBody(),
+		AgendaItemRegime:    timelineItem.AgendaItemRegime(),
+		AgendaItemSituation: timelineItem.AgendaItemSituation(),
+		EventSituation:      timelineItem.EventSituation(),
+		VotingResult:        timelineItem.VotingResult(),
+		VotingIsApproved:    timelineItem.VotingIsApproved(),
+	}
+}
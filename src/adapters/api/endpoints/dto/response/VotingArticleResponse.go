@@ -5,31 +5,36 @@ import (
 	"github.com/google/uuid"
 	"strings"
 	"time"
+	"vnc-api/core/domains/deputyvote"
+	"vnc-api/core/domains/partyorientation"
 )
 
 type VotingArticle struct {
-	Id                   uuid.UUID        `json:"id"`
-	Title                string           `json:"title"`
-	Content              string           `json:"content"`
-	Result               string           `json:"result"`
-	ResultAnnouncedAt    time.Time        `json:"result_announced_at"`
-	IsApproved           *bool            `json:"is_approved,omitempty"`
-	LegislativeBody      *LegislativeBody `json:"legislative_body"`
-	MainProposition      *Article         `json:"main_proposition,omitempty"`
-	RelatedPropositions  []Article        `json:"related_propositions,omitempty"`
-	AffectedPropositions []Article        `json:"affected_propositions,omitempty"`
-	Type                 *ArticleType     `json:"type"`
-	AverageRating        float64          `json:"average_rating,omitempty"`
-	NumberOfRatings      int              `json:"number_of_ratings,omitempty"`
-	UserRating           int              `json:"user_rating,omitempty"`
-	ViewLater            bool             `json:"view_later,omitempty"`
-	Events               []Article        `json:"events,omitempty"`
-	Newsletter           *Article         `json:"newsletter,omitempty"`
-	CreatedAt            time.Time        `json:"created_at"`
-	UpdatedAt            time.Time        `json:"updated_at"`
+	Id                   uuid.UUID          `json:"id"`
+	Title                string             `json:"title"`
+	Content              string             `json:"content"`
+	Result               string             `json:"result"`
+	ResultAnnouncedAt    time.Time          `json:"result_announced_at"`
+	IsApproved           *bool              `json:"is_approved,omitempty"`
+	LegislativeBody      *LegislativeBody   `json:"legislative_body"`
+	MainProposition      *Article           `json:"main_proposition,omitempty"`
+	RelatedPropositions  []Article          `json:"related_propositions,omitempty"`
+	AffectedPropositions []Article          `json:"affected_propositions,omitempty"`
+	Type                 *ArticleType       `json:"type"`
+	AverageRating        float64            `json:"average_rating,omitempty"`
+	NumberOfRatings      int                `json:"number_of_ratings,omitempty"`
+	UserRating           int                `json:"user_rating,omitempty"`
+	ViewLater            bool               `json:"view_later,omitempty"`
+	Votes                []DeputyVote       `json:"votes,omitempty"`
+	PartyOrientations    []PartyOrientation `json:"party_orientations,omitempty"`
+	Events               []Article          `json:"events,omitempty"`
+	Newsletter           *Article           `json:"newsletter,omitempty"`
+	CreatedAt            time.Time          `json:"created_at"`
+	UpdatedAt            time.Time          `json:"updated_at"`
 }
 
-func NewVotingArticle(voting voting.Voting) *VotingArticle {
+func NewVotingArticle(voting voting.Voting, deputyVotes []deputyvote.DeputyVote,
+	partyOrientations []partyorientation.PartyOrientation) *VotingArticle {
 	mainProposition := voting.MainProposition()
 	var mainPropositionArticle *Article
 	if mainProposition.Id() != uuid.Nil {
@@ -46,6 +51,16 @@ func NewVotingArticle(voting voting.Voting) *VotingArticle {
 		affectedPropositions = append(affectedPropositions, *NewArticle(proposition.Article()))
 	}
 
+	var votes []DeputyVote
+	for _, deputyVote := range deputyVotes {
+		votes = append(votes, *NewDeputyVote(deputyVote))
+	}
+
+	var orientations []PartyOrientation
+	for _, partyOrientation := range partyOrientations {
+		orientations = append(orientations, *NewPartyOrientation(partyOrientation))
+	}
+
 	votingArticle := voting.Article()
 
 	var newsletter *Article
@@ -75,6 +90,8 @@ func NewVotingArticle(voting voting.Voting) *VotingArticle {
 		NumberOfRatings:      votingArticle.NumberOfRatings(),
 		UserRating:           votingArticle.UserRating(),
 		ViewLater:            votingArticle.ViewLater(),
+		Votes:                votes,
+		PartyOrientations:    orientations,
 		Events:               events,
 		Newsletter:           newsletter,
 		CreatedAt:            votingArticle.CreatedAt(),
@@ -0,0 +1,35 @@
+package response
+
+import (
+	"github.com/google/uuid"
+	"time"
+	"vnc-api/core/domains/votingrecord"
+)
+
+type VotingRecord struct {
+	Id                      uuid.UUID        `json:"id"`
+	VotingArticleId         uuid.UUID        `json:"voting_article_id"`
+	VotingTitle             string           `json:"voting_title"`
+	VotingResult            string           `json:"voting_result"`
+	VotingResultAnnouncedAt time.Time        `json:"voting_result_announced_at"`
+	VotingIsApproved        *bool            `json:"voting_is_approved,omitempty"`
+	LegislativeBody         *LegislativeBody `json:"legislative_body"`
+	Party                   *Party           `json:"party"`
+	Vote                    string           `json:"vote"`
+	PartyOrientation        string           `json:"party_orientation,omitempty"`
+}
+
+func NewVotingRecord(votingRecord votingrecord.VotingRecord) *VotingRecord {
+	return &VotingRecord{
+		Id:                      votingRecord.Id(),
+		VotingArticleId:         votingRecord.VotingArticleId(),
+		VotingTitle:             votingRecord.VotingTitle(),
+		VotingResult:            votingRecord.VotingResult(),
+		VotingResultAnnouncedAt: votingRecord.VotingResultAnnouncedAt(),
+		VotingIsApproved:        votingRecord.VotingIsApproved(),
+		LegislativeBody:         NewLegislativeBody(votingRecord.LegislativeBody()),
+		Party:                   NewParty(votingRecord.Party()),
+		Vote:                    votingRecord.Vote(),
+		PartyOrientation:        votingRecord.PartyOrientation(),
+	}
+}
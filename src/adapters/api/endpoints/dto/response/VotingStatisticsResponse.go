@@ -0,0 +1,98 @@
+package response
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"vnc-api/core/domains/votingoutcome"
+)
+
+type VotingStatistics struct {
+	NumberOfApprovedVotes     int             `json:"number_of_approved_votes"`
+	NumberOfRejectedVotes     int             `json:"number_of_rejected_votes"`
+	NumberOfUndeterminedVotes int             `json:"number_of_undetermined_votes"`
+	NumberOfVotes             int             `json:"number_of_votes"`
+	Outcomes                  []VotingOutcome `json:"outcomes"`
+}
+
+type VotingOutcome struct {
+	LegislativeBody           *LegislativeBody `json:"legislative_body"`
+	PropositionType           *PropositionType `json:"proposition_type,omitempty"`
+	Month                     string           `json:"month"`
+	NumberOfApprovedVotes     int              `json:"number_of_approved_votes"`
+	NumberOfRejectedVotes     int              `json:"number_of_rejected_votes"`
+	NumberOfUndeterminedVotes int              `json:"number_of_undetermined_votes"`
+	NumberOfVotes             int              `json:"number_of_votes"`
+}
+
+func NewVotingStatistics(votingOutcomes []votingoutcome.VotingOutcome) *VotingStatistics {
+	votingStatistics := &VotingStatistics{Outcomes: make([]VotingOutcome, 0)}
+	for _, votingOutcome := range votingOutcomes {
+		votingStatistics.NumberOfApprovedVotes += votingOutcome.NumberOfApprovedVotes()
+		votingStatistics.NumberOfRejectedVotes += votingOutcome.NumberOfRejectedVotes()
+		votingStatistics.NumberOfUndeterminedVotes += votingOutcome.NumberOfUndeterminedVotes()
+		votingStatistics.NumberOfVotes += votingOutcome.NumberOfVotes()
+		votingStatistics.Outcomes = append(votingStatistics.Outcomes, *NewVotingOutcome(votingOutcome))
+	}
+
+	return votingStatistics
+}
+
+func NewVotingOutcome(votingOutcome votingoutcome.VotingOutcome) *VotingOutcome {
+	var propositionType *PropositionType
+	votingOutcomePropositionType := votingOutcome.PropositionType()
+	if !votingOutcomePropositionType.IsZero() {
+		propositionType = NewPropositionType(votingOutcomePropositionType)
+	}
+
+	return &VotingOutcome{
+		LegislativeBody:           NewLegislativeBody(votingOutcome.LegislativeBody()),
+		PropositionType:           propositionType,
+		Month:                     votingOutcome.Month().Format("2006-01"),
+		NumberOfApprovedVotes:     votingOutcome.NumberOfApprovedVotes(),
+		NumberOfRejectedVotes:     votingOutcome.NumberOfRejectedVotes(),
+		NumberOfUndeterminedVotes: votingOutcome.NumberOfUndeterminedVotes(),
+		NumberOfVotes:             votingOutcome.NumberOfVotes(),
+	}
+}
+
+func NewVotingOutcomesCsv(votingOutcomes []votingoutcome.VotingOutcome) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+
+	err := writer.Write([]string{"month", "legislative_body_id", "legislative_body_acronym", "legislative_body_name",
+		"proposition_type_id", "proposition_type_description", "number_of_approved_votes",
+		"number_of_rejected_votes", "number_of_undetermined_votes", "number_of_votes"})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, votingOutcome := range votingOutcomes {
+		legislativeBody := votingOutcome.LegislativeBody()
+		propositionType := votingOutcome.PropositionType()
+
+		var propositionTypeId string
+		if !propositionType.IsZero() {
+			propositionTypeId = propositionType.Id().String()
+		}
+
+		err = writer.Write([]string{
+			votingOutcome.Month().Format("2006-01"),
+			legislativeBody.Id().String(),
+			legislativeBody.Acronym(),
+			legislativeBody.Name(),
+			propositionTypeId,
+			propositionType.Description(),
+			strconv.Itoa(votingOutcome.NumberOfApprovedVotes()),
+			strconv.Itoa(votingOutcome.NumberOfRejectedVotes()),
+			strconv.Itoa(votingOutcome.NumberOfUndeterminedVotes()),
+			strconv.Itoa(votingOutcome.NumberOfVotes()),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	return buffer.Bytes(), writer.Error()
+}
@@ -0,0 +1,9 @@
+package swagger
+
+import "github.com/google/uuid"
+
+type ArticleOperation struct {
+	ArticleId uuid.UUID `json:"article_id" example:"b27947d6-3224-4479-8da4-7917ae16b34d"`
+	Status    string    `json:"status"     example:"not_found"`
+	Message   string    `json:"message"    example:"Article not found"`
+}
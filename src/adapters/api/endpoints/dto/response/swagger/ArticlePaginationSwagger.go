@@ -1,8 +1,10 @@
 package swagger
 
 type ArticlePagination struct {
-	Page         int       `json:"page"           example:"1"`
-	ItemsPerPage int       `json:"items_per_page" example:"15"`
-	Total        int       `json:"total"          example:"4562"`
-	Data         []Article `json:"data"`
+	Page           int       `json:"page"            example:"1"`
+	ItemsPerPage   int       `json:"items_per_page"  example:"15"`
+	Total          int       `json:"total"           example:"4562"`
+	Data           []Article `json:"data"`
+	Suggestions    []string  `json:"suggestions"     example:"reforma tributária,reforma trabalhista"`
+	CorrectedQuery string    `json:"corrected_query" example:"reforma tributária"`
 }
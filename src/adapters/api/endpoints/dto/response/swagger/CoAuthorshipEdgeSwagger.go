@@ -0,0 +1,9 @@
+package swagger
+
+import "github.com/google/uuid"
+
+type CoAuthorshipEdge struct {
+	Source uuid.UUID `json:"source" example:"a4b04454-f426-44d2-843e-1331510b19ad"`
+	Target uuid.UUID `json:"target" example:"c7d1e2f3-4a5b-4c6d-8e9f-0a1b2c3d4e5f"`
+	Weight int       `json:"weight" example:"5"`
+}
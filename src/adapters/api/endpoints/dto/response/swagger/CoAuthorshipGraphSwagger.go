@@ -0,0 +1,6 @@
+package swagger
+
+type CoAuthorshipGraph struct {
+	Nodes []CoAuthorshipNode `json:"nodes"`
+	Edges []CoAuthorshipEdge `json:"edges"`
+}
@@ -0,0 +1,13 @@
+package swagger
+
+import "github.com/google/uuid"
+
+type CoAuthorshipNode struct {
+	Id                   uuid.UUID `json:"id"                     example:"a4b04454-f426-44d2-843e-1331510b19ad"`
+	Label                string    `json:"label"                  example:"José do Povo"`
+	Type                 string    `json:"type"                   example:"deputy"`
+	PartyAcronym         string    `json:"party_acronym"          example:"PVNC"`
+	FederatedUnit        string    `json:"federated_unit"         example:"AL"`
+	ExternalAuthorType   string    `json:"external_author_type"   example:"Órgão do Poder Executivo"`
+	NumberOfPropositions int       `json:"number_of_propositions" example:"37"`
+}
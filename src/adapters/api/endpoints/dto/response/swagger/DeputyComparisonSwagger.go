@@ -0,0 +1,6 @@
+package swagger
+
+type DeputyComparison struct {
+	Deputies []ComparedDeputy `json:"deputies"`
+	Pairs    []DeputyPair     `json:"pairs"`
+}
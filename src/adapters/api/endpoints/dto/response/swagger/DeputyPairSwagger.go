@@ -0,0 +1,12 @@
+package swagger
+
+import "github.com/google/uuid"
+
+type DeputyPair struct {
+	FirstDeputyId                  uuid.UUID `json:"first_deputy_id"                    example:"a4b04454-f426-44d2-843e-1331510b19ad"`
+	SecondDeputyId                 uuid.UUID `json:"second_deputy_id"                   example:"c7d1e2f3-4a5b-4c6d-8e9f-0a1b2c3d4e5f"`
+	NumberOfCoAuthoredPropositions int       `json:"number_of_co_authored_propositions" example:"12"`
+	NumberOfCommonVotes            int       `json:"number_of_common_votes"             example:"340"`
+	NumberOfMatchingVotes          int       `json:"number_of_matching_votes"           example:"289"`
+	VoteAgreementRate              float64   `json:"vote_agreement_rate"                example:"0.85"`
+}
@@ -0,0 +1,10 @@
+package swagger
+
+type DeputyStatistics struct {
+	NumberOfPropositions         int                    `json:"number_of_propositions"          example:"57"`
+	PropositionTypes             []PropositionTypeCount `json:"proposition_types"`
+	NumberOfRapporteurships      int                    `json:"number_of_rapporteurships"       example:"27"`
+	NumberOfVotedPropositions    int                    `json:"number_of_voted_propositions"    example:"8"`
+	NumberOfApprovedPropositions int                    `json:"number_of_approved_propositions" example:"6"`
+	ApprovalRate                 float64                `json:"approval_rate"                   example:"0.75"`
+}
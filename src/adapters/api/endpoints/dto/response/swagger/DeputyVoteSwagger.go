@@ -0,0 +1,9 @@
+package swagger
+
+import "github.com/google/uuid"
+
+type DeputyVote struct {
+	Id     uuid.UUID `json:"id"     example:"3c1e2b7a-8d4f-4a6e-9b2c-5d7e8f9a0b1c"`
+	Deputy Deputy    `json:"deputy"`
+	Vote   string    `json:"vote"   example:"yes"`
+}
@@ -0,0 +1,10 @@
+package swagger
+
+import "time"
+
+type EmailDigest struct {
+	Frequency  string    `json:"frequency"    example:"weekly"`
+	LastSentAt time.Time `json:"last_sent_at" example:"2024-01-05T20:25:19.98031Z"`
+	CreatedAt  time.Time `json:"created_at"   example:"2024-01-05T20:25:19.98031Z"`
+	UpdatedAt  time.Time `json:"updated_at"   example:"2024-01-05T20:25:19.98031Z"`
+}
@@ -0,0 +1,7 @@
+package swagger
+
+type FederatedUnitActivity struct {
+	FederatedUnit        string `json:"federated_unit"         example:"MG"`
+	NumberOfPropositions int    `json:"number_of_propositions" example:"1375"`
+	NumberOfDeputies     int    `json:"number_of_deputies"     example:"53"`
+}
@@ -0,0 +1,5 @@
+package swagger
+
+type FederatedUnitStatistics struct {
+	FederatedUnits []FederatedUnitActivity `json:"federated_units"`
+}
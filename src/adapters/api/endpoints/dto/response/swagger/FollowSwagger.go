@@ -0,0 +1,13 @@
+package swagger
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Follow struct {
+	ResourceType string    `json:"resource_type" example:"deputy"`
+	ResourceId   uuid.UUID `json:"resource_id"   example:"a4b04454-f426-44d2-843e-1331510b19ad"`
+	ResourceName string    `json:"resource_name" example:"José do Povo"`
+	CreatedAt    time.Time `json:"created_at"    example:"2024-01-05T20:25:19.98031Z"`
+}
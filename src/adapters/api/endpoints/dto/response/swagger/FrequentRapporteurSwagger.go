@@ -0,0 +1,6 @@
+package swagger
+
+type FrequentRapporteur struct {
+	Deputy                  Deputy `json:"deputy"`
+	NumberOfRapporteurships int    `json:"number_of_rapporteurships" example:"14"`
+}
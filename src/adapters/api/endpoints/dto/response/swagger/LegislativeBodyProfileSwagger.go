@@ -0,0 +1,14 @@
+package swagger
+
+import "github.com/google/uuid"
+
+type LegislativeBodyProfile struct {
+	Id                  uuid.UUID            `json:"id"      example:"31991060-fa3d-4647-aea5-b17b41de6a36"`
+	Name                string               `json:"name"    example:"Comissão de Educação"`
+	Acronym             string               `json:"acronym" example:"CE"`
+	Type                LegislativeBodyType  `json:"type"`
+	UpcomingEvents      []Article            `json:"upcoming_events"`
+	RecentEvents        []Article            `json:"recent_events"`
+	RecentVotes         []Article            `json:"recent_votes"`
+	FrequentRapporteurs []FrequentRapporteur `json:"frequent_rapporteurs"`
+}
@@ -0,0 +1,9 @@
+package swagger
+
+type MonthlyActivity struct {
+	Month                        string `json:"month"                           example:"2024-05"`
+	NumberOfPropositions         int    `json:"number_of_propositions"          example:"38"`
+	NumberOfVotedPropositions    int    `json:"number_of_voted_propositions"    example:"5"`
+	NumberOfApprovedPropositions int    `json:"number_of_approved_propositions" example:"4"`
+	NumberOfRejectedPropositions int    `json:"number_of_rejected_propositions" example:"1"`
+}
@@ -0,0 +1,9 @@
+package swagger
+
+type NotificationPagination struct {
+	Page         int            `json:"page"           example:"1"`
+	ItemsPerPage int            `json:"items_per_page" example:"15"`
+	Total        int            `json:"total"          example:"42"`
+	UnreadCount  int            `json:"unread_count"   example:"3"`
+	Data         []Notification `json:"data"`
+}
@@ -0,0 +1,6 @@
+package swagger
+
+type NotificationPreference struct {
+	Type    string `json:"type"    example:"proposition_voting"`
+	Enabled bool   `json:"enabled" example:"true"`
+}
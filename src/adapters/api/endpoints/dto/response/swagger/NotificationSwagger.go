@@ -0,0 +1,18 @@
+package swagger
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Notification struct {
+	Id               uuid.UUID `json:"id"                example:"1b5c7f7e-4b0e-4e4a-9c43-2d0c6f3a8e1d"`
+	Type             string    `json:"type"              example:"proposition_voting"`
+	Description      string    `json:"description"       example:"A proposição \"Altera a Lei nº 9.503, de 23 de setembro de 1997\" teve uma nova votação"`
+	Article          Article   `json:"article"`
+	PropositionId    uuid.UUID `json:"proposition_id"    example:"e9b0f6b5-5c63-4b0e-9d43-1b2d3e2a8f1c"`
+	PropositionTitle string    `json:"proposition_title" example:"Altera a Lei nº 9.503, de 23 de setembro de 1997"`
+	IsRead           bool      `json:"is_read"           example:"true"`
+	ReadAt           time.Time `json:"read_at"           example:"2024-01-05T20:25:19.98031Z"`
+	CreatedAt        time.Time `json:"created_at"        example:"2024-01-05T20:25:19.98031Z"`
+}
@@ -0,0 +1,6 @@
+package swagger
+
+type PartyOrientation struct {
+	Party       Party  `json:"party"`
+	Orientation string `json:"orientation" example:"yes"`
+}
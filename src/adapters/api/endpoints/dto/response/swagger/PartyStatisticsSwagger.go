@@ -0,0 +1,13 @@
+package swagger
+
+type PartyStatistics struct {
+	NumberOfDeputies             int                    `json:"number_of_deputies"              example:"68"`
+	NumberOfPropositions         int                    `json:"number_of_propositions"          example:"1254"`
+	PropositionTypes             []PropositionTypeCount `json:"proposition_types"`
+	NumberOfVotedPropositions    int                    `json:"number_of_voted_propositions"    example:"120"`
+	NumberOfApprovedPropositions int                    `json:"number_of_approved_propositions" example:"96"`
+	NumberOfRejectedPropositions int                    `json:"number_of_rejected_propositions" example:"24"`
+	ApprovalRate                 float64                `json:"approval_rate"                   example:"0.8"`
+	RejectionRate                float64                `json:"rejection_rate"                  example:"0.2"`
+	MonthlyActivity              []MonthlyActivity      `json:"monthly_activity"`
+}
@@ -0,0 +1,6 @@
+package swagger
+
+type PrivateCalendar struct {
+	Url       string `json:"url"        example:"https://api.vocenacamara.com.br/api/v1/calendar/private/5f0d3c1a9b8e4d2fa6c7b1e0d9f8a7b6/events.ics"`
+	WebcalUrl string `json:"webcal_url" example:"webcal://api.vocenacamara.com.br/api/v1/calendar/private/5f0d3c1a9b8e4d2fa6c7b1e0d9f8a7b6/events.ics"`
+}
@@ -0,0 +1,6 @@
+package swagger
+
+type PropositionTypeCount struct {
+	PropositionType      PropositionType `json:"proposition_type"`
+	NumberOfPropositions int             `json:"number_of_propositions" example:"42"`
+}
@@ -0,0 +1,8 @@
+package swagger
+
+type RapporteurshipPagination struct {
+	Page         int              `json:"page"           example:"1"`
+	ItemsPerPage int              `json:"items_per_page" example:"15"`
+	Total        int              `json:"total"          example:"27"`
+	Data         []Rapporteurship `json:"data"`
+}
@@ -0,0 +1,19 @@
+package swagger
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Rapporteurship struct {
+	Id                   uuid.UUID `json:"id"                     example:"0a4b4d4a-5d7c-4e8f-8f60-3f6a2b7c9d1e"`
+	Title                string    `json:"title"                  example:"PL 1234/2024"`
+	Topic                string    `json:"topic"                  example:"Dispõe sobre a transparência de dados públicos."`
+	Regime               string    `json:"regime"                 example:"Urgência (Art. 155, RICD)"`
+	Situation            string    `json:"situation"              example:"Aprovado o Parecer."`
+	PropositionArticleId uuid.UUID `json:"proposition_article_id" example:"5f8c4a4b-7d5e-4c51-9c3f-2b2d9b4e1a6f"`
+	PropositionTitle     string    `json:"proposition_title"      example:"Projeto garante acesso aberto a dados públicos"`
+	EventArticleId       uuid.UUID `json:"event_article_id"       example:"9e2d6b1c-3a4f-4b8e-9d7c-1f2e3a4b5c6d"`
+	EventTitle           string    `json:"event_title"            example:"Reunião Deliberativa Ordinária"`
+	EventStartsAt        time.Time `json:"event_starts_at"        example:"2024-05-14T10:00:00Z"`
+}
@@ -0,0 +1,10 @@
+package swagger
+
+import "time"
+
+type ReadingListItem struct {
+	Article  Article   `json:"article"`
+	Position int       `json:"position" example:"1"`
+	Note     string    `json:"note"     example:"Rever antes da votação no plenário"`
+	AddedAt  time.Time `json:"added_at" example:"2024-01-05T20:25:19.98031Z"`
+}
@@ -0,0 +1,18 @@
+package swagger
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ReadingList struct {
+	Id               uuid.UUID `json:"id"                 example:"5b4f1ad2-2c3b-4bb6-8d3a-6a3f0f5d2c7e"`
+	Name             string    `json:"name"               example:"Reforma tributária"`
+	Description      string    `json:"description"        example:"Proposições e votações sobre a reforma tributária"`
+	IsDefault        bool      `json:"is_default"         example:"false"`
+	IsPublic         bool      `json:"is_public"          example:"true"`
+	ShareUrl         string    `json:"share_url"          example:"https://vocenacamara.com.br/reading-lists/shared/9f86d081884c7d659a2feaa0c55ad015"`
+	NumberOfArticles int       `json:"number_of_articles" example:"12"`
+	CreatedAt        time.Time `json:"created_at"         example:"2024-01-05T20:25:19.98031Z"`
+	UpdatedAt        time.Time `json:"updated_at"         example:"2024-01-05T20:25:19.98031Z"`
+}
@@ -0,0 +1,10 @@
+package swagger
+
+import "github.com/google/uuid"
+
+type RecommendationReason struct {
+	Type         string    `json:"type"          example:"similar_content"`
+	Description  string    `json:"description"   example:"Porque você leu \"Altera a Lei nº 9.503, de 23 de setembro de 1997\""`
+	ArticleId    uuid.UUID `json:"article_id"    example:"e9b0f6b5-5c63-4b0e-9d43-1b2d3e2a8f1c"`
+	ArticleTitle string    `json:"article_title" example:"Altera a Lei nº 9.503, de 23 de setembro de 1997"`
+}
@@ -0,0 +1,6 @@
+package swagger
+
+type RecommendedArticle struct {
+	Article Article              `json:"article"`
+	Reason  RecommendationReason `json:"reason"`
+}
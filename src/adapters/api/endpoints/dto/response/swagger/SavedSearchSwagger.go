@@ -0,0 +1,17 @@
+package swagger
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type SavedSearch struct {
+	Id                  uuid.UUID `json:"id"                     example:"0f3e8a5c-6b1d-4c5e-9a7f-2d8b4e6c1a93"`
+	Name                string    `json:"name"                   example:"Reforma tributária aprovada"`
+	Query               string    `json:"query"                  example:"content=reforma+tribut%C3%A1ria&votingResult=approved"`
+	NotifyByEmail       bool      `json:"notify_by_email"        example:"true"`
+	NumberOfNewArticles int       `json:"number_of_new_articles" example:"3"`
+	LastOpenedAt        time.Time `json:"last_opened_at"         example:"2024-01-05T20:25:19.98031Z"`
+	CreatedAt           time.Time `json:"created_at"             example:"2024-01-05T20:25:19.98031Z"`
+	UpdatedAt           time.Time `json:"updated_at"             example:"2024-01-05T20:25:19.98031Z"`
+}
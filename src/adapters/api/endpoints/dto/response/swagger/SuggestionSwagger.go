@@ -0,0 +1,11 @@
+package swagger
+
+import "github.com/google/uuid"
+
+type Suggestion struct {
+	Id               uuid.UUID `json:"id"                example:"a4b04454-f426-44d2-843e-1331510b19ad"`
+	Type             string    `json:"type"              example:"deputy"`
+	Label            string    `json:"label"             example:"José do Povo"`
+	Description      string    `json:"description"       example:"PVNC-AL"`
+	FilterParameters []string  `json:"filter_parameters" example:"propositionDeputyId,eventRapporteurId"`
+}
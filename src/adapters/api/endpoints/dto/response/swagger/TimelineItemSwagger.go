@@ -0,0 +1,20 @@
+package swagger
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type TimelineItem struct {
+	Type                string    `json:"type"                  example:"voting"`
+	Date                time.Time `json:"date"                  example:"2024-01-05T20:25:19.98031Z"`
+	Title               string    `json:"title"                 example:"Votação 2438608-41"`
+	Description         string    `json:"description"           example:"Aprovado o Requerimento de Urgência."`
+	ArticleId           uuid.UUID `json:"article_id"            example:"5f8c4a4b-7d5e-4c51-9c3f-2b2d9b4e1a6f"`
+	LegislativeBody     string    `json:"legislative_body"      example:"PLEN"`
+	AgendaItemRegime    string    `json:"agenda_item_regime"    example:"Urgência (Art. 155, RICD)"`
+	AgendaItemSituation string    `json:"agenda_item_situation" example:"Aprovada"`
+	EventSituation      string    `json:"event_situation"       example:"Encerrada"`
+	VotingResult        string    `json:"voting_result"         example:"Aprovado o Requerimento."`
+	VotingIsApproved    bool      `json:"voting_is_approved"    example:"true"`
+}
@@ -0,0 +1,11 @@
+package swagger
+
+type VotingOutcome struct {
+	LegislativeBody           LegislativeBody `json:"legislative_body"`
+	PropositionType           PropositionType `json:"proposition_type"`
+	Month                     string          `json:"month"                        example:"2024-05"`
+	NumberOfApprovedVotes     int             `json:"number_of_approved_votes"     example:"12"`
+	NumberOfRejectedVotes     int             `json:"number_of_rejected_votes"     example:"3"`
+	NumberOfUndeterminedVotes int             `json:"number_of_undetermined_votes" example:"1"`
+	NumberOfVotes             int             `json:"number_of_votes"              example:"16"`
+}
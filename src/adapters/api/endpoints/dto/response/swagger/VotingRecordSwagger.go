@@ -0,0 +1,19 @@
+package swagger
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type VotingRecord struct {
+	Id                      uuid.UUID       `json:"id"                         example:"3c1e2b7a-8d4f-4a6e-9b2c-5d7e8f9a0b1c"`
+	VotingArticleId         uuid.UUID       `json:"voting_article_id"          example:"d369b9bc-c226-4bbf-8fbb-fceed205845a"`
+	VotingTitle             string          `json:"voting_title"               example:"Votação 3457539-42"`
+	VotingResult            string          `json:"voting_result"              example:"Aprovado o Substitutivo ao Projeto de Lei nº 1..."`
+	VotingResultAnnouncedAt time.Time       `json:"voting_result_announced_at" example:"2023-05-18T20:17:32Z"`
+	VotingIsApproved        bool            `json:"voting_is_approved"         example:"true"`
+	LegislativeBody         LegislativeBody `json:"legislative_body"`
+	Party                   Party           `json:"party"`
+	Vote                    string          `json:"vote"                       example:"yes"`
+	PartyOrientation        string          `json:"party_orientation"          example:"free"`
+}
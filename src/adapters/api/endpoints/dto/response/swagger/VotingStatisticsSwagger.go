@@ -0,0 +1,9 @@
+package swagger
+
+type VotingStatistics struct {
+	NumberOfApprovedVotes     int             `json:"number_of_approved_votes"     example:"842"`
+	NumberOfRejectedVotes     int             `json:"number_of_rejected_votes"     example:"213"`
+	NumberOfUndeterminedVotes int             `json:"number_of_undetermined_votes" example:"57"`
+	NumberOfVotes             int             `json:"number_of_votes"              example:"1112"`
+	Outcomes                  []VotingOutcome `json:"outcomes"`
+}
@@ -1,16 +1,20 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/gommon/log"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 	"vnc-api/adapters/api/endpoints/dto/request"
 	"vnc-api/adapters/api/endpoints/dto/response"
 	"vnc-api/adapters/api/endpoints/handlers/utils"
+	"vnc-api/core/domains/articleoperation"
+	domainutils "vnc-api/core/domains/utils"
 	"vnc-api/core/filters"
 	"vnc-api/core/interfaces/services"
 )
@@ -22,11 +26,14 @@ type Article struct {
 	votingService      services.Voting
 	eventService       services.Event
 	newsletterService  services.Newsletter
+	searchService      services.Search
+	articleViewService services.ArticleView
 }
 
 func NewArticleHandler(articleService services.Article, resourceService services.Resources,
 	propositionService services.Proposition, votingService services.Voting, eventService services.Event,
-	newsletterService services.Newsletter) *Article {
+	newsletterService services.Newsletter, searchService services.Search,
+	articleViewService services.ArticleView) *Article {
 	return &Article{
 		articleService:     articleService,
 		resourceService:    resourceService,
@@ -34,6 +41,8 @@ func NewArticleHandler(articleService services.Article, resourceService services
 		votingService:      votingService,
 		eventService:       eventService,
 		newsletterService:  newsletterService,
+		searchService:      searchService,
+		articleViewService: articleViewService,
 	}
 }
 
@@ -41,7 +50,7 @@ func NewArticleHandler(articleService services.Article, resourceService services
 // @ID          GetArticles
 // @Summary     List most recent articles
 // @Tags        Articles
-// @Description This request is responsible for listing the most recent articles available on the platform.
+// @Description This request is responsible for listing the most recent articles available on the platform. When a search by content returns no articles, the response includes spelling suggestions and a corrected query.
 // @Security    BearerAuth
 // @Produce     json
 // @Param       typeId                      query string false "Article type ID"
@@ -52,6 +61,7 @@ func NewArticleHandler(articleService services.Article, resourceService services
 // @Param       propositionDeputyId         query string false "ID of the deputy who drafted the proposition"
 // @Param       propositionPartyId          query string false "ID of the party that drafted the proposition"
 // @Param       propositionExternalAuthorId query string false "ID of the external author who drafted the proposition"
+// @Param       propositionFederatedUnit    query string false "Federated units (UFs) represented by the deputies who drafted the proposition, at the time it was drafted, separated by commas. Example: MG,SP"
 // @Param       votingStartDate             query string false "Date from which the voting results were announced. Accepted format: YYYY-MM-DD"
 // @Param       votingEndDate               query string false "Date until which the voting results were announced. Accepted format: YYYY-MM-DD"
 // @Param       votingResult                query string false "Voting result. Accepted values: approved, rejected and undetermined"
@@ -62,6 +72,7 @@ func NewArticleHandler(articleService services.Article, resourceService services
 // @Param       eventLegislativeBodyId      query string false "ID of the legislative body responsible for the event"
 // @Param       eventRapporteurId           query string false "ID of the rapporteur (deputy) for one or more items on the event agenda"
 // @Param       removeEventsInTheFuture     query bool   false "Remove events in the future?"
+// @Param       excludeRead                 query bool   false "Remove articles already read by the user? Only applies to authenticated users"
 // @Param       page                        query int    false "Page number. By default, it is 1"
 // @Param       itemsPerPage                query int    false "Number of articles returned per page. The default is 15 and the allowed values are between 1 and 100"
 // @Success 200 {object} swagger.ArticlePagination "Successful request"
@@ -80,6 +91,17 @@ func (instance Article) GetArticles(context echo.Context) error {
 		return context.JSON(httpError.Code, httpError)
 	}
 
+	excludeReadParameter := context.QueryParam("excludeRead")
+	if excludeReadParameter != "" {
+		parameter, parameterDescription := "excludeRead", "Remove articles already read by the user?"
+		excludeRead, httpError := utils.ConvertFromStringToBool(excludeReadParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the excludeRead parameter: ", httpError.Message)
+			return context.JSON(httpError.Code, httpError)
+		}
+		articleFilter.ExcludeRead = excludeRead
+	}
+
 	articleSlice, totalNumberOfArticles, err := instance.articleService.GetArticles(*articleFilter, userId)
 	if err != nil {
 		if strings.Contains(err.Error(), "connection refused") {
@@ -103,13 +125,27 @@ func (instance Article) GetArticles(context echo.Context) error {
 		Data:         articles,
 	}
 
+	if totalNumberOfArticles == 0 && articleFilter.Content != "" {
+		correctedQuery, suggestions, err := instance.searchService.GetSpellingSuggestions(articleFilter.Content)
+		if err != nil {
+			log.Error("Error retrieving spelling suggestions: ", err.Error())
+		} else {
+			requestResult.CorrectedQuery = correctedQuery
+			requestResult.Suggestions = suggestions
+		}
+	}
+
 	return context.JSON(http.StatusOK, requestResult)
 }
 
 func getArticleQueryParametersFromContext(context echo.Context) (*filters.Article, *response.HttpError) {
+	return getArticleFilterFromQueryParameters(context.QueryParams())
+}
+
+func getArticleFilterFromQueryParameters(queryParameters url.Values) (*filters.Article, *response.HttpError) {
 	var articleFilter filters.Article
 
-	typeIdParameter := context.QueryParam("typeId")
+	typeIdParameter := queryParameters.Get("typeId")
 	if typeIdParameter != "" {
 		parameter, parameterDescription := "typeId", "Article type ID"
 		typeId, httpError := utils.ConvertFromStringToUuid(typeIdParameter, parameter, parameterDescription)
@@ -120,7 +156,7 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 		articleFilter.TypeId = &typeId
 	}
 
-	specificTypeIdParameter := context.QueryParam("specificTypeId")
+	specificTypeIdParameter := queryParameters.Get("specificTypeId")
 	if specificTypeIdParameter != "" {
 		parameter, parameterDescription := "specificTypeId", "Article specific type ID"
 		specificTypeId, httpError := utils.ConvertFromStringToUuid(specificTypeIdParameter, parameter,
@@ -132,9 +168,9 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 		articleFilter.SpecificTypeId = &specificTypeId
 	}
 
-	articleFilter.Content = context.QueryParam("content")
+	articleFilter.Content = queryParameters.Get("content")
 
-	startDateParameter := context.QueryParam("startDate")
+	startDateParameter := queryParameters.Get("startDate")
 	if startDateParameter != "" {
 		parameter, parameterDescription := "startDate", "Article start date"
 		startDate, httpError := utils.ConvertFromStringToTime(startDateParameter, parameter, parameterDescription)
@@ -145,7 +181,7 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 		articleFilter.StartDate = &startDate
 	}
 
-	endDateParameter := context.QueryParam("endDate")
+	endDateParameter := queryParameters.Get("endDate")
 	if endDateParameter != "" {
 		parameter, parameterDescription := "endDate", "Article end date"
 		endDate, httpError := utils.ConvertFromStringToTime(endDateParameter, parameter, parameterDescription)
@@ -164,7 +200,7 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 		return nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
 	}
 
-	propositionDeputyIdParameter := context.QueryParam("propositionDeputyId")
+	propositionDeputyIdParameter := queryParameters.Get("propositionDeputyId")
 	if propositionDeputyIdParameter != "" {
 		parameter, parameterDescription := "propositionDeputyId", "Proposition deputy ID"
 		propositionDeputyId, httpError := utils.ConvertFromStringToUuid(propositionDeputyIdParameter, parameter,
@@ -176,7 +212,7 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 		articleFilter.Proposition.DeputyId = &propositionDeputyId
 	}
 
-	propositionPartyIdParameter := context.QueryParam("propositionPartyId")
+	propositionPartyIdParameter := queryParameters.Get("propositionPartyId")
 	if propositionPartyIdParameter != "" {
 		parameter, parameterDescription := "propositionPartyId", "Proposition party ID"
 		propositionPartyId, httpError := utils.ConvertFromStringToUuid(propositionPartyIdParameter, parameter,
@@ -188,7 +224,7 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 		articleFilter.Proposition.PartyId = &propositionPartyId
 	}
 
-	propositionExternalAuthorIdParameter := context.QueryParam("propositionExternalAuthorId")
+	propositionExternalAuthorIdParameter := queryParameters.Get("propositionExternalAuthorId")
 	if propositionExternalAuthorIdParameter != "" {
 		parameter, parameterDescription := "propositionExternalAuthorId", "Proposition external author ID"
 		propositionExternalAuthorId, httpError := utils.ConvertFromStringToUuid(propositionExternalAuthorIdParameter,
@@ -200,62 +236,26 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 		articleFilter.Proposition.ExternalAuthorId = &propositionExternalAuthorId
 	}
 
-	votingStartDateParameter := context.QueryParam("votingStartDate")
-	if votingStartDateParameter != "" {
-		parameter, parameterDescription := "votingStartDate", "Voting start date"
-		votingStartDate, httpError := utils.ConvertFromStringToTime(votingStartDateParameter, parameter,
-			parameterDescription)
-		if httpError != nil {
-			log.Warn("Error converting the votingStartDate parameter: ", httpError.Message)
-			return nil, httpError
-		}
-		articleFilter.Voting.StartDate = &votingStartDate
-	}
-
-	votingEndDateParameter := context.QueryParam("votingEndDate")
-	if votingEndDateParameter != "" {
-		parameter, parameterDescription := "votingEndDate", "Voting end date"
-		votingEndDate, httpError := utils.ConvertFromStringToTime(votingEndDateParameter, parameter,
-			parameterDescription)
-		if httpError != nil {
-			log.Warn("Error converting the votingEndDate parameter: ", httpError.Message)
-			return nil, httpError
-		}
-		articleFilter.Voting.EndDate = &votingEndDate
-	}
-
-	if articleFilter.Voting.StartDate != nil && articleFilter.Voting.EndDate != nil &&
-		articleFilter.Voting.StartDate.After(*articleFilter.Voting.EndDate) {
-		errorMessage := fmt.Sprint("Invalid parameters: The voting start date parameter (votingStartDate) " +
-			"cannot be greater than the voting end date parameter (votingEndDate)")
-		log.Warn(errorMessage)
-		return nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
-	}
-
-	votingResultParameter := context.QueryParam("votingResult")
-	if votingResultParameter != "" {
-		if votingResultParameter != "approved" && votingResultParameter != "rejected" &&
-			votingResultParameter != "undetermined" {
-			errorMessage := fmt.Sprint("Invalid parameter: Voting result (votingResult)")
-			log.Warn(errorMessage)
-			return nil, response.NewHttpError(http.StatusBadRequest, errorMessage)
+	for _, propositionFederatedUnitParameter := range queryParameters["propositionFederatedUnit"] {
+		for _, propositionFederatedUnit := range strings.Split(propositionFederatedUnitParameter, ",") {
+			propositionFederatedUnit = strings.ToUpper(strings.TrimSpace(propositionFederatedUnit))
+			if !domainutils.IsFederatedUnitValid(propositionFederatedUnit) {
+				errorMessage := fmt.Sprint("Invalid parameter: Proposition federated unit (propositionFederatedUnit)")
+				log.Warnf("%s (Value: %s)", errorMessage, propositionFederatedUnitParameter)
+				return nil, response.NewHttpError(http.StatusBadRequest, errorMessage)
+			}
+			articleFilter.Proposition.FederatedUnits = append(articleFilter.Proposition.FederatedUnits,
+				propositionFederatedUnit)
 		}
-		articleFilter.Voting.Result = votingResultParameter
 	}
 
-	votingLegislativeBodyIdParameter := context.QueryParam("votingLegislativeBodyId")
-	if votingLegislativeBodyIdParameter != "" {
-		parameter, parameterDescription := "votingLegislativeBodyId", "Voting legislative body ID"
-		votingLegislativeBodyId, httpError := utils.ConvertFromStringToUuid(votingLegislativeBodyIdParameter, parameter,
-			parameterDescription)
-		if httpError != nil {
-			log.Warn("Error converting the votingLegislativeBodyId parameter: ", httpError.Message)
-			return nil, httpError
-		}
-		articleFilter.Voting.LegislativeBodyId = &votingLegislativeBodyId
+	votingFilter, httpError := getVotingFilterFromQueryParameters(queryParameters)
+	if httpError != nil {
+		return nil, httpError
 	}
+	articleFilter.Voting = *votingFilter
 
-	eventStartDateParameter := context.QueryParam("eventStartDate")
+	eventStartDateParameter := queryParameters.Get("eventStartDate")
 	if eventStartDateParameter != "" {
 		parameter, parameterDescription := "eventStartDate", "Event start date"
 		eventStartDate, httpError := utils.ConvertFromStringToTime(eventStartDateParameter, parameter,
@@ -267,7 +267,7 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 		articleFilter.Event.StartDate = &eventStartDate
 	}
 
-	eventEndDateParameter := context.QueryParam("eventEndDate")
+	eventEndDateParameter := queryParameters.Get("eventEndDate")
 	if eventEndDateParameter != "" {
 		parameter, parameterDescription := "eventEndDate", "Event end date"
 		eventEndDate, httpError := utils.ConvertFromStringToTime(eventEndDateParameter, parameter, parameterDescription)
@@ -286,7 +286,7 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 		return nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
 	}
 
-	eventSituationIdParameter := context.QueryParam("eventSituationId")
+	eventSituationIdParameter := queryParameters.Get("eventSituationId")
 	if eventSituationIdParameter != "" {
 		parameter, parameterDescription := "eventSituationId", "Event situation ID"
 		eventSituationId, httpError := utils.ConvertFromStringToUuid(eventSituationIdParameter, parameter,
@@ -298,7 +298,7 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 		articleFilter.Event.SituationId = &eventSituationId
 	}
 
-	eventLegislativeBodyIdParameter := context.QueryParam("eventLegislativeBodyId")
+	eventLegislativeBodyIdParameter := queryParameters.Get("eventLegislativeBodyId")
 	if eventLegislativeBodyIdParameter != "" {
 		parameter, parameterDescription := "eventLegislativeBodyId", "Event legislative body ID"
 		eventLegislativeBodyId, httpError := utils.ConvertFromStringToUuid(eventLegislativeBodyIdParameter, parameter,
@@ -310,7 +310,7 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 		articleFilter.Event.LegislativeBodyId = &eventLegislativeBodyId
 	}
 
-	eventRapporteurIdParameter := context.QueryParam("eventRapporteurId")
+	eventRapporteurIdParameter := queryParameters.Get("eventRapporteurId")
 	if eventRapporteurIdParameter != "" {
 		parameter, parameterDescription := "eventRapporteurId", "Event rapporteur ID"
 		eventRapporteurId, httpError := utils.ConvertFromStringToUuid(eventRapporteurIdParameter, parameter,
@@ -322,7 +322,7 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 		articleFilter.Event.RapporteurId = &eventRapporteurId
 	}
 
-	removeEventsInTheFutureParameter := context.QueryParam("removeEventsInTheFuture")
+	removeEventsInTheFutureParameter := queryParameters.Get("removeEventsInTheFuture")
 	if removeEventsInTheFutureParameter != "" {
 		parameter, parameterDescription := "removeEventsInTheFuture", "Remove events in the future?"
 		removeEventsInTheFuture, httpError := utils.ConvertFromStringToBool(removeEventsInTheFutureParameter, parameter,
@@ -345,7 +345,7 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 		return nil, response.NewHttpError(http.StatusUnprocessableEntity, err.Error())
 	}
 
-	pageParameter := context.QueryParam("page")
+	pageParameter := queryParameters.Get("page")
 	if pageParameter != "" {
 		parameter, parameterDescription := "page", "Page"
 		page, httpError := utils.ConvertFromStringToInt(pageParameter, parameter, parameterDescription)
@@ -356,7 +356,7 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 		articleFilter.Pagination.Page = &page
 	}
 
-	itemsPerPageParameter := context.QueryParam("itemsPerPage")
+	itemsPerPageParameter := queryParameters.Get("itemsPerPage")
 	if itemsPerPageParameter != "" {
 		parameter, parameterDescription := "itemsPerPage", "Items per page"
 		itemsPerPage, httpError := utils.ConvertFromStringToInt(itemsPerPageParameter, parameter, parameterDescription)
@@ -393,6 +393,7 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 // @Param       propositionDeputyId         query string false "ID of the deputy who drafted the proposition"
 // @Param       propositionPartyId          query string false "ID of the party that drafted the proposition"
 // @Param       propositionExternalAuthorId query string false "ID of the external author who drafted the proposition"
+// @Param       propositionFederatedUnit    query string false "Federated units (UFs) represented by the deputies who drafted the proposition, at the time it was drafted, separated by commas. Example: MG,SP"
 // @Param       votingStartDate             query string false "Date from which the voting results were announced. Accepted format: YYYY-MM-DD"
 // @Param       votingEndDate               query string false "Date until which the voting results were announced. Accepted format: YYYY-MM-DD"
 // @Param       votingResult                query string false "Voting result. Accepted values: approved, rejected and undetermined"
@@ -405,6 +406,7 @@ func getArticleQueryParametersFromContext(context echo.Context) (*filters.Articl
 // @Param       removeEventsInTheFuture     query bool   false "Remove events in the future?"
 // @Param       page                        query int    false "Page number. By default, it is 1"
 // @Param       itemsPerPage                query int    false "Number of articles returned per page. The default is 15 and the allowed values are between 1 and 100"
+// @Param       window                      query string false "Period considered when calculating the trending score. Accepted values: 24h, 7d and 30d. The default is 7d"
 // @Success 200 {object} swagger.ArticlePagination "Successful request"
 // @Failure 400 {object} swagger.HttpError         "Badly formatted request"
 // @Failure 401 {object} swagger.HttpError         "Unauthorized access"
@@ -421,7 +423,14 @@ func (instance Article) GetTrendingArticles(context echo.Context) error {
 		return context.JSON(httpError.Code, httpError)
 	}
 
-	articleSlice, totalNumberOfArticles, err := instance.articleService.GetTrendingArticles(*articleFilter, userId)
+	trendingFilter, httpError := getTrendingQueryParametersFromContext(context)
+	if httpError != nil {
+		log.Warn("getTrendingQueryParametersFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	articleSlice, totalNumberOfArticles, err := instance.articleService.GetTrendingArticles(*articleFilter,
+		*trendingFilter, userId)
 	if err != nil {
 		if strings.Contains(err.Error(), "connection refused") {
 			log.Error("Database unavailable: ", err.Error())
@@ -447,6 +456,22 @@ func (instance Article) GetTrendingArticles(context echo.Context) error {
 	return context.JSON(http.StatusOK, requestResult)
 }
 
+func getTrendingQueryParametersFromContext(context echo.Context) (*filters.Trending, *response.HttpError) {
+	var trendingFilter filters.Trending
+
+	windowParameter := context.QueryParam("window")
+	if windowParameter != "" {
+		if !filters.IsTrendingWindowValid(windowParameter) {
+			errorMessage := fmt.Sprint("Invalid parameter: Trending window (window)")
+			log.Warn(errorMessage)
+			return nil, response.NewHttpError(http.StatusBadRequest, errorMessage)
+		}
+		trendingFilter.Window = windowParameter
+	}
+
+	return &trendingFilter, nil
+}
+
 // GetTrendingArticlesByType
 // @ID          GetTrendingArticlesByType
 // @Summary     List trending articles by article types
@@ -457,6 +482,7 @@ func (instance Article) GetTrendingArticles(context echo.Context) error {
 // @Param       articleTypeIds         query string false "List of IDs of the types of articles that should be returned (separated by commas). By default, it returns all types"
 // @Param       articleSpecificTypeIds query string false "List of IDs of the specific types of articles that should be returned (separated by commas). By default, it returns all specific types"
 // @Param       itemsPerType           query int    false "Number of articles returned by type. The default is 5 and the allowed values are between 1 and 20"
+// @Param       window                 query string false "Period considered when calculating the trending score. Accepted values: 24h, 7d and 30d. The default is 7d"
 // @Success 200 {object} swagger.ArticleTypeWithSpecificTypes "Successful request"
 // @Failure 400 {object} swagger.HttpError                    "Badly formatted request"
 // @Failure 401 {object} swagger.HttpError                    "Unauthorized access"
@@ -519,11 +545,17 @@ func (instance Article) GetTrendingArticlesByType(context echo.Context) error {
 		}
 	}
 
+	trendingFilter, httpError := getTrendingQueryParametersFromContext(context)
+	if httpError != nil {
+		log.Warn("getTrendingQueryParametersFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
 	articleTypeSlice := make([]response.ArticleType, 0)
 	if articleSpecificTypeIds != nil {
 		for _, articleSpecificTypeId := range articleSpecificTypeIds {
 			trendingArticles, err := instance.articleService.GetTrendingArticlesBySpecificTypeId(articleSpecificTypeId,
-				itemsPerType, userId)
+				itemsPerType, *trendingFilter, userId)
 			if err != nil {
 				if strings.Contains(err.Error(), "connection refused") {
 					log.Error("Database unavailable: ", err.Error())
@@ -557,7 +589,7 @@ func (instance Article) GetTrendingArticlesByType(context echo.Context) error {
 
 			for _, articleTypeId := range articleTypesWithoutArticles {
 				trendingArticles, err := instance.articleService.GetTrendingArticlesByTypeId(articleTypeId,
-					itemsPerType, userId)
+					itemsPerType, *trendingFilter, userId)
 				if err != nil {
 					if strings.Contains(err.Error(), "connection refused") {
 						log.Error("Database unavailable: ", err.Error())
@@ -574,7 +606,7 @@ func (instance Article) GetTrendingArticlesByType(context echo.Context) error {
 	} else if articleTypeIds != nil {
 		for _, articleTypeId := range articleTypeIds {
 			trendingArticles, err := instance.articleService.GetTrendingArticlesByTypeId(articleTypeId, itemsPerType,
-				userId)
+				*trendingFilter, userId)
 			if err != nil {
 				if strings.Contains(err.Error(), "connection refused") {
 					log.Error("Database unavailable: ", err.Error())
@@ -614,7 +646,7 @@ func (instance Article) GetTrendingArticlesByType(context echo.Context) error {
 
 				for _, propositionType := range propositionTypes {
 					trendingArticles, err := instance.articleService.GetTrendingArticlesBySpecificTypeId(
-						propositionType.Id(), itemsPerType, userId)
+						propositionType.Id(), itemsPerType, *trendingFilter, userId)
 					if err != nil {
 						if strings.Contains(err.Error(), "connection refused") {
 							log.Error("Database unavailable: ", err.Error())
@@ -643,7 +675,7 @@ func (instance Article) GetTrendingArticlesByType(context echo.Context) error {
 
 				for _, eventType := range eventTypes {
 					trendingArticles, err := instance.articleService.GetTrendingArticlesBySpecificTypeId(eventType.Id(),
-						itemsPerType, userId)
+						itemsPerType, *trendingFilter, userId)
 					if err != nil {
 						if strings.Contains(err.Error(), "connection refused") {
 							log.Error("Database unavailable: ", err.Error())
@@ -660,7 +692,7 @@ func (instance Article) GetTrendingArticlesByType(context echo.Context) error {
 				}
 			} else {
 				trendingArticles, err := instance.articleService.GetTrendingArticlesByTypeId(articleType.Id(),
-					itemsPerType, userId)
+					itemsPerType, *trendingFilter, userId)
 				if err != nil {
 					if strings.Contains(err.Error(), "connection refused") {
 						log.Error("Database unavailable: ", err.Error())
@@ -680,11 +712,138 @@ func (instance Article) GetTrendingArticlesByType(context echo.Context) error {
 	return context.JSON(http.StatusOK, articleTypeSlice)
 }
 
+// GetRecommendedArticles
+// @ID          GetRecommendedArticles
+// @Summary     List recommended articles
+// @Tags        Articles
+// @Description This request is responsible for listing the articles recommended to the user based on the articles they read and rated, together with the reason for each recommendation. Anonymous users and users without reading history receive the trending articles.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       limit query int false "Number of articles returned. The default is 15 and the allowed values are between 1 and 50"
+// @Success 200 {array}  swagger.RecommendedArticle "Successful request"
+// @Failure 400 {object} swagger.HttpError          "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError          "Unauthorized access"
+// @Failure 422 {object} swagger.HttpError          "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError          "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError          "Some of the services/resources are temporarily unavailable"
+// @Router /articles/recommended [GET]
+func (instance Article) GetRecommendedArticles(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	limit := filters.DefaultNumberOfRecommendationsFilter
+	limitParameter := context.QueryParam("limit")
+	if limitParameter != "" {
+		var httpError *response.HttpError
+		parameter, parameterDescription := "limit", "Limit"
+		limit, httpError = utils.ConvertFromStringToInt(limitParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the limit parameter: ", httpError.Message)
+			return context.JSON(httpError.Code, httpError)
+		}
+
+		if limit > filters.MaximumNumberOfRecommendationsFilter {
+			errorMessage := fmt.Sprint("Invalid parameter: Limit (limit) must be less than or equal to ",
+				filters.MaximumNumberOfRecommendationsFilter)
+			log.Warnf("Parameter out of allowed range: %s (Value: %d)", errorMessage, limit)
+			return context.JSON(http.StatusUnprocessableEntity, response.NewHttpError(http.StatusUnprocessableEntity,
+				errorMessage))
+		}
+	}
+
+	recommendations, err := instance.articleService.GetRecommendedArticles(limit, userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving recommended articles for user %s: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	recommendedArticles := make([]response.RecommendedArticle, 0)
+	for _, recommendationData := range recommendations {
+		recommendedArticles = append(recommendedArticles, *response.NewRecommendedArticle(recommendationData))
+	}
+
+	return context.JSON(http.StatusOK, recommendedArticles)
+}
+
+// GetFollowedArticles
+// @ID          GetFollowedArticles
+// @Summary     List the most recent articles about the resources followed by the user
+// @Tags        Articles
+// @Description This request is responsible for listing the most recent propositions, votes and events related to the deputies, parties, external authors, proposition types, legislative bodies and propositions followed by the user.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       typeId                      query string false "Article type ID"
+// @Param       specificTypeId              query string false "Article specific type ID"
+// @Param       content                     query string false "Part of the content of the articles, in the title or content"
+// @Param       startDate                   query string false "Date from which the articles were created. Accepted format: YYYY-MM-DD"
+// @Param       endDate                     query string false "Date until which the articles were created. Accepted format: YYYY-MM-DD"
+// @Param       propositionDeputyId         query string false "ID of the deputy who drafted the proposition"
+// @Param       propositionPartyId          query string false "ID of the party that drafted the proposition"
+// @Param       propositionExternalAuthorId query string false "ID of the external author who drafted the proposition"
+// @Param       propositionFederatedUnit    query string false "Federated units (UFs) represented by the deputies who drafted the proposition, at the time it was drafted, separated by commas. Example: MG,SP"
+// @Param       votingStartDate             query string false "Date from which the voting results were announced. Accepted format: YYYY-MM-DD"
+// @Param       votingEndDate               query string false "Date until which the voting results were announced. Accepted format: YYYY-MM-DD"
+// @Param       votingResult                query string false "Voting result. Accepted values: approved, rejected and undetermined"
+// @Param       votingLegislativeBodyId     query string false "ID of the legislative body responsible for the voting"
+// @Param       eventStartDate              query string false "Date from which the events occurred. Accepted format: YYYY-MM-DD"
+// @Param       eventEndDate                query string false "Date until which the events occurred. Accepted format: YYYY-MM-DD"
+// @Param       eventSituationId            query string false "ID of the event situation"
+// @Param       eventLegislativeBodyId      query string false "ID of the legislative body responsible for the event"
+// @Param       eventRapporteurId           query string false "ID of the rapporteur (deputy) for one or more items on the event agenda"
+// @Param       removeEventsInTheFuture     query bool   false "Remove events in the future?"
+// @Param       page                        query int    false "Page number. By default, it is 1"
+// @Param       itemsPerPage                query int    false "Number of articles returned per page. The default is 15 and the allowed values are between 1 and 100"
+// @Success 200 {object} swagger.ArticlePagination "Successful request"
+// @Failure 400 {object} swagger.HttpError         "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError         "Unauthorized access"
+// @Failure 422 {object} swagger.HttpError         "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError         "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError         "Some of the services/resources are temporarily unavailable"
+// @Router /articles/following [GET]
+func (instance Article) GetFollowedArticles(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	articleFilter, httpError := getArticleQueryParametersFromContext(context)
+	if httpError != nil {
+		log.Warn("getArticleQueryParametersFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	articleSlice, totalNumberOfArticles, err := instance.articleService.GetFollowedArticles(*articleFilter, userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the articles followed by user %s: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	articles := make([]response.Article, 0)
+	for _, articleData := range articleSlice {
+		articles = append(articles, *response.NewArticle(articleData))
+	}
+
+	requestResult := response.Pagination{
+		Page:         articleFilter.Pagination.GetPage(),
+		ItemsPerPage: articleFilter.Pagination.GetItemsPerPage(),
+		Total:        totalNumberOfArticles,
+		Data:         articles,
+	}
+
+	return context.JSON(http.StatusOK, requestResult)
+}
+
 // GetArticlesToViewLater
 // @ID          GetArticlesToViewLater
 // @Summary     List articles bookmarked for later viewing by the user
 // @Tags        Articles
-// @Description This request is responsible for listing the articles bookmarked for later viewing by the user on the platform. The articles will be listed in the order in which the user bookmarked the articles.
+// @Description This request is responsible for listing the articles bookmarked for later viewing by the user on the platform. The articles will be listed in the order of the default reading list of the user, which can be reordered by the user.
 // @Security    BearerAuth
 // @Produce     json
 // @Param       typeId                      query string false "Article type ID"
@@ -695,6 +854,7 @@ func (instance Article) GetTrendingArticlesByType(context echo.Context) error {
 // @Param       propositionDeputyId         query string false "ID of the deputy who drafted the proposition"
 // @Param       propositionPartyId          query string false "ID of the party that drafted the proposition"
 // @Param       propositionExternalAuthorId query string false "ID of the external author who drafted the proposition"
+// @Param       propositionFederatedUnit    query string false "Federated units (UFs) represented by the deputies who drafted the proposition, at the time it was drafted, separated by commas. Example: MG,SP"
 // @Param       votingStartDate             query string false "Date from which the voting results were announced. Accepted format: YYYY-MM-DD"
 // @Param       votingEndDate               query string false "Date until which the voting results were announced. Accepted format: YYYY-MM-DD"
 // @Param       votingResult                query string false "Voting result. Accepted values: approved, rejected and undetermined"
@@ -749,6 +909,154 @@ func (instance Article) GetArticlesToViewLater(context echo.Context) error {
 	return context.JSON(http.StatusOK, requestResult)
 }
 
+// GetArticlesFromHistory
+// @ID          GetArticlesFromHistory
+// @Summary     List articles in the reading history of the user
+// @Tags        Articles
+// @Description This request is responsible for listing the articles already read by the user on the platform. The articles will be listed from the most recently viewed to the least recently viewed.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       typeId                      query string false "Article type ID"
+// @Param       specificTypeId              query string false "Article specific type ID"
+// @Param       content                     query string false "Part of the content of the articles, in the title or content"
+// @Param       startDate                   query string false "Date from which the articles were created. Accepted format: YYYY-MM-DD"
+// @Param       endDate                     query string false "Date until which the articles were created. Accepted format: YYYY-MM-DD"
+// @Param       propositionDeputyId         query string false "ID of the deputy who drafted the proposition"
+// @Param       propositionPartyId          query string false "ID of the party that drafted the proposition"
+// @Param       propositionExternalAuthorId query string false "ID of the external author who drafted the proposition"
+// @Param       propositionFederatedUnit    query string false "Federated units (UFs) represented by the deputies who drafted the proposition, at the time it was drafted, separated by commas. Example: MG,SP"
+// @Param       votingStartDate             query string false "Date from which the voting results were announced. Accepted format: YYYY-MM-DD"
+// @Param       votingEndDate               query string false "Date until which the voting results were announced. Accepted format: YYYY-MM-DD"
+// @Param       votingResult                query string false "Voting result. Accepted values: approved, rejected and undetermined"
+// @Param       votingLegislativeBodyId     query string false "ID of the legislative body responsible for the voting"
+// @Param       eventStartDate              query string false "Date from which the events occurred. Accepted format: YYYY-MM-DD"
+// @Param       eventEndDate                query string false "Date until which the events occurred. Accepted format: YYYY-MM-DD"
+// @Param       eventSituationId            query string false "ID of the event situation"
+// @Param       eventLegislativeBodyId      query string false "ID of the legislative body responsible for the event"
+// @Param       eventRapporteurId           query string false "ID of the rapporteur (deputy) for one or more items on the event agenda"
+// @Param       removeEventsInTheFuture     query bool   false "Remove events in the future?"
+// @Param       page                        query int    false "Page number. By default, it is 1"
+// @Param       itemsPerPage                query int    false "Number of articles returned per page. The default is 15 and the allowed values are between 1 and 100"
+// @Success 200 {object} swagger.ArticlePagination "Successful request"
+// @Failure 400 {object} swagger.HttpError         "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError         "Unauthorized access"
+// @Failure 422 {object} swagger.HttpError         "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError         "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError         "Some of the services/resources are temporarily unavailable"
+// @Router /articles/history [GET]
+func (instance Article) GetArticlesFromHistory(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	articleFilter, httpError := getArticleQueryParametersFromContext(context)
+	if httpError != nil {
+		log.Warn("getArticleQueryParametersFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	articleSlice, totalNumberOfArticles, err := instance.articleService.GetArticlesFromHistory(*articleFilter, userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the reading history of user %s: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	articles := make([]response.Article, 0)
+	for _, articleData := range articleSlice {
+		articles = append(articles, *response.NewArticle(articleData))
+	}
+
+	requestResult := response.Pagination{
+		Page:         articleFilter.Pagination.GetPage(),
+		ItemsPerPage: articleFilter.Pagination.GetItemsPerPage(),
+		Total:        totalNumberOfArticles,
+		Data:         articles,
+	}
+
+	return context.JSON(http.StatusOK, requestResult)
+}
+
+// DeleteArticleFromHistory
+// @ID          DeleteArticleFromHistory
+// @Summary     Remove an article from the reading history of the user
+// @Tags        Articles
+// @Description This request is responsible for removing an article from the reading history of the user. The views of the article are kept for the platform statistics, but are no longer associated with the user.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       articleId path string true "Article ID"
+// @Success 204 {object} nil               "Successful request"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 403 {object} swagger.HttpError "Access denied"
+// @Failure 404 {object} swagger.HttpError "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /articles/history/{articleId} [DELETE]
+func (instance Article) DeleteArticleFromHistory(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	articleIdParameter := context.Param("articleId")
+	parameter, parameterDescription := "articleId", "Article ID"
+	articleId, httpError := utils.ConvertFromStringToUuid(articleIdParameter, parameter, parameterDescription)
+	if httpError != nil {
+		log.Warn("Error converting the articleId parameter: ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	err := instance.articleService.DeleteArticleFromHistory(userId, articleId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Article %s could not be found in the reading history of user %s: %s", articleId, userId,
+				err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Article not found in the reading history"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error removing article %s from the reading history of user %s: %s", articleId, userId,
+			err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.NoContent(http.StatusNoContent)
+}
+
+// ClearHistory
+// @ID          ClearHistory
+// @Summary     Clear the reading history of the user
+// @Tags        Articles
+// @Description This request is responsible for removing all articles from the reading history of the user. The views of the articles are kept for the platform statistics, but are no longer associated with the user.
+// @Security    BearerAuth
+// @Produce     json
+// @Success 204 {object} nil               "Successful request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 403 {object} swagger.HttpError "Access denied"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /articles/history [DELETE]
+func (instance Article) ClearHistory(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	err := instance.articleService.ClearHistory(userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error clearing the reading history of user %s: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.NoContent(http.StatusNoContent)
+}
+
 // GetPropositionArticleById
 // @ID          GetPropositionArticleById
 // @Summary     Get article details by ID (Only for proposition articles)
@@ -756,7 +1064,8 @@ func (instance Article) GetArticlesToViewLater(context echo.Context) error {
 // @Description This request is responsible for looking up the details of an article of a proposition by the article ID.
 // @Security    BearerAuth
 // @Produce     json
-// @Param       articleId path string true "Article ID"
+// @Param       articleId  path  string true  "Article ID"
+// @Param       recordView query bool   false "Register the view of the article? By default, it is true"
 // @Success 200 {object} swagger.PropositionArticle "Successful request"
 // @Failure 400 {object} swagger.HttpError          "Badly formatted request"
 // @Failure 401 {object} swagger.HttpError          "Unauthorized access"
@@ -776,6 +1085,11 @@ func (instance Article) GetPropositionArticleById(context echo.Context) error {
 		return context.JSON(httpError.Code, httpError)
 	}
 
+	recordView, httpError := getRecordViewQueryParameterFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
 	propositionData, err := instance.propositionService.GetPropositionByArticleId(articleId, userId)
 	if err != nil {
 		if strings.Contains(err.Error(), "no rows") {
@@ -793,17 +1107,69 @@ func (instance Article) GetPropositionArticleById(context echo.Context) error {
 
 	propositionArticle := response.NewPropositionArticle(*propositionData)
 
+	if recordView {
+		instance.registerArticleView(context, articleId, userId)
+	}
+
 	return context.JSON(http.StatusOK, propositionArticle)
 }
 
+// GetPropositionTimelineByArticleId
+// @ID          GetPropositionTimelineByArticleId
+// @Summary     Get the timeline of a proposition by the article ID
+// @Tags        Articles
+// @Description This request is responsible for returning the path of a proposition through the Chamber as a single chronologically ordered list, which includes its submission, each event agenda item where it appeared (with the regime and situation of the item and the situation of the event), each voting about it (with its result) and the newsletters that mention it. Each item carries the ID of the article where its details can be found.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       articleId path string true "Article ID"
+// @Success 200 {array}  swagger.TimelineItem "Successful request"
+// @Failure 400 {object} swagger.HttpError    "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError    "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError    "Requested resource not found"
+// @Failure 500 {object} swagger.HttpError    "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError    "Some of the services/resources are temporarily unavailable"
+// @Router /articles/{articleId}/proposition/timeline [GET]
+func (instance Article) GetPropositionTimelineByArticleId(context echo.Context) error {
+	articleIdParameter := context.Param("articleId")
+	parameter, parameterDescription := "articleId", "Article ID"
+	articleId, httpError := utils.ConvertFromStringToUuid(articleIdParameter, parameter, parameterDescription)
+	if httpError != nil {
+		log.Warn("Error converting the articleId parameter: ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	timelineItems, err := instance.propositionService.GetPropositionTimelineByArticleId(articleId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Proposition article %s could not be found: %s", articleId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Proposition article not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the timeline of proposition article %s: %s", articleId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	timeline := make([]response.TimelineItem, 0)
+	for _, timelineItem := range timelineItems {
+		timeline = append(timeline, *response.NewTimelineItem(timelineItem))
+	}
+
+	return context.JSON(http.StatusOK, timeline)
+}
+
 // GetVotingArticleById
 // @ID          GetVotingArticleById
 // @Summary     Get article details by ID (Only for voting articles)
 // @Tags        Articles
-// @Description This request is responsible for looking up the details of an article of a voting by the article ID.
+// @Description This request is responsible for looking up the details of an article of a voting by the article ID, including how each deputy voted (yes, no, abstention, obstruction or absent) and the orientation given by each party (yes, no, abstention, obstruction or free).
 // @Security    BearerAuth
 // @Produce     json
-// @Param       articleId path string true "Article ID"
+// @Param       articleId  path  string true  "Article ID"
+// @Param       recordView query bool   false "Register the view of the article? By default, it is true"
 // @Success 200 {object} swagger.VotingArticle "Successful request"
 // @Failure 400 {object} swagger.HttpError     "Badly formatted request"
 // @Failure 401 {object} swagger.HttpError     "Unauthorized access"
@@ -823,6 +1189,11 @@ func (instance Article) GetVotingArticleById(context echo.Context) error {
 		return context.JSON(httpError.Code, httpError)
 	}
 
+	recordView, httpError := getRecordViewQueryParameterFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
 	votingData, err := instance.votingService.GetVotingByArticleId(articleId, userId)
 	if err != nil {
 		if strings.Contains(err.Error(), "no rows") {
@@ -838,7 +1209,33 @@ func (instance Article) GetVotingArticleById(context echo.Context) error {
 		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
 	}
 
-	votingArticle := response.NewVotingArticle(*votingData)
+	deputyVotes, err := instance.votingService.GetDeputyVotesByVotingId(votingData.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the deputy votes of voting article %s: %s", articleId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	partyOrientations, err := instance.votingService.GetPartyOrientationsByVotingId(votingData.Id())
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the party orientations of voting article %s: %s", articleId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	votingArticle := response.NewVotingArticle(*votingData, deputyVotes, partyOrientations)
+
+	if recordView {
+		instance.registerArticleView(context, articleId, userId)
+	}
 
 	return context.JSON(http.StatusOK, votingArticle)
 }
@@ -850,7 +1247,8 @@ func (instance Article) GetVotingArticleById(context echo.Context) error {
 // @Description This request is responsible for looking up the details of an article of an event by the article ID.
 // @Security    BearerAuth
 // @Produce     json
-// @Param       articleId path string true "Article ID"
+// @Param       articleId  path  string true  "Article ID"
+// @Param       recordView query bool   false "Register the view of the article? By default, it is true"
 // @Success 200 {object} swagger.EventArticle "Successful request"
 // @Failure 400 {object} swagger.HttpError    "Badly formatted request"
 // @Failure 401 {object} swagger.HttpError    "Unauthorized access"
@@ -870,6 +1268,11 @@ func (instance Article) GetEventArticleById(context echo.Context) error {
 		return context.JSON(httpError.Code, httpError)
 	}
 
+	recordView, httpError := getRecordViewQueryParameterFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
 	eventData, err := instance.eventService.GetEventByArticleId(articleId, userId)
 	if err != nil {
 		if strings.Contains(err.Error(), "no rows") {
@@ -887,6 +1290,10 @@ func (instance Article) GetEventArticleById(context echo.Context) error {
 
 	eventArticle := response.NewEventArticle(*eventData)
 
+	if recordView {
+		instance.registerArticleView(context, articleId, userId)
+	}
+
 	return context.JSON(http.StatusOK, eventArticle)
 }
 
@@ -897,7 +1304,8 @@ func (instance Article) GetEventArticleById(context echo.Context) error {
 // @Description This request is responsible for looking up the details of an article of a newsletter by the article ID.
 // @Security    BearerAuth
 // @Produce     json
-// @Param       articleId path string true "Article ID"
+// @Param       articleId  path  string true  "Article ID"
+// @Param       recordView query bool   false "Register the view of the article? By default, it is true"
 // @Success 200 {object} swagger.NewsletterArticle "Successful request"
 // @Failure 400 {object} swagger.HttpError         "Badly formatted request"
 // @Failure 401 {object} swagger.HttpError         "Unauthorized access"
@@ -917,6 +1325,11 @@ func (instance Article) GetNewsletterArticleById(context echo.Context) error {
 		return context.JSON(httpError.Code, httpError)
 	}
 
+	recordView, httpError := getRecordViewQueryParameterFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
 	newsletterData, err := instance.newsletterService.GetNewsletterByArticleId(articleId, userId)
 	if err != nil {
 		if strings.Contains(err.Error(), "no rows") {
@@ -934,6 +1347,10 @@ func (instance Article) GetNewsletterArticleById(context echo.Context) error {
 
 	newsletterArticle := response.NewNewsletterArticle(*newsletterData)
 
+	if recordView {
+		instance.registerArticleView(context, articleId, userId)
+	}
+
 	return context.JSON(http.StatusOK, newsletterArticle)
 }
 
@@ -1062,3 +1479,244 @@ func (instance Article) SaveArticleToViewLater(context echo.Context) error {
 
 	return context.NoContent(http.StatusNoContent)
 }
+
+// SaveArticleRatings
+// @ID          SaveArticleRatings
+// @Summary     Rate several articles at once
+// @Tags        Articles
+// @Description This request is responsible for rating or removing the rating of up to 100 articles at once. All ratings are saved in a single transaction and the result of the operation is returned for each article, in the order in which the articles were informed. Articles with invalid or duplicated data are ignored and reported with the `invalid` status.
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       requestBody body request.RatingBatch true "Request body"
+// @Success 200 {array}  swagger.ArticleOperation "Successful request"
+// @Failure 400 {object} swagger.HttpError        "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError        "Unauthorized access"
+// @Failure 403 {object} swagger.HttpError        "Access denied"
+// @Failure 422 {object} swagger.HttpError        "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError        "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError        "Some of the services/resources are temporarily unavailable"
+// @Router /articles/rating/batch [PUT]
+func (instance Article) SaveArticleRatings(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	var ratingBatch request.RatingBatch
+	err := context.Bind(&ratingBatch)
+	if err != nil {
+		log.Warn("Error assigning data from article batch rating request to DTO: ", err.Error())
+		return context.JSON(http.StatusBadRequest, response.NewBadRequestError())
+	}
+
+	httpError := validateArticleOperationBatchSize(len(ratingBatch.Articles))
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	articleOperationResults := make([]response.ArticleOperation, len(ratingBatch.Articles))
+	var articleOperations []articleoperation.ArticleOperation
+	var articleOperationIndexes []int
+	for index, articleRating := range ratingBatch.Articles {
+		articleOperation, err := articleoperation.NewBuilder().
+			ArticleId(articleRating.ArticleId).
+			Rating(articleRating.Rating).
+			Build()
+		if err == nil && isArticleOperationDuplicated(articleOperations, articleRating.ArticleId) {
+			err = errors.New("The article is duplicated in the request")
+		}
+		if err != nil {
+			articleOperationResults[index] = response.ArticleOperation{
+				ArticleId: articleRating.ArticleId,
+				Status:    articleoperation.InvalidStatus,
+				Message:   err.Error(),
+			}
+			continue
+		}
+
+		articleOperations = append(articleOperations, *articleOperation)
+		articleOperationIndexes = append(articleOperationIndexes, index)
+	}
+
+	if len(articleOperations) > 0 {
+		articleOperationSlice, err := instance.articleService.SaveArticleRatings(userId, articleOperations)
+		if err != nil {
+			if strings.Contains(err.Error(), "connection refused") {
+				log.Error("Database unavailable: ", err.Error())
+				return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+			}
+
+			log.Errorf("Error rating articles with user %s: %s", userId, err.Error())
+			return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+		}
+
+		for index, articleOperationData := range articleOperationSlice {
+			articleOperationResults[articleOperationIndexes[index]] = *response.NewArticleOperation(
+				articleOperationData)
+		}
+	}
+
+	return context.JSON(http.StatusOK, articleOperationResults)
+}
+
+// SaveArticlesToViewLater
+// @ID          SaveArticlesToViewLater
+// @Summary     Add or remove several articles from the list of articles bookmarked for later viewing by the user
+// @Tags        Articles
+// @Description This request is responsible for adding or removing up to 100 articles at once from the list of articles bookmarked for later viewing by the user. All bookmarks are saved in a single transaction and the result of the operation is returned for each article, in the order in which the articles were informed. Articles with invalid or duplicated data are ignored and reported with the `invalid` status.
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       requestBody body request.ViewLaterBatch true "Request body"
+// @Success 200 {array}  swagger.ArticleOperation "Successful request"
+// @Failure 400 {object} swagger.HttpError        "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError        "Unauthorized access"
+// @Failure 403 {object} swagger.HttpError        "Access denied"
+// @Failure 422 {object} swagger.HttpError        "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError        "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError        "Some of the services/resources are temporarily unavailable"
+// @Router /articles/view-later/batch [PUT]
+func (instance Article) SaveArticlesToViewLater(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	var viewLaterBatch request.ViewLaterBatch
+	err := context.Bind(&viewLaterBatch)
+	if err != nil {
+		log.Warn("Error assigning data from article batch bookmarking request to view later to DTO: ", err.Error())
+		return context.JSON(http.StatusBadRequest, response.NewBadRequestError())
+	}
+
+	httpError := validateArticleOperationBatchSize(len(viewLaterBatch.Articles))
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	articleOperationResults := make([]response.ArticleOperation, len(viewLaterBatch.Articles))
+	var articleOperations []articleoperation.ArticleOperation
+	var articleOperationIndexes []int
+	for index, articleViewLater := range viewLaterBatch.Articles {
+		articleOperation, err := articleoperation.NewBuilder().
+			ArticleId(articleViewLater.ArticleId).
+			ViewLater(articleViewLater.ViewLater).
+			Build()
+		if err == nil && isArticleOperationDuplicated(articleOperations, articleViewLater.ArticleId) {
+			err = errors.New("The article is duplicated in the request")
+		}
+		if err != nil {
+			articleOperationResults[index] = response.ArticleOperation{
+				ArticleId: articleViewLater.ArticleId,
+				Status:    articleoperation.InvalidStatus,
+				Message:   err.Error(),
+			}
+			continue
+		}
+
+		articleOperations = append(articleOperations, *articleOperation)
+		articleOperationIndexes = append(articleOperationIndexes, index)
+	}
+
+	if len(articleOperations) > 0 {
+		articleOperationSlice, err := instance.articleService.SaveArticlesToViewLater(userId, articleOperations)
+		if err != nil {
+			if strings.Contains(err.Error(), "connection refused") {
+				log.Error("Database unavailable: ", err.Error())
+				return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+			}
+
+			log.Errorf("Error updating the later viewing bookmarks with user %s: %s", userId, err.Error())
+			return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+		}
+
+		for index, articleOperationData := range articleOperationSlice {
+			articleOperationResults[articleOperationIndexes[index]] = *response.NewArticleOperation(
+				articleOperationData)
+		}
+	}
+
+	return context.JSON(http.StatusOK, articleOperationResults)
+}
+
+func validateArticleOperationBatchSize(numberOfArticles int) *response.HttpError {
+	if numberOfArticles < 1 || numberOfArticles > 100 {
+		errorMessage := "Invalid parameter: The list of articles (articles) must contain between 1 and 100 articles"
+		log.Warnf("Parameter out of allowed range: %s (Value: %d)", errorMessage, numberOfArticles)
+		return response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
+	}
+
+	return nil
+}
+
+func isArticleOperationDuplicated(articleOperations []articleoperation.ArticleOperation, articleId uuid.UUID) bool {
+	for _, articleOperation := range articleOperations {
+		if articleOperation.ArticleId() == articleId {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RegisterArticleView
+// @ID          RegisterArticleView
+// @Summary     Register the view of an article
+// @Tags        Articles
+// @Description This request is responsible for registering the view of an article by the user (or by the anonymous visitor). Repeated views of the same article by the same viewer within the deduplication window, as well as views from known bots, are ignored. Views are buffered and registered in the database asynchronously.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       articleId path string true "Article ID"
+// @Success 204 {object} nil               "Successful request"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /articles/{articleId}/view [POST]
+func (instance Article) RegisterArticleView(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	articleIdParameter := context.Param("articleId")
+	parameter, parameterDescription := "articleId", "Article ID"
+	articleId, httpError := utils.ConvertFromStringToUuid(articleIdParameter, parameter, parameterDescription)
+	if httpError != nil {
+		log.Warn("Error converting the articleId parameter: ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	err := instance.articleViewService.RegisterArticleView(articleId, userId, context.RealIP(),
+		context.Request().UserAgent())
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Article %s viewed by user %s could not be found: %s", articleId, userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Article not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error registering the view of article %s by user %s: %s", articleId, userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.NoContent(http.StatusNoContent)
+}
+
+func getRecordViewQueryParameterFromContext(context echo.Context) (bool, *response.HttpError) {
+	recordViewParameter := context.QueryParam("recordView")
+	if recordViewParameter == "" {
+		return true, nil
+	}
+
+	parameter, parameterDescription := "recordView", "Register the view of the article?"
+	recordView, httpError := utils.ConvertFromStringToBool(recordViewParameter, parameter, parameterDescription)
+	if httpError != nil {
+		log.Warn("Error converting the recordView parameter: ", httpError.Message)
+		return false, httpError
+	}
+
+	return recordView, nil
+}
+
+func (instance Article) registerArticleView(context echo.Context, articleId uuid.UUID, userId uuid.UUID) {
+	instance.articleViewService.RegisterLoadedArticleView(articleId, userId, context.RealIP(),
+		context.Request().UserAgent())
+}
@@ -0,0 +1,316 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+	"net/http"
+	"strings"
+	"time"
+	"vnc-api/adapters/api/endpoints/dto/response"
+	"vnc-api/adapters/api/endpoints/handlers/utils"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/services"
+)
+
+type Calendar struct {
+	calendarService services.Calendar
+}
+
+func NewCalendarHandler(calendarService services.Calendar) *Calendar {
+	return &Calendar{
+		calendarService: calendarService,
+	}
+}
+
+// GetEventCalendar
+// @ID          GetEventCalendar
+// @Summary     Export the events as an iCalendar file
+// @Tags        Calendar
+// @Description This request is responsible for returning the events available on the platform as an iCalendar (RFC 5545) file that can be imported or subscribed to in calendar applications. Each event keeps the same UID across requests and its status follows the situation of the event, so cancelled events are returned with the status CANCELLED. By default, only the events of the last 30 days and the future events are returned.
+// @Produce     text/calendar,json
+// @Param       eventTypeId             query string false "ID of the event type"
+// @Param       eventStartDate          query string false "Date from which the events occurred. Accepted format: YYYY-MM-DD"
+// @Param       eventEndDate            query string false "Date until which the events occurred. Accepted format: YYYY-MM-DD"
+// @Param       eventSituationId        query string false "ID of the event situation"
+// @Param       eventLegislativeBodyId  query string false "ID of the legislative body responsible for the event"
+// @Param       eventRapporteurId       query string false "ID of the rapporteur (deputy) for one or more items on the event agenda"
+// @Param       removeEventsInTheFuture query bool   false "Remove events in the future?"
+// @Success 200 {string} string            "Successful request"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 422 {object} swagger.HttpError "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /calendar/events.ics [GET]
+func (instance Calendar) GetEventCalendar(context echo.Context) error {
+	calendarFilter, httpError := getCalendarQueryParametersFromContext(context)
+	if httpError != nil {
+		log.Warn("getCalendarQueryParametersFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	events, err := instance.calendarService.GetCalendarEvents(*calendarFilter)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Error("Error retrieving the events of the calendar: ", err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	calendar := response.NewEventCalendar(events, "Você na Câmara - Eventos",
+		"Eventos da Câmara dos Deputados acompanhados pelo Você na Câmara")
+
+	return context.Blob(http.StatusOK, "text/calendar; charset=UTF-8", []byte(calendar))
+}
+
+// GetPrivateEventCalendar
+// @ID          GetPrivateEventCalendar
+// @Summary     Export the events of the legislative bodies followed by a user as an iCalendar file
+// @Tags        Calendar
+// @Description This request is responsible for returning, as an iCalendar (RFC 5545) file, the events of the legislative bodies followed by the user who owns the private calendar token. The URL of this calendar is returned by the request that creates the private calendar of the user and does not require authentication, so that it can be subscribed to in calendar applications. It accepts the same filters as the public calendar of events.
+// @Produce     text/calendar,json
+// @Param       calendarToken           path  string true  "Private calendar token"
+// @Param       eventTypeId             query string false "ID of the event type"
+// @Param       eventStartDate          query string false "Date from which the events occurred. Accepted format: YYYY-MM-DD"
+// @Param       eventEndDate            query string false "Date until which the events occurred. Accepted format: YYYY-MM-DD"
+// @Param       eventSituationId        query string false "ID of the event situation"
+// @Param       eventLegislativeBodyId  query string false "ID of the legislative body responsible for the event"
+// @Param       eventRapporteurId       query string false "ID of the rapporteur (deputy) for one or more items on the event agenda"
+// @Param       removeEventsInTheFuture query bool   false "Remove events in the future?"
+// @Success 200 {string} string            "Successful request"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 404 {object} swagger.HttpError "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /calendar/private/{calendarToken}/events.ics [GET]
+func (instance Calendar) GetPrivateEventCalendar(context echo.Context) error {
+	calendarToken := context.Param("calendarToken")
+
+	calendarFilter, httpError := getCalendarQueryParametersFromContext(context)
+	if httpError != nil {
+		log.Warn("getCalendarQueryParametersFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	events, err := instance.calendarService.GetPrivateCalendarEvents(calendarToken, *calendarFilter)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warn("Private calendar not found: ", err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Calendar not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Error("Error retrieving the events of the private calendar: ", err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	calendar := response.NewEventCalendar(events, "Você na Câmara - Meus eventos",
+		"Eventos dos órgãos legislativos que você segue no Você na Câmara")
+
+	return context.Blob(http.StatusOK, "text/calendar; charset=UTF-8", []byte(calendar))
+}
+
+func getCalendarQueryParametersFromContext(context echo.Context) (*filters.Calendar, *response.HttpError) {
+	var calendarFilter filters.Calendar
+
+	eventTypeIdParameter := context.QueryParam("eventTypeId")
+	if eventTypeIdParameter != "" {
+		parameter, parameterDescription := "eventTypeId", "Event type ID"
+		eventTypeId, httpError := utils.ConvertFromStringToUuid(eventTypeIdParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the eventTypeId parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		calendarFilter.TypeId = &eventTypeId
+	}
+
+	eventStartDateParameter := context.QueryParam("eventStartDate")
+	if eventStartDateParameter != "" {
+		parameter, parameterDescription := "eventStartDate", "Event start date"
+		eventStartDate, httpError := utils.ConvertFromStringToTime(eventStartDateParameter, parameter,
+			parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the eventStartDate parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		calendarFilter.Event.StartDate = &eventStartDate
+	}
+
+	eventEndDateParameter := context.QueryParam("eventEndDate")
+	if eventEndDateParameter != "" {
+		parameter, parameterDescription := "eventEndDate", "Event end date"
+		eventEndDate, httpError := utils.ConvertFromStringToTime(eventEndDateParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the eventEndDate parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		calendarFilter.Event.EndDate = &eventEndDate
+	}
+
+	if calendarFilter.Event.StartDate != nil && calendarFilter.Event.EndDate != nil &&
+		calendarFilter.Event.StartDate.After(*calendarFilter.Event.EndDate) {
+		errorMessage := fmt.Sprint("Invalid parameters: The event start date parameter (eventStartDate) cannot " +
+			"be greater than the event end date parameter (eventEndDate)")
+		log.Warn(errorMessage)
+		return nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
+	}
+
+	eventSituationIdParameter := context.QueryParam("eventSituationId")
+	if eventSituationIdParameter != "" {
+		parameter, parameterDescription := "eventSituationId", "Event situation ID"
+		eventSituationId, httpError := utils.ConvertFromStringToUuid(eventSituationIdParameter, parameter,
+			parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the eventSituationId parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		calendarFilter.Event.SituationId = &eventSituationId
+	}
+
+	eventLegislativeBodyIdParameter := context.QueryParam("eventLegislativeBodyId")
+	if eventLegislativeBodyIdParameter != "" {
+		parameter, parameterDescription := "eventLegislativeBodyId", "Event legislative body ID"
+		eventLegislativeBodyId, httpError := utils.ConvertFromStringToUuid(eventLegislativeBodyIdParameter, parameter,
+			parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the eventLegislativeBodyId parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		calendarFilter.Event.LegislativeBodyId = &eventLegislativeBodyId
+	}
+
+	eventRapporteurIdParameter := context.QueryParam("eventRapporteurId")
+	if eventRapporteurIdParameter != "" {
+		parameter, parameterDescription := "eventRapporteurId", "Event rapporteur ID"
+		eventRapporteurId, httpError := utils.ConvertFromStringToUuid(eventRapporteurIdParameter, parameter,
+			parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the eventRapporteurId parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		calendarFilter.Event.RapporteurId = &eventRapporteurId
+	}
+
+	removeEventsInTheFutureParameter := context.QueryParam("removeEventsInTheFuture")
+	if removeEventsInTheFutureParameter != "" {
+		parameter, parameterDescription := "removeEventsInTheFuture", "Remove events in the future?"
+		removeEventsInTheFuture, httpError := utils.ConvertFromStringToBool(removeEventsInTheFutureParameter, parameter,
+			parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the removeEventsInTheFuture parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		calendarFilter.Event.RemoveEventsInTheFuture = &removeEventsInTheFuture
+
+		if removeEventsInTheFuture {
+			eventEndDate := time.Now()
+			calendarFilter.Event.EndDate = &eventEndDate
+		}
+	}
+
+	return &calendarFilter, nil
+}
+
+// GetPrivateCalendar
+// @ID          GetPrivateCalendar
+// @Summary     Get the private calendar of the user
+// @Tags        Users
+// @Description This request is responsible for returning the URL of the private calendar of the user, which contains the events of the legislative bodies they follow.
+// @Security    BearerAuth
+// @Produce     json
+// @Success 200 {object} swagger.PrivateCalendar "Successful request"
+// @Failure 401 {object} swagger.HttpError       "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError       "Requested resource not found"
+// @Failure 500 {object} swagger.HttpError       "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError       "Some of the services/resources are temporarily unavailable"
+// @Router /user/calendar [GET]
+func (instance Calendar) GetPrivateCalendar(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	calendarToken, err := instance.calendarService.GetPrivateCalendarToken(userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("User %s does not have a private calendar: %s", userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Calendar not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the private calendar of user %s: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.JSON(http.StatusOK, response.NewPrivateCalendar(calendarToken))
+}
+
+// CreatePrivateCalendar
+// @ID          CreatePrivateCalendar
+// @Summary     Create the private calendar of the user
+// @Tags        Users
+// @Description This request is responsible for creating the private calendar of the user, with the events of the legislative bodies they follow. If the user already has a private calendar, a new URL is generated and the previous one stops working.
+// @Security    BearerAuth
+// @Produce     json
+// @Success 200 {object} swagger.PrivateCalendar "Successful request"
+// @Failure 401 {object} swagger.HttpError       "Unauthorized access"
+// @Failure 500 {object} swagger.HttpError       "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError       "Some of the services/resources are temporarily unavailable"
+// @Router /user/calendar [PUT]
+func (instance Calendar) CreatePrivateCalendar(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	calendarToken, err := instance.calendarService.CreatePrivateCalendarToken(userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error creating the private calendar of user %s: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.JSON(http.StatusOK, response.NewPrivateCalendar(calendarToken))
+}
+
+// DeletePrivateCalendar
+// @ID          DeletePrivateCalendar
+// @Summary     Delete the private calendar of the user
+// @Tags        Users
+// @Description This request is responsible for deleting the private calendar of the user, after which its URL stops working.
+// @Security    BearerAuth
+// @Produce     json
+// @Success 204 {object} nil               "Successful request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError "Requested resource not found"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /user/calendar [DELETE]
+func (instance Calendar) DeletePrivateCalendar(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	err := instance.calendarService.DeletePrivateCalendarToken(userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("User %s does not have a private calendar: %s", userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Calendar not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error deleting the private calendar of user %s: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,435 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+	"net/http"
+	"strings"
+	"vnc-api/adapters/api/endpoints/dto/response"
+	"vnc-api/adapters/api/endpoints/handlers/utils"
+	"vnc-api/core/domains/deputyvote"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/services"
+)
+
+const (
+	minimumNumberOfComparedDeputies = 2
+	maximumNumberOfComparedDeputies = 4
+)
+
+type Deputy struct {
+	deputyService services.Deputy
+}
+
+func NewDeputyHandler(deputyService services.Deputy) *Deputy {
+	return &Deputy{
+		deputyService: deputyService,
+	}
+}
+
+// GetDeputyById
+// @ID          GetDeputyById
+// @Summary     Get the profile of a deputy by ID
+// @Tags        Deputies
+// @Description This request is responsible for returning the profile of a deputy, which includes the identity of the deputy, the current party and federated unit and, when the deputy has drafted propositions under a different party or federated unit, the most recent of them. The profile also contains the paginated lists of the propositions drafted by the deputy and of the agenda items for which the deputy was the rapporteur, along with a summary of the legislative activity: the number of propositions by type and the approval rate of the propositions that were voted, considering the latest voting with a defined result of each proposition.
+// @Produce     json
+// @Param       deputyId            path  string true  "Deputy ID"
+// @Param       propositionsPage    query int    false "Page number of the propositions drafted by the deputy. By default, it is 1"
+// @Param       rapporteurshipsPage query int    false "Page number of the rapporteurships of the deputy. By default, it is 1"
+// @Param       itemsPerPage        query int    false "Number of propositions and rapporteurships returned per page. The default is 15 and the allowed values are between 1 and 100"
+// @Success 200 {object} swagger.DeputyProfile "Successful request"
+// @Failure 400 {object} swagger.HttpError     "Badly formatted request"
+// @Failure 404 {object} swagger.HttpError     "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError     "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError     "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError     "Some of the services/resources are temporarily unavailable"
+// @Router /deputies/{deputyId} [GET]
+func (instance Deputy) GetDeputyById(context echo.Context) error {
+	deputyIdParameter := context.Param("deputyId")
+	parameter, parameterDescription := "deputyId", "Deputy ID"
+	deputyId, httpError := utils.ConvertFromStringToUuid(deputyIdParameter, parameter, parameterDescription)
+	if httpError != nil {
+		log.Warn("Error converting the deputyId parameter: ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	propositionPagination, rapporteurshipPagination, httpError := getDeputyQueryParametersFromContext(context)
+	if httpError != nil {
+		log.Warn("getDeputyQueryParametersFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	deputyData, err := instance.deputyService.GetDeputyById(deputyId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Deputy %s could not be found: %s", deputyId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Deputy not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving deputy %s: %s", deputyId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	deputyStatistics, err := instance.deputyService.GetDeputyStatistics(deputyId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the statistics of deputy %s: %s", deputyId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	propositionSlice, totalNumberOfPropositions, err := instance.deputyService.GetDeputyPropositions(deputyId,
+		*propositionPagination)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the propositions of deputy %s: %s", deputyId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	rapporteurshipSlice, totalNumberOfRapporteurships, err := instance.deputyService.GetDeputyRapporteurships(
+		deputyId, *rapporteurshipPagination)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the rapporteurships of deputy %s: %s", deputyId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	propositions := make([]response.Article, 0)
+	for _, propositionData := range propositionSlice {
+		propositions = append(propositions, *response.NewArticle(propositionData))
+	}
+
+	rapporteurships := make([]response.Rapporteurship, 0)
+	for _, rapporteurshipData := range rapporteurshipSlice {
+		rapporteurships = append(rapporteurships, *response.NewRapporteurship(rapporteurshipData))
+	}
+
+	deputyProfile := response.DeputyProfile{
+		Deputy:     response.NewDeputy(*deputyData),
+		Statistics: response.NewDeputyStatistics(*deputyStatistics),
+		Propositions: &response.Pagination{
+			Page:         propositionPagination.GetPage(),
+			ItemsPerPage: propositionPagination.GetItemsPerPage(),
+			Total:        totalNumberOfPropositions,
+			Data:         propositions,
+		},
+		Rapporteurships: &response.Pagination{
+			Page:         rapporteurshipPagination.GetPage(),
+			ItemsPerPage: rapporteurshipPagination.GetItemsPerPage(),
+			Total:        totalNumberOfRapporteurships,
+			Data:         rapporteurships,
+		},
+	}
+
+	return context.JSON(http.StatusOK, deputyProfile)
+}
+
+func getDeputyQueryParametersFromContext(context echo.Context) (*filters.Pagination, *filters.Pagination,
+	*response.HttpError) {
+	var propositionPagination, rapporteurshipPagination filters.Pagination
+	queryParameters := context.QueryParams()
+
+	propositionsPageParameter := queryParameters.Get("propositionsPage")
+	if propositionsPageParameter != "" {
+		parameter, parameterDescription := "propositionsPage", "Page of the propositions"
+		page, httpError := utils.ConvertFromStringToInt(propositionsPageParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the propositionsPage parameter: ", httpError.Message)
+			return nil, nil, httpError
+		}
+		propositionPagination.Page = &page
+	}
+
+	rapporteurshipsPageParameter := queryParameters.Get("rapporteurshipsPage")
+	if rapporteurshipsPageParameter != "" {
+		parameter, parameterDescription := "rapporteurshipsPage", "Page of the rapporteurships"
+		page, httpError := utils.ConvertFromStringToInt(rapporteurshipsPageParameter, parameter,
+			parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the rapporteurshipsPage parameter: ", httpError.Message)
+			return nil, nil, httpError
+		}
+		rapporteurshipPagination.Page = &page
+	}
+
+	itemsPerPageParameter := queryParameters.Get("itemsPerPage")
+	if itemsPerPageParameter != "" {
+		parameter, parameterDescription := "itemsPerPage", "Items per page"
+		itemsPerPage, httpError := utils.ConvertFromStringToInt(itemsPerPageParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the itemsPerPage parameter: ", httpError.Message)
+			return nil, nil, httpError
+		}
+
+		if itemsPerPage > 100 {
+			errorMessage := fmt.Sprint("Invalid parameter: Items per page (itemsPerPage) must be less than or " +
+				"equal to 100")
+			log.Warnf("Parameter out of allowed range: %s (Value: %d)", errorMessage, itemsPerPage)
+			return nil, nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
+		}
+
+		propositionPagination.ItemsPerPage = &itemsPerPage
+		rapporteurshipPagination.ItemsPerPage = &itemsPerPage
+	}
+
+	return &propositionPagination, &rapporteurshipPagination, nil
+}
+
+// GetDeputyVotes
+// @ID          GetDeputyVotes
+// @Summary     Get the votes of a deputy
+// @Tags        Deputies
+// @Description This request is responsible for returning the paginated list of the votes of a deputy, from the most recent voting to the oldest. Each vote contains the voting in which it was cast, the party of the deputy at the time of the vote and, when there was one, the orientation that the party gave to its deputies.
+// @Produce     json
+// @Param       deputyId                path  string true  "Deputy ID"
+// @Param       vote                    query string false "Vote of the deputy. Accepted values: yes, no, abstention, obstruction and absent"
+// @Param       votingStartDate         query string false "Date from which the voting results were announced. Accepted format: YYYY-MM-DD"
+// @Param       votingEndDate           query string false "Date until which the voting results were announced. Accepted format: YYYY-MM-DD"
+// @Param       votingResult            query string false "Voting result. Accepted values: approved, rejected and undetermined"
+// @Param       votingLegislativeBodyId query string false "ID of the legislative body responsible for the voting"
+// @Param       page                    query int    false "Page number. By default, it is 1"
+// @Param       itemsPerPage            query int    false "Number of votes returned per page. The default is 15 and the allowed values are between 1 and 100"
+// @Success 200 {object} swagger.VotingRecordPagination "Successful request"
+// @Failure 400 {object} swagger.HttpError              "Badly formatted request"
+// @Failure 404 {object} swagger.HttpError              "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError              "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError              "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError              "Some of the services/resources are temporarily unavailable"
+// @Router /deputies/{deputyId}/votes [GET]
+func (instance Deputy) GetDeputyVotes(context echo.Context) error {
+	deputyIdParameter := context.Param("deputyId")
+	parameter, parameterDescription := "deputyId", "Deputy ID"
+	deputyId, httpError := utils.ConvertFromStringToUuid(deputyIdParameter, parameter, parameterDescription)
+	if httpError != nil {
+		log.Warn("Error converting the deputyId parameter: ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	deputyVoteFilter, httpError := getDeputyVoteQueryParametersFromContext(context)
+	if httpError != nil {
+		log.Warn("getDeputyVoteQueryParametersFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	_, err := instance.deputyService.GetDeputyById(deputyId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Deputy %s could not be found: %s", deputyId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Deputy not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving deputy %s: %s", deputyId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	votingRecordSlice, totalNumberOfVotingRecords, err := instance.deputyService.GetDeputyVotingRecords(deputyId,
+		*deputyVoteFilter)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the votes of deputy %s: %s", deputyId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	votingRecords := make([]response.VotingRecord, 0)
+	for _, votingRecordData := range votingRecordSlice {
+		votingRecords = append(votingRecords, *response.NewVotingRecord(votingRecordData))
+	}
+
+	return context.JSON(http.StatusOK, response.Pagination{
+		Page:         deputyVoteFilter.Pagination.GetPage(),
+		ItemsPerPage: deputyVoteFilter.Pagination.GetItemsPerPage(),
+		Total:        totalNumberOfVotingRecords,
+		Data:         votingRecords,
+	})
+}
+
+func getDeputyVoteQueryParametersFromContext(context echo.Context) (*filters.DeputyVote, *response.HttpError) {
+	var deputyVoteFilter filters.DeputyVote
+	queryParameters := context.QueryParams()
+
+	voteParameter := queryParameters.Get("vote")
+	if voteParameter != "" {
+		if !deputyvote.IsVoteValid(voteParameter) {
+			errorMessage := fmt.Sprint("Invalid parameter: Vote (vote)")
+			log.Warn(errorMessage)
+			return nil, response.NewHttpError(http.StatusBadRequest, errorMessage)
+		}
+		deputyVoteFilter.Vote = voteParameter
+	}
+
+	votingFilter, httpError := getVotingFilterFromQueryParameters(queryParameters)
+	if httpError != nil {
+		return nil, httpError
+	}
+	deputyVoteFilter.Voting = *votingFilter
+
+	pageParameter := queryParameters.Get("page")
+	if pageParameter != "" {
+		parameter, parameterDescription := "page", "Page"
+		page, httpError := utils.ConvertFromStringToInt(pageParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the page parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		deputyVoteFilter.Pagination.Page = &page
+	}
+
+	itemsPerPageParameter := queryParameters.Get("itemsPerPage")
+	if itemsPerPageParameter != "" {
+		parameter, parameterDescription := "itemsPerPage", "Items per page"
+		itemsPerPage, httpError := utils.ConvertFromStringToInt(itemsPerPageParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the itemsPerPage parameter: ", httpError.Message)
+			return nil, httpError
+		}
+
+		if itemsPerPage > 100 {
+			errorMessage := fmt.Sprint("Invalid parameter: Items per page (itemsPerPage) must be less than or " +
+				"equal to 100")
+			log.Warnf("Parameter out of allowed range: %s (Value: %d)", errorMessage, itemsPerPage)
+			return nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
+		}
+		deputyVoteFilter.Pagination.ItemsPerPage = &itemsPerPage
+	}
+
+	return &deputyVoteFilter, nil
+}
+
+// GetDeputyComparison
+// @ID          GetDeputyComparison
+// @Summary     Compare deputies side by side
+// @Tags        Deputies
+// @Description This request is responsible for comparing from two to four deputies. For each deputy, it returns the same summary of the legislative activity presented in the profile of the deputy (number of propositions by type, number of rapporteurships and approval rate) and whether the deputy has changed party. For each pair of deputies, it returns the number of propositions that both deputies co-authored and, considering the votings in which both deputies were present, how often they voted the same way.
+// @Produce     json
+// @Param       ids query string true "Comma-separated list with the IDs of the deputies that will be compared. Between 2 and 4 different deputies must be provided"
+// @Success 200 {object} swagger.DeputyComparison "Successful request"
+// @Failure 400 {object} swagger.HttpError        "Badly formatted request"
+// @Failure 404 {object} swagger.HttpError        "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError        "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError        "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError        "Some of the services/resources are temporarily unavailable"
+// @Router /deputies/compare [GET]
+func (instance Deputy) GetDeputyComparison(context echo.Context) error {
+	deputyIds, httpError := getComparedDeputyIdsFromContext(context)
+	if httpError != nil {
+		log.Warn("getComparedDeputyIdsFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	comparedDeputies := make([]response.ComparedDeputy, 0)
+	for _, deputyId := range deputyIds {
+		deputyData, err := instance.deputyService.GetDeputyById(deputyId)
+		if err != nil {
+			if strings.Contains(err.Error(), "no rows") {
+				log.Warnf("Deputy %s could not be found: %s", deputyId, err.Error())
+				return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+					fmt.Sprintf("Deputy %s not found", deputyId)))
+			} else if strings.Contains(err.Error(), "connection refused") {
+				log.Error("Database unavailable: ", err.Error())
+				return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+			}
+
+			log.Errorf("Error retrieving deputy %s: %s", deputyId, err.Error())
+			return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+		}
+
+		deputyStatistics, err := instance.deputyService.GetDeputyStatistics(deputyId)
+		if err != nil {
+			if strings.Contains(err.Error(), "connection refused") {
+				log.Error("Database unavailable: ", err.Error())
+				return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+			}
+
+			log.Errorf("Error retrieving the statistics of deputy %s: %s", deputyId, err.Error())
+			return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+		}
+
+		previousParty := deputyData.PreviousParty()
+		comparedDeputies = append(comparedDeputies, response.ComparedDeputy{
+			Deputy:       response.NewDeputy(*deputyData),
+			ChangedParty: !previousParty.IsZero(),
+			Statistics:   response.NewDeputyStatistics(*deputyStatistics),
+		})
+	}
+
+	deputyPairSlice, err := instance.deputyService.GetDeputyPairs(deputyIds)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the pairs of deputies %s: %s", deputyIds, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	deputyPairs := make([]response.DeputyPair, 0)
+	for _, deputyPairData := range deputyPairSlice {
+		deputyPairs = append(deputyPairs, *response.NewDeputyPair(deputyPairData))
+	}
+
+	return context.JSON(http.StatusOK, response.DeputyComparison{
+		Deputies: comparedDeputies,
+		Pairs:    deputyPairs,
+	})
+}
+
+func getComparedDeputyIdsFromContext(context echo.Context) ([]uuid.UUID, *response.HttpError) {
+	idsParameter := context.QueryParam("ids")
+	if idsParameter == "" {
+		errorMessage := fmt.Sprint("Parameter not provided: Deputy IDs (ids)")
+		log.Warn("Badly formatted request: ", errorMessage)
+		return nil, response.NewHttpError(http.StatusBadRequest, errorMessage)
+	}
+
+	var deputyIds []uuid.UUID
+	providedDeputyIds := make(map[uuid.UUID]bool)
+	for _, idParameter := range strings.Split(idsParameter, ",") {
+		parameter, parameterDescription := "ids", "Deputy IDs"
+		deputyId, httpError := utils.ConvertFromStringToUuid(strings.TrimSpace(idParameter), parameter,
+			parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the ids parameter: ", httpError.Message)
+			return nil, httpError
+		}
+
+		if !providedDeputyIds[deputyId] {
+			providedDeputyIds[deputyId] = true
+			deputyIds = append(deputyIds, deputyId)
+		}
+	}
+
+	if len(deputyIds) < minimumNumberOfComparedDeputies || len(deputyIds) > maximumNumberOfComparedDeputies {
+		errorMessage := fmt.Sprintf("Invalid parameter: Deputy IDs (ids) must contain between %d and %d different "+
+			"deputies", minimumNumberOfComparedDeputies, maximumNumberOfComparedDeputies)
+		log.Warnf("Parameter out of allowed range: %s (Value: %s)", errorMessage, idsParameter)
+		return nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
+	}
+
+	return deputyIds, nil
+}
@@ -0,0 +1,220 @@
+package handlers
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+	"net/http"
+	"os"
+	"strings"
+	"vnc-api/adapters/api/endpoints/dto/request"
+	"vnc-api/adapters/api/endpoints/dto/response"
+	"vnc-api/adapters/api/endpoints/handlers/utils"
+	"vnc-api/core/domains/emaildigest"
+	"vnc-api/core/interfaces/services"
+)
+
+type EmailDigest struct {
+	emailDigestService services.EmailDigest
+}
+
+func NewEmailDigestHandler(emailDigestService services.EmailDigest) *EmailDigest {
+	return &EmailDigest{
+		emailDigestService: emailDigestService,
+	}
+}
+
+// GetEmailDigest
+// @ID          GetEmailDigest
+// @Summary     Get the email digest subscription of the user
+// @Tags        Users
+// @Description This request is responsible for returning the subscription of the user to the email digest with the new articles about the resources they follow.
+// @Security    BearerAuth
+// @Produce     json
+// @Success 200 {object} swagger.EmailDigest "Successful request"
+// @Failure 401 {object} swagger.HttpError   "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError   "Requested resource not found"
+// @Failure 500 {object} swagger.HttpError   "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError   "Some of the services/resources are temporarily unavailable"
+// @Router /user/email-digest [GET]
+func (instance EmailDigest) GetEmailDigest(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	emailDigest, err := instance.emailDigestService.GetEmailDigest(userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("User %s is not subscribed to the email digest: %s", userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Email digest subscription not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the email digest subscription of user %s: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.JSON(http.StatusOK, response.NewEmailDigest(*emailDigest))
+}
+
+// SubscribeToEmailDigest
+// @ID          SubscribeToEmailDigest
+// @Summary     Subscribe to the email digest
+// @Tags        Users
+// @Description This request is responsible for subscribing the user to the daily or weekly email digest with the new articles about the resources they follow or, if the user is already subscribed, changing the frequency of the digest.
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       requestBody body request.EmailDigest true "Request body. Accepted values for the frequency: daily and weekly"
+// @Success 200 {object} swagger.EmailDigest "Successful request"
+// @Failure 400 {object} swagger.HttpError   "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError   "Unauthorized access"
+// @Failure 422 {object} swagger.HttpError   "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError   "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError   "Some of the services/resources are temporarily unavailable"
+// @Router /user/email-digest [PUT]
+func (instance EmailDigest) SubscribeToEmailDigest(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	var emailDigestRequest request.EmailDigest
+	err := context.Bind(&emailDigestRequest)
+	if err != nil {
+		log.Warn("Error assigning data from email digest subscription request to DTO: ", err.Error())
+		return context.JSON(http.StatusBadRequest, response.NewBadRequestError())
+	}
+
+	if !emaildigest.IsFrequencyValid(emailDigestRequest.Frequency) {
+		errorMessage := "Invalid parameter: Frequency (frequency) must be daily or weekly"
+		log.Warnf("Invalid email digest frequency: %s (Value: %s)", errorMessage, emailDigestRequest.Frequency)
+		return context.JSON(http.StatusUnprocessableEntity, response.NewHttpError(http.StatusUnprocessableEntity,
+			errorMessage))
+	}
+
+	emailDigest, err := instance.emailDigestService.SubscribeToEmailDigest(userId, emailDigestRequest.Frequency)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error subscribing user %s to the email digest: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.JSON(http.StatusOK, response.NewEmailDigest(*emailDigest))
+}
+
+// UnsubscribeFromEmailDigest
+// @ID          UnsubscribeFromEmailDigest
+// @Summary     Unsubscribe from the email digest
+// @Tags        Users
+// @Description This request is responsible for unsubscribing the user from the email digest.
+// @Security    BearerAuth
+// @Produce     json
+// @Success 204 {object} nil               "Successful request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError "Requested resource not found"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /user/email-digest [DELETE]
+func (instance EmailDigest) UnsubscribeFromEmailDigest(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	err := instance.emailDigestService.UnsubscribeFromEmailDigest(userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("User %s is not subscribed to the email digest: %s", userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Email digest subscription not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error unsubscribing user %s from the email digest: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.NoContent(http.StatusNoContent)
+}
+
+// GetEmailDigestUnsubscribePage
+// @ID          GetEmailDigestUnsubscribePage
+// @Summary     Get the confirmation page to unsubscribe from the email digest
+// @Tags        Users
+// @Description This request is responsible for returning the page opened by the signed link included in each digest, in which the user confirms that they want to unsubscribe from the email digest. Opening the link does not unsubscribe the user, so that link previews and email scanners cannot unsubscribe them.
+// @Produce     html
+// @Param       userId    query string true "User ID"
+// @Param       signature query string true "Signature of the unsubscribe link"
+// @Success 200 {string} string            "Successful request"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 403 {object} swagger.HttpError "Access denied"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Router /email-digest/unsubscribe [GET]
+func (instance EmailDigest) GetEmailDigestUnsubscribePage(context echo.Context) error {
+	userId, httpError := utils.ConvertFromStringToUuid(context.QueryParam("userId"), "userId", "User ID")
+	if httpError != nil {
+		log.Warn("Error converting the userId parameter: ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	page, err := instance.emailDigestService.GetEmailDigestUnsubscribePage(userId, context.QueryParam("signature"))
+	if err != nil {
+		if strings.Contains(err.Error(), "signature") {
+			log.Warnf("Invalid email digest unsubscribe link for user %s: %s", userId, err.Error())
+			return context.JSON(http.StatusForbidden, response.NewHttpError(http.StatusForbidden,
+				"The unsubscribe link is invalid"))
+		}
+
+		log.Errorf("Error generating the email digest unsubscribe page of user %s: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.HTMLBlob(http.StatusOK, page)
+}
+
+// UnsubscribeFromEmailDigestByLink
+// @ID          UnsubscribeFromEmailDigestByLink
+// @Summary     Unsubscribe from the email digest through the link sent by email
+// @Tags        Users
+// @Description This request is responsible for unsubscribing the user from the email digest through the signed link included in each digest, without requiring authentication. It is sent by the confirmation page, after which the user is redirected to the platform, and by the one-click unsubscribe of email clients (RFC 8058), which send the List-Unsubscribe=One-Click body.
+// @Accept      x-www-form-urlencoded
+// @Produce     json
+// @Param       userId           query string true  "User ID"
+// @Param       signature        query string true  "Signature of the unsubscribe link"
+// @Param       List-Unsubscribe formData string false "One-click unsubscribe of email clients. Accepted value: One-Click"
+// @Success 204 {object} nil               "Successful request"
+// @Success 303 {object} nil               "Successful request, redirecting to the platform"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 403 {object} swagger.HttpError "Access denied"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /email-digest/unsubscribe [POST]
+func (instance EmailDigest) UnsubscribeFromEmailDigestByLink(context echo.Context) error {
+	userId, httpError := utils.ConvertFromStringToUuid(context.QueryParam("userId"), "userId", "User ID")
+	if httpError != nil {
+		log.Warn("Error converting the userId parameter: ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	err := instance.emailDigestService.UnsubscribeFromEmailDigestWithSignature(userId, context.QueryParam("signature"))
+	if err != nil && !strings.Contains(err.Error(), "no rows") {
+		if strings.Contains(err.Error(), "signature") {
+			log.Warnf("Invalid email digest unsubscribe link for user %s: %s", userId, err.Error())
+			return context.JSON(http.StatusForbidden, response.NewHttpError(http.StatusForbidden,
+				"The unsubscribe link is invalid"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error unsubscribing user %s from the email digest through the link: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	if context.FormValue("List-Unsubscribe") == "One-Click" {
+		return context.NoContent(http.StatusNoContent)
+	}
+
+	return context.Redirect(http.StatusSeeOther, os.Getenv("APPLICATION_URL")+"/email-digest/unsubscribed")
+}
@@ -0,0 +1,233 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/xml"
+	"fmt"
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+	"vnc-api/adapters/api/endpoints/dto/response"
+	"vnc-api/core/interfaces/services"
+)
+
+type Feed struct {
+	articleService  services.Article
+	resourceService services.Resources
+}
+
+func NewFeedHandler(articleService services.Article, resourceService services.Resources) *Feed {
+	return &Feed{
+		articleService:  articleService,
+		resourceService: resourceService,
+	}
+}
+
+type articleFeed struct {
+	articleTypeCode string
+	title           string
+	description     string
+}
+
+var articleFeeds = map[string]articleFeed{
+	"articles": {
+		title:       "Você na Câmara - Artigos",
+		description: "Artigos mais recentes do Você na Câmara",
+	},
+	"propositions": {
+		articleTypeCode: "proposition",
+		title:           "Você na Câmara - Proposições",
+		description:     "Proposições mais recentes do Você na Câmara",
+	},
+	"votes": {
+		articleTypeCode: "voting",
+		title:           "Você na Câmara - Votações",
+		description:     "Votações mais recentes do Você na Câmara",
+	},
+	"events": {
+		articleTypeCode: "event",
+		title:           "Você na Câmara - Eventos",
+		description:     "Eventos mais recentes do Você na Câmara",
+	},
+	"newsletters": {
+		articleTypeCode: "newsletter",
+		title:           "Você na Câmara - Boletins",
+		description:     "Boletins mais recentes do Você na Câmara",
+	},
+}
+
+// GetArticleFeed
+// @ID          GetArticleFeed
+// @Summary     Get a feed of the most recent articles
+// @Tags        Feeds
+// @Description This request is responsible for returning the most recent articles available on the platform as an RSS 2.0 or Atom feed. The feed is chosen by the path, in the format `{type}.{format}`, where the type is articles, propositions, votes, events or newsletters and the format is rss or atom. It accepts the same filters as the article listing, except for excludeRead, and the typeId parameter is ignored by the feeds of a specific type. Entries are identified by the article ID, and the response carries the ETag and Last-Modified headers so that clients can use conditional requests (If-None-Match and If-Modified-Since).
+// @Produce     application/rss+xml,application/atom+xml,json
+// @Param       feed                        path  string true  "Feed name. Accepted values: articles.rss, articles.atom, propositions.rss, propositions.atom, votes.rss, votes.atom, events.rss, events.atom, newsletters.rss and newsletters.atom"
+// @Param       typeId                      query string false "Article type ID"
+// @Param       specificTypeId              query string false "Article specific type ID"
+// @Param       content                     query string false "Part of the content of the articles, in the title or content"
+// @Param       startDate                   query string false "Date from which the articles were created. Accepted format: YYYY-MM-DD"
+// @Param       endDate                     query string false "Date until which the articles were created. Accepted format: YYYY-MM-DD"
+// @Param       propositionDeputyId         query string false "ID of the deputy who drafted the proposition"
+// @Param       propositionPartyId          query string false "ID of the party that drafted the proposition"
+// @Param       propositionExternalAuthorId query string false "ID of the external author who drafted the proposition"
+// @Param       propositionFederatedUnit    query string false "Federated units (UFs) represented by the deputies who drafted the proposition, at the time it was drafted, separated by commas. Example: MG,SP"
+// @Param       votingStartDate             query string false "Date from which the voting results were announced. Accepted format: YYYY-MM-DD"
+// @Param       votingEndDate               query string false "Date until which the voting results were announced. Accepted format: YYYY-MM-DD"
+// @Param       votingResult                query string false "Voting result. Accepted values: approved, rejected and undetermined"
+// @Param       votingLegislativeBodyId     query string false "ID of the legislative body responsible for the voting"
+// @Param       eventStartDate              query string false "Date from which the events occurred. Accepted format: YYYY-MM-DD"
+// @Param       eventEndDate                query string false "Date until which the events occurred. Accepted format: YYYY-MM-DD"
+// @Param       eventSituationId            query string false "ID of the event situation"
+// @Param       eventLegislativeBodyId      query string false "ID of the legislative body responsible for the event"
+// @Param       eventRapporteurId           query string false "ID of the rapporteur (deputy) for one or more items on the event agenda"
+// @Param       removeEventsInTheFuture     query bool   false "Remove events in the future?"
+// @Param       page                        query int    false "Page number. By default, it is 1"
+// @Param       itemsPerPage                query int    false "Number of articles returned per page. The default is 15 and the allowed values are between 1 and 100"
+// @Success 200 {string} string             "Successful request"
+// @Success 304 {string} string             "The feed has not been modified since the last request"
+// @Failure 400 {object} swagger.HttpError  "Badly formatted request"
+// @Failure 404 {object} swagger.HttpError  "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError  "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError  "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError  "Some of the services/resources are temporarily unavailable"
+// @Router /feeds/{feed} [GET]
+func (instance Feed) GetArticleFeed(context echo.Context) error {
+	feedName, feedFormat, found := strings.Cut(context.Param("feed"), ".")
+	feed, feedExists := articleFeeds[feedName]
+	if !found || !feedExists || (feedFormat != "rss" && feedFormat != "atom") {
+		log.Warn("Feed not found: ", context.Param("feed"))
+		return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound, "Feed not found"))
+	}
+
+	articleFilter, httpError := getArticleQueryParametersFromContext(context)
+	if httpError != nil {
+		log.Warn("getArticleQueryParametersFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	if feed.articleTypeCode != "" {
+		articleTypeId, err := instance.getArticleTypeIdByCode(feed.articleTypeCode)
+		if err != nil {
+			if strings.Contains(err.Error(), "connection refused") {
+				log.Error("Database unavailable: ", err.Error())
+				return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+			}
+
+			log.Errorf("Error retrieving the article type of the %s feed: %s", feedName, err.Error())
+			return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+		}
+		articleFilter.TypeId = &articleTypeId
+	}
+
+	articleSlice, totalNumberOfArticles, err := instance.articleService.GetArticles(*articleFilter, uuid.Nil)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the articles of the %s feed: %s", feedName, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	lastModified := getArticleFeedLastModified(articleSlice)
+	entityTag := getArticleFeedEntityTag(context, articleSlice, totalNumberOfArticles)
+
+	context.Response().Header().Set("ETag", entityTag)
+	if !lastModified.IsZero() {
+		context.Response().Header().Set(echo.HeaderLastModified, lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if isArticleFeedNotModified(context, entityTag, lastModified) {
+		return context.NoContent(http.StatusNotModified)
+	}
+
+	feedUrl := fmt.Sprint(os.Getenv("API_URL"), context.Request().URL.RequestURI())
+
+	var feedData interface{}
+	contentType := "application/rss+xml; charset=UTF-8"
+	if feedFormat == "atom" {
+		feedData = response.NewArticleAtomFeed(articleSlice, feed.title, feedUrl, lastModified)
+		contentType = "application/atom+xml; charset=UTF-8"
+	} else {
+		feedData = response.NewArticleRssFeed(articleSlice, feed.title, feed.description, feedUrl, lastModified)
+	}
+
+	feedContent, err := xml.Marshal(feedData)
+	if err != nil {
+		log.Errorf("Error encoding the %s feed: %s", context.Param("feed"), err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.Blob(http.StatusOK, contentType, append([]byte(xml.Header), feedContent...))
+}
+
+func (instance Feed) getArticleTypeIdByCode(articleTypeCode string) (uuid.UUID, error) {
+	articleTypes, err := instance.resourceService.GetArticleTypes()
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	for _, articleType := range articleTypes {
+		if strings.Contains(articleType.Codes(), articleTypeCode) {
+			return articleType.Id(), nil
+		}
+	}
+
+	return uuid.Nil, fmt.Errorf("no article type found with the code %s", articleTypeCode)
+}
+
+func getArticleFeedLastModified(articles []article.Article) time.Time {
+	var lastModified time.Time
+	for _, articleData := range articles {
+		updatedAt := response.GetArticleFeedDateTime(articleData.UpdatedAt())
+		if updatedAt.After(lastModified) {
+			lastModified = updatedAt
+		}
+	}
+
+	return lastModified.Truncate(time.Second)
+}
+
+func getArticleFeedEntityTag(context echo.Context, articles []article.Article, totalNumberOfArticles int) string {
+	hash := sha256.New()
+	hash.Write([]byte(fmt.Sprint(context.Param("feed"), "?", context.QueryParams().Encode(), ";",
+		totalNumberOfArticles)))
+	for _, articleData := range articles {
+		hash.Write([]byte(fmt.Sprint(";", articleData.Id(), ":", articleData.UpdatedAt().UnixNano())))
+	}
+
+	return fmt.Sprintf("\"%x\"", hash.Sum(nil)[:16])
+}
+
+func isArticleFeedNotModified(context echo.Context, entityTag string, lastModified time.Time) bool {
+	ifNoneMatchHeader := context.Request().Header.Get("If-None-Match")
+	if ifNoneMatchHeader != "" {
+		for _, requestEntityTag := range strings.Split(ifNoneMatchHeader, ",") {
+			requestEntityTag = strings.TrimPrefix(strings.TrimSpace(requestEntityTag), "W/")
+			if requestEntityTag == "*" || requestEntityTag == entityTag {
+				return true
+			}
+		}
+		return false
+	}
+
+	ifModifiedSinceHeader := context.Request().Header.Get(echo.HeaderIfModifiedSince)
+	if ifModifiedSinceHeader == "" || lastModified.IsZero() {
+		return false
+	}
+
+	ifModifiedSince, err := http.ParseTime(ifModifiedSinceHeader)
+	if err != nil {
+		log.Warnf("Invalid If-Modified-Since header (Value: %s): %s", ifModifiedSinceHeader, err.Error())
+		return false
+	}
+
+	return !lastModified.After(ifModifiedSince)
+}
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+	"net/http"
+	"strings"
+	"vnc-api/adapters/api/endpoints/dto/response"
+	"vnc-api/adapters/api/endpoints/handlers/utils"
+	"vnc-api/core/domains/follow"
+	"vnc-api/core/interfaces/services"
+)
+
+type Follow struct {
+	followService services.Follow
+}
+
+func NewFollowHandler(followService services.Follow) *Follow {
+	return &Follow{
+		followService: followService,
+	}
+}
+
+// GetFollows
+// @ID          GetFollows
+// @Summary     List the resources followed by the user
+// @Tags        Users
+// @Description This request is responsible for listing the deputies, parties, external authors, proposition types, legislative bodies and propositions followed by the user.
+// @Security    BearerAuth
+// @Produce     json
+// @Success 200 {array}  swagger.Follow    "Successful request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /user/following [GET]
+func (instance Follow) GetFollows(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	followSlice, err := instance.followService.GetFollows(userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the resources followed by user %s: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	follows := make([]response.Follow, 0)
+	for _, followData := range followSlice {
+		follows = append(follows, *response.NewFollow(followData))
+	}
+
+	return context.JSON(http.StatusOK, follows)
+}
+
+// FollowResource
+// @ID          FollowResource
+// @Summary     Follow a resource
+// @Tags        Users
+// @Description This request is responsible for following a deputy, party, external author, proposition type, legislative body or proposition, so that its articles are listed in the feed of the user. Propositions are identified by the ID of their article and their new votes, events and newsletter mentions generate notifications.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       resourceType path string true "Resource type. Accepted values: deputy, party, external_author, proposition_type, legislative_body and proposition"
+// @Param       resourceId   path string true "Resource ID"
+// @Success 204 {object} nil               "Successful request"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /user/following/{resourceType}/{resourceId} [PUT]
+func (instance Follow) FollowResource(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	resourceType, resourceId, httpError := getFollowPathParametersFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	err := instance.followService.FollowResource(userId, resourceType, resourceId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Resource %s %s followed by user %s could not be found: %s", resourceType, resourceId, userId,
+				err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Resource not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error following %s %s with user %s: %s", resourceType, resourceId, userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.NoContent(http.StatusNoContent)
+}
+
+// UnfollowResource
+// @ID          UnfollowResource
+// @Summary     Unfollow a resource
+// @Tags        Users
+// @Description This request is responsible for unfollowing a deputy, party, external author, proposition type, legislative body or proposition followed by the user.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       resourceType path string true "Resource type. Accepted values: deputy, party, external_author, proposition_type, legislative_body and proposition"
+// @Param       resourceId   path string true "Resource ID"
+// @Success 204 {object} nil               "Successful request"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /user/following/{resourceType}/{resourceId} [DELETE]
+func (instance Follow) UnfollowResource(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	resourceType, resourceId, httpError := getFollowPathParametersFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	err := instance.followService.UnfollowResource(userId, resourceType, resourceId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Resource %s %s is not followed by user %s: %s", resourceType, resourceId, userId,
+				err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Resource not found among the resources followed by the user"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error unfollowing %s %s with user %s: %s", resourceType, resourceId, userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.NoContent(http.StatusNoContent)
+}
+
+func getFollowPathParametersFromContext(context echo.Context) (string, uuid.UUID, *response.HttpError) {
+	resourceType := context.Param("resourceType")
+	if !follow.IsResourceTypeValid(resourceType) {
+		errorMessage := fmt.Sprint("Invalid parameter: Resource type (resourceType) must be one of the following " +
+			"values: deputy, party, external_author, proposition_type, legislative_body and proposition")
+		log.Warnf("Parameter out of allowed range: %s (Value: %s)", errorMessage, resourceType)
+		return "", uuid.Nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
+	}
+
+	resourceIdParameter := context.Param("resourceId")
+	parameter, parameterDescription := "resourceId", "Resource ID"
+	resourceId, httpError := utils.ConvertFromStringToUuid(resourceIdParameter, parameter, parameterDescription)
+	if httpError != nil {
+		log.Warn("Error converting the resourceId parameter: ", httpError.Message)
+		return "", uuid.Nil, httpError
+	}
+
+	return resourceType, resourceId, nil
+}
@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+	"net/http"
+	"strings"
+	"time"
+	"vnc-api/adapters/api/endpoints/dto/response"
+	"vnc-api/adapters/api/endpoints/handlers/utils"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/services"
+)
+
+type Graph struct {
+	graphService services.Graph
+}
+
+func NewGraphHandler(graphService services.Graph) *Graph {
+	return &Graph{
+		graphService: graphService,
+	}
+}
+
+// GetCoAuthorshipGraph
+// @ID          GetCoAuthorshipGraph
+// @Summary     Get the co-authorship network of the propositions
+// @Tags        Graphs
+// @Description This request is responsible for returning the network of authors of the propositions, in which the nodes are the deputies and external authors that drafted propositions together and the edges link each pair of co-authors, with the number of propositions they share as the weight. Only the collaborations with at least the minimum weight are included, and the network is limited to the authors with the most propositions among them. The propositions are limited to a period of up to 366 days, which ends on the current date when no dates are informed. The party and the federated unit of each author are the ones of their most recent proposition in the period. The party filter keeps the propositions that have at least one author from the party, so the collaborations of the party with other parties remain in the network. Besides JSON, the network can be exported in the GraphML and GEXF formats, which can be loaded in tools such as Gephi.
+// @Produce     json,application/graphml+xml,application/gexf+xml
+// @Param       startDate         query string false "Date from which the propositions were submitted. By default, it is 366 days before the end date. Accepted format: YYYY-MM-DD"
+// @Param       endDate           query string false "Date until which the propositions were submitted. By default, it is 366 days after the start date or, without a start date, the current date. Accepted format: YYYY-MM-DD"
+// @Param       partyId           query string false "ID of a party that must be among the authors of the propositions"
+// @Param       propositionTypeId query string false "Proposition type ID"
+// @Param       minimumWeight     query int    false "Minimum number of propositions shared by two authors for their collaboration to be included. By default, it is 1"
+// @Param       format            query string false "Format of the response. Accepted values: json, graphml and gexf. By default, it is json"
+// @Success 200 {object} swagger.CoAuthorshipGraph "Successful request"
+// @Failure 400 {object} swagger.HttpError         "Badly formatted request"
+// @Failure 422 {object} swagger.HttpError         "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError         "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError         "Some of the services/resources are temporarily unavailable"
+// @Router /graphs/coauthorship [GET]
+func (instance Graph) GetCoAuthorshipGraph(context echo.Context) error {
+	coAuthorshipFilter, httpError := getCoAuthorshipQueryParametersFromContext(context)
+	if httpError != nil {
+		log.Warn("getCoAuthorshipQueryParametersFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	format := context.QueryParam("format")
+	if format != "" && format != "json" && format != "graphml" && format != "gexf" {
+		errorMessage := fmt.Sprint("Invalid parameter: Format (format) must be json, graphml or gexf")
+		log.Warn(errorMessage)
+		return context.JSON(http.StatusBadRequest, response.NewHttpError(http.StatusBadRequest, errorMessage))
+	}
+
+	nodes, edges, err := instance.graphService.GetCoAuthorshipGraph(*coAuthorshipFilter)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Error("Error retrieving the co-authorship graph: ", err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	switch format {
+	case "graphml":
+		graphml, err := response.NewCoAuthorshipGraphml(nodes, edges)
+		if err != nil {
+			log.Error("Error encoding the co-authorship graph as GraphML: ", err.Error())
+			return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+		}
+
+		context.Response().Header().Set(echo.HeaderContentDisposition,
+			"attachment; filename=\"coauthorship.graphml\"")
+		return context.Blob(http.StatusOK, "application/graphml+xml; charset=UTF-8", graphml)
+	case "gexf":
+		gexf, err := response.NewCoAuthorshipGexf(nodes, edges)
+		if err != nil {
+			log.Error("Error encoding the co-authorship graph as GEXF: ", err.Error())
+			return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+		}
+
+		context.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename=\"coauthorship.gexf\"")
+		return context.Blob(http.StatusOK, "application/gexf+xml; charset=UTF-8", gexf)
+	}
+
+	return context.JSON(http.StatusOK, response.NewCoAuthorshipGraph(nodes, edges))
+}
+
+func getCoAuthorshipQueryParametersFromContext(context echo.Context) (*filters.CoAuthorship, *response.HttpError) {
+	var coAuthorshipFilter filters.CoAuthorship
+	queryParameters := context.QueryParams()
+
+	startDateParameter := queryParameters.Get("startDate")
+	if startDateParameter != "" {
+		parameter, parameterDescription := "startDate", "Start date"
+		startDate, httpError := utils.ConvertFromStringToTime(startDateParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the startDate parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		coAuthorshipFilter.StartDate = &startDate
+	}
+
+	endDateParameter := queryParameters.Get("endDate")
+	if endDateParameter != "" {
+		parameter, parameterDescription := "endDate", "End date"
+		endDate, httpError := utils.ConvertFromStringToTime(endDateParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the endDate parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		coAuthorshipFilter.EndDate = &endDate
+	}
+
+	if coAuthorshipFilter.StartDate != nil && coAuthorshipFilter.EndDate != nil &&
+		coAuthorshipFilter.StartDate.After(*coAuthorshipFilter.EndDate) {
+		errorMessage := fmt.Sprint("Invalid parameters: The start date parameter (startDate) cannot be greater " +
+			"than the end date parameter (endDate)")
+		log.Warn(errorMessage)
+		return nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
+	} else if coAuthorshipFilter.StartDate != nil && coAuthorshipFilter.EndDate != nil &&
+		coAuthorshipFilter.EndDate.Sub(*coAuthorshipFilter.StartDate) >
+			filters.MaximumCoAuthorshipPeriodInDaysFilter*24*time.Hour {
+		errorMessage := fmt.Sprintf("Invalid parameters: The period between the start date (startDate) and the "+
+			"end date (endDate) cannot be greater than %d days", filters.MaximumCoAuthorshipPeriodInDaysFilter)
+		log.Warn(errorMessage)
+		return nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
+	}
+
+	partyIdParameter := queryParameters.Get("partyId")
+	if partyIdParameter != "" {
+		parameter, parameterDescription := "partyId", "Party ID"
+		partyId, httpError := utils.ConvertFromStringToUuid(partyIdParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the partyId parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		coAuthorshipFilter.PartyId = &partyId
+	}
+
+	propositionTypeIdParameter := queryParameters.Get("propositionTypeId")
+	if propositionTypeIdParameter != "" {
+		parameter, parameterDescription := "propositionTypeId", "Proposition type ID"
+		propositionTypeId, httpError := utils.ConvertFromStringToUuid(propositionTypeIdParameter, parameter,
+			parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the propositionTypeId parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		coAuthorshipFilter.PropositionTypeId = &propositionTypeId
+	}
+
+	minimumWeightParameter := queryParameters.Get("minimumWeight")
+	if minimumWeightParameter != "" {
+		parameter, parameterDescription := "minimumWeight", "Minimum weight"
+		minimumWeight, httpError := utils.ConvertFromStringToInt(minimumWeightParameter, parameter,
+			parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the minimumWeight parameter: ", httpError.Message)
+			return nil, httpError
+		}
+
+		if minimumWeight < 1 {
+			errorMessage := fmt.Sprint("Invalid parameter: Minimum weight (minimumWeight) must be greater than or " +
+				"equal to 1")
+			log.Warnf("Parameter out of allowed range: %s (Value: %d)", errorMessage, minimumWeight)
+			return nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
+		}
+		coAuthorshipFilter.MinimumWeight = minimumWeight
+	}
+
+	return &coAuthorshipFilter, nil
+}
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+	"net/http"
+	"strings"
+	"vnc-api/adapters/api/endpoints/dto/response"
+	"vnc-api/adapters/api/endpoints/handlers/utils"
+	"vnc-api/core/interfaces/services"
+)
+
+type LegislativeBody struct {
+	legislativeBodyService services.LegislativeBody
+}
+
+func NewLegislativeBodyHandler(legislativeBodyService services.LegislativeBody) *LegislativeBody {
+	return &LegislativeBody{
+		legislativeBodyService: legislativeBodyService,
+	}
+}
+
+// GetLegislativeBodyById
+// @ID          GetLegislativeBodyById
+// @Summary     Get the profile of a legislative body by ID
+// @Tags        Legislative Bodies
+// @Description This request is responsible for returning, in a single request, the profile of a legislative body (such as a committee), which includes its type, the upcoming events (from the current day onwards, in chronological order), the most recent events and votes and the deputies who were most frequently rapporteurs of items on the agendas of its events.
+// @Produce     json
+// @Param       legislativeBodyId path string true "Legislative body ID"
+// @Success 200 {object} swagger.LegislativeBodyProfile "Successful request"
+// @Failure 400 {object} swagger.HttpError              "Badly formatted request"
+// @Failure 404 {object} swagger.HttpError              "Requested resource not found"
+// @Failure 500 {object} swagger.HttpError              "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError              "Some of the services/resources are temporarily unavailable"
+// @Router /legislative-bodies/{legislativeBodyId} [GET]
+func (instance LegislativeBody) GetLegislativeBodyById(context echo.Context) error {
+	legislativeBodyIdParameter := context.Param("legislativeBodyId")
+	parameter, parameterDescription := "legislativeBodyId", "Legislative body ID"
+	legislativeBodyId, httpError := utils.ConvertFromStringToUuid(legislativeBodyIdParameter, parameter,
+		parameterDescription)
+	if httpError != nil {
+		log.Warn("Error converting the legislativeBodyId parameter: ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	legislativeBodyData, err := instance.legislativeBodyService.GetLegislativeBodyById(legislativeBodyId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Legislative body %s could not be found: %s", legislativeBodyId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Legislative body not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving legislative body %s: %s", legislativeBodyId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	upcomingEventSlice, err := instance.legislativeBodyService.GetLegislativeBodyUpcomingEvents(legislativeBodyId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the upcoming events of legislative body %s: %s", legislativeBodyId,
+			err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	recentEventSlice, err := instance.legislativeBodyService.GetLegislativeBodyRecentEvents(legislativeBodyId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the recent events of legislative body %s: %s", legislativeBodyId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	recentVotingSlice, err := instance.legislativeBodyService.GetLegislativeBodyRecentVotes(legislativeBodyId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the recent votes of legislative body %s: %s", legislativeBodyId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	rapporteurSlice, err := instance.legislativeBodyService.GetLegislativeBodyFrequentRapporteurs(legislativeBodyId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the most frequent rapporteurs of legislative body %s: %s", legislativeBodyId,
+			err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	upcomingEvents := make([]response.Article, 0)
+	for _, eventData := range upcomingEventSlice {
+		upcomingEvents = append(upcomingEvents, *response.NewArticle(eventData))
+	}
+
+	recentEvents := make([]response.Article, 0)
+	for _, eventData := range recentEventSlice {
+		recentEvents = append(recentEvents, *response.NewArticle(eventData))
+	}
+
+	recentVotes := make([]response.Article, 0)
+	for _, votingData := range recentVotingSlice {
+		recentVotes = append(recentVotes, *response.NewArticle(votingData))
+	}
+
+	frequentRapporteurs := make([]response.FrequentRapporteur, 0)
+	for _, rapporteurData := range rapporteurSlice {
+		frequentRapporteurs = append(frequentRapporteurs, *response.NewFrequentRapporteur(rapporteurData))
+	}
+
+	legislativeBodyProfile := response.LegislativeBodyProfile{
+		LegislativeBody:     response.NewLegislativeBody(*legislativeBodyData),
+		UpcomingEvents:      upcomingEvents,
+		RecentEvents:        recentEvents,
+		RecentVotes:         recentVotes,
+		FrequentRapporteurs: frequentRapporteurs,
+	}
+
+	return context.JSON(http.StatusOK, legislativeBodyProfile)
+}
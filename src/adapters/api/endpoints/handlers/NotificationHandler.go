@@ -0,0 +1,248 @@
+package handlers
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+	"net/http"
+	"strings"
+	"vnc-api/adapters/api/endpoints/dto/request"
+	"vnc-api/adapters/api/endpoints/dto/response"
+	"vnc-api/adapters/api/endpoints/handlers/utils"
+	"vnc-api/core/domains/notificationpreference"
+	"vnc-api/core/interfaces/services"
+)
+
+type Notification struct {
+	notificationService services.Notification
+}
+
+func NewNotificationHandler(notificationService services.Notification) *Notification {
+	return &Notification{
+		notificationService: notificationService,
+	}
+}
+
+// GetNotifications
+// @ID          GetNotifications
+// @Summary     List the notifications of the user
+// @Tags        Notifications
+// @Description This request is responsible for listing the notifications of the user about the new votes, events and newsletter mentions of the propositions they follow, from the most recent to the oldest, together with the number of unread notifications.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       onlyUnread   query bool false "List only the unread notifications?"
+// @Param       page         query int  false "Page number. By default, it is 1"
+// @Param       itemsPerPage query int  false "Number of notifications returned per page. The default is 15 and the allowed values are between 1 and 100"
+// @Success 200 {object} swagger.NotificationPagination "Successful request"
+// @Failure 400 {object} swagger.HttpError              "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError              "Unauthorized access"
+// @Failure 422 {object} swagger.HttpError              "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError              "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError              "Some of the services/resources are temporarily unavailable"
+// @Router /notifications [GET]
+func (instance Notification) GetNotifications(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	var onlyUnread bool
+	onlyUnreadParameter := context.QueryParam("onlyUnread")
+	if onlyUnreadParameter != "" {
+		var httpError *response.HttpError
+		parameter, parameterDescription := "onlyUnread", "List only the unread notifications?"
+		onlyUnread, httpError = utils.ConvertFromStringToBool(onlyUnreadParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the onlyUnread parameter: ", httpError.Message)
+			return context.JSON(httpError.Code, httpError)
+		}
+	}
+
+	pagination, httpError := getPaginationQueryParametersFromContext(context)
+	if httpError != nil {
+		log.Warn("getPaginationQueryParametersFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	notificationSlice, totalNumberOfNotifications, numberOfUnreadNotifications, err :=
+		instance.notificationService.GetNotifications(userId, onlyUnread, *pagination)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the notifications of user %s: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	notifications := make([]response.Notification, 0)
+	for _, notificationData := range notificationSlice {
+		notifications = append(notifications, *response.NewNotification(notificationData))
+	}
+
+	return context.JSON(http.StatusOK, response.Pagination{
+		Page:         pagination.GetPage(),
+		ItemsPerPage: pagination.GetItemsPerPage(),
+		Total:        totalNumberOfNotifications,
+		UnreadCount:  &numberOfUnreadNotifications,
+		Data:         notifications,
+	})
+}
+
+// MarkNotificationAsRead
+// @ID          MarkNotificationAsRead
+// @Summary     Mark a notification as read
+// @Tags        Notifications
+// @Description This request is responsible for marking a notification of the user as read.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       notificationId path string true "Notification ID"
+// @Success 204 {object} nil               "Successful request"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError "Requested resource not found"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /notifications/{notificationId}/read [PATCH]
+func (instance Notification) MarkNotificationAsRead(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	notificationIdParameter := context.Param("notificationId")
+	parameter, parameterDescription := "notificationId", "Notification ID"
+	notificationId, httpError := utils.ConvertFromStringToUuid(notificationIdParameter, parameter,
+		parameterDescription)
+	if httpError != nil {
+		log.Warn("Error converting the notificationId parameter: ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	err := instance.notificationService.MarkNotificationAsRead(userId, notificationId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Notification %s of user %s could not be found: %s", notificationId, userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Notification not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error marking notification %s of user %s as read: %s", notificationId, userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.NoContent(http.StatusNoContent)
+}
+
+// MarkAllNotificationsAsRead
+// @ID          MarkAllNotificationsAsRead
+// @Summary     Mark all notifications as read
+// @Tags        Notifications
+// @Description This request is responsible for marking all the unread notifications of the user as read.
+// @Security    BearerAuth
+// @Produce     json
+// @Success 204 {object} nil               "Successful request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /notifications/read [PATCH]
+func (instance Notification) MarkAllNotificationsAsRead(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	err := instance.notificationService.MarkAllNotificationsAsRead(userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error marking all notifications of user %s as read: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.NoContent(http.StatusNoContent)
+}
+
+// GetNotificationPreferences
+// @ID          GetNotificationPreferences
+// @Summary     List the notification preferences of the user
+// @Tags        Notifications
+// @Description This request is responsible for listing, for each notification type, whether the user receives notifications of that type. All types are enabled by default.
+// @Security    BearerAuth
+// @Produce     json
+// @Success 200 {array}  swagger.NotificationPreference "Successful request"
+// @Failure 401 {object} swagger.HttpError              "Unauthorized access"
+// @Failure 500 {object} swagger.HttpError              "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError              "Some of the services/resources are temporarily unavailable"
+// @Router /notifications/preferences [GET]
+func (instance Notification) GetNotificationPreferences(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	notificationPreferenceSlice, err := instance.notificationService.GetNotificationPreferences(userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the notification preferences of user %s: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	notificationPreferences := make([]response.NotificationPreference, 0)
+	for _, notificationPreference := range notificationPreferenceSlice {
+		notificationPreferences = append(notificationPreferences,
+			*response.NewNotificationPreference(notificationPreference))
+	}
+
+	return context.JSON(http.StatusOK, notificationPreferences)
+}
+
+// SaveNotificationPreference
+// @ID          SaveNotificationPreference
+// @Summary     Enable or disable a notification type
+// @Tags        Notifications
+// @Description This request is responsible for enabling or disabling the notifications of a type for the user. Disabling a type stops new notifications of that type from being generated, without removing the existing ones.
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       type        path string                         true "Notification type. Accepted values: proposition_voting, proposition_event and proposition_newsletter"
+// @Param       requestBody body request.NotificationPreference true "Request body"
+// @Success 204 {object} nil               "Successful request"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 422 {object} swagger.HttpError "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /notifications/preferences/{type} [PUT]
+func (instance Notification) SaveNotificationPreference(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	var notificationPreferenceRequest request.NotificationPreference
+	err := context.Bind(&notificationPreferenceRequest)
+	if err != nil {
+		log.Warn("Error assigning data from notification preference request to DTO: ", err.Error())
+		return context.JSON(http.StatusBadRequest, response.NewBadRequestError())
+	}
+
+	notificationPreference, err := notificationpreference.NewBuilder().
+		Type(context.Param("type")).
+		Enabled(notificationPreferenceRequest.Enabled).
+		Build()
+	if err != nil {
+		log.Warn("Error validating notification preference data: ", err.Error())
+		return context.JSON(http.StatusUnprocessableEntity, response.NewHttpError(http.StatusUnprocessableEntity,
+			err.Error()))
+	}
+
+	err = instance.notificationService.SaveNotificationPreference(userId, *notificationPreference)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error saving the notification preference %s of user %s: %s", notificationPreference.Type(),
+			userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.NoContent(http.StatusNoContent)
+}
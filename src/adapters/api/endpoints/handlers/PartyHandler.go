@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+	"net/http"
+	"strings"
+	"vnc-api/adapters/api/endpoints/dto/response"
+	"vnc-api/adapters/api/endpoints/handlers/utils"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/services"
+)
+
+type Party struct {
+	partyService services.Party
+}
+
+func NewPartyHandler(partyService services.Party) *Party {
+	return &Party{
+		partyService: partyService,
+	}
+}
+
+const (
+	defaultNumberOfMonthsOfPartyActivity = 12
+	maximumNumberOfMonthsOfPartyActivity = 60
+)
+
+// GetPartyById
+// @ID          GetPartyById
+// @Summary     Get the profile of a party by ID
+// @Tags        Parties
+// @Description This request is responsible for returning the profile of a party, which includes its current deputies, the paginated list of the propositions drafted by deputies while affiliated with the party and a summary of its legislative activity: the number of propositions by type, the approval and rejection rates of the propositions that were voted (considering the latest voting with a defined result of each proposition) and the activity of the party in each month of the requested period.
+// @Produce     json
+// @Param       partyId      path  string true  "Party ID"
+// @Param       months       query int    false "Number of months of the monthly activity, counting the current month. The default is 12 and the allowed values are between 1 and 60"
+// @Param       page         query int    false "Page number of the propositions drafted under the party. By default, it is 1"
+// @Param       itemsPerPage query int    false "Number of propositions returned per page. The default is 15 and the allowed values are between 1 and 100"
+// @Success 200 {object} swagger.PartyProfile "Successful request"
+// @Failure 400 {object} swagger.HttpError    "Badly formatted request"
+// @Failure 404 {object} swagger.HttpError    "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError    "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError    "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError    "Some of the services/resources are temporarily unavailable"
+// @Router /parties/{partyId} [GET]
+func (instance Party) GetPartyById(context echo.Context) error {
+	partyIdParameter := context.Param("partyId")
+	parameter, parameterDescription := "partyId", "Party ID"
+	partyId, httpError := utils.ConvertFromStringToUuid(partyIdParameter, parameter, parameterDescription)
+	if httpError != nil {
+		log.Warn("Error converting the partyId parameter: ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	numberOfMonths, pagination, httpError := getPartyQueryParametersFromContext(context)
+	if httpError != nil {
+		log.Warn("getPartyQueryParametersFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	partyData, err := instance.partyService.GetPartyById(partyId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Party %s could not be found: %s", partyId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound, "Party not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving party %s: %s", partyId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	deputySlice, err := instance.partyService.GetPartyDeputies(partyId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the deputies of party %s: %s", partyId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	partyStatistics, err := instance.partyService.GetPartyStatistics(partyId, numberOfMonths)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the statistics of party %s: %s", partyId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	propositionSlice, totalNumberOfPropositions, err := instance.partyService.GetPartyPropositions(partyId,
+		*pagination)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the propositions of party %s: %s", partyId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	deputies := make([]response.Deputy, 0)
+	for _, deputyData := range deputySlice {
+		deputies = append(deputies, *response.NewDeputy(deputyData))
+	}
+
+	propositions := make([]response.Article, 0)
+	for _, propositionData := range propositionSlice {
+		propositions = append(propositions, *response.NewArticle(propositionData))
+	}
+
+	partyProfile := response.PartyProfile{
+		Party:      response.NewParty(*partyData),
+		Deputies:   deputies,
+		Statistics: response.NewPartyStatistics(*partyStatistics),
+		Propositions: &response.Pagination{
+			Page:         pagination.GetPage(),
+			ItemsPerPage: pagination.GetItemsPerPage(),
+			Total:        totalNumberOfPropositions,
+			Data:         propositions,
+		},
+	}
+
+	return context.JSON(http.StatusOK, partyProfile)
+}
+
+func getPartyQueryParametersFromContext(context echo.Context) (int, *filters.Pagination, *response.HttpError) {
+	numberOfMonths := defaultNumberOfMonthsOfPartyActivity
+	var pagination filters.Pagination
+	queryParameters := context.QueryParams()
+
+	monthsParameter := queryParameters.Get("months")
+	if monthsParameter != "" {
+		parameter, parameterDescription := "months", "Number of months"
+		months, httpError := utils.ConvertFromStringToInt(monthsParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the months parameter: ", httpError.Message)
+			return 0, nil, httpError
+		}
+
+		if months < 1 || months > maximumNumberOfMonthsOfPartyActivity {
+			errorMessage := fmt.Sprintf("Invalid parameter: Number of months (months) must be between 1 and %d",
+				maximumNumberOfMonthsOfPartyActivity)
+			log.Warnf("Parameter out of allowed range: %s (Value: %d)", errorMessage, months)
+			return 0, nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
+		}
+
+		numberOfMonths = months
+	}
+
+	pageParameter := queryParameters.Get("page")
+	if pageParameter != "" {
+		parameter, parameterDescription := "page", "Page"
+		page, httpError := utils.ConvertFromStringToInt(pageParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the page parameter: ", httpError.Message)
+			return 0, nil, httpError
+		}
+		pagination.Page = &page
+	}
+
+	itemsPerPageParameter := queryParameters.Get("itemsPerPage")
+	if itemsPerPageParameter != "" {
+		parameter, parameterDescription := "itemsPerPage", "Items per page"
+		itemsPerPage, httpError := utils.ConvertFromStringToInt(itemsPerPageParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the itemsPerPage parameter: ", httpError.Message)
+			return 0, nil, httpError
+		}
+
+		if itemsPerPage > 100 {
+			errorMessage := fmt.Sprint("Invalid parameter: Items per page (itemsPerPage) must be less than or " +
+				"equal to 100")
+			log.Warnf("Parameter out of allowed range: %s (Value: %d)", errorMessage, itemsPerPage)
+			return 0, nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
+		}
+
+		pagination.ItemsPerPage = &itemsPerPage
+	}
+
+	return numberOfMonths, &pagination, nil
+}
@@ -0,0 +1,598 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+	"vnc-api/adapters/api/endpoints/dto/request"
+	"vnc-api/adapters/api/endpoints/dto/response"
+	"vnc-api/adapters/api/endpoints/handlers/utils"
+	"vnc-api/core/domains/readinglist"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/services"
+)
+
+type ReadingList struct {
+	readingListService services.ReadingList
+}
+
+func NewReadingListHandler(readingListService services.ReadingList) *ReadingList {
+	return &ReadingList{
+		readingListService: readingListService,
+	}
+}
+
+// GetReadingLists
+// @ID          GetReadingLists
+// @Summary     List the reading lists of the user
+// @Tags        Reading Lists
+// @Description This request is responsible for listing the reading lists created by the user. The default reading list, which contains the articles bookmarked for later viewing, is listed first once the user bookmarks an article for later viewing.
+// @Security    BearerAuth
+// @Produce     json
+// @Success 200 {array}  swagger.ReadingList "Successful request"
+// @Failure 401 {object} swagger.HttpError   "Unauthorized access"
+// @Failure 500 {object} swagger.HttpError   "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError   "Some of the services/resources are temporarily unavailable"
+// @Router /reading-lists [GET]
+func (instance ReadingList) GetReadingLists(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	readingListSlice, err := instance.readingListService.GetReadingLists(userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the reading lists of user %s: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	readingLists := make([]response.ReadingList, 0)
+	for _, readingListData := range readingListSlice {
+		readingLists = append(readingLists, *response.NewReadingList(readingListData))
+	}
+
+	return context.JSON(http.StatusOK, readingLists)
+}
+
+// CreateReadingList
+// @ID          CreateReadingList
+// @Summary     Create a reading list
+// @Tags        Reading Lists
+// @Description This request is responsible for creating a new reading list for the user. When the reading list is public, the response includes the link through which it can be shared.
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       requestBody body request.ReadingList true "Request body"
+// @Success 201 {object} swagger.ReadingList "Successful request"
+// @Failure 400 {object} swagger.HttpError   "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError   "Unauthorized access"
+// @Failure 422 {object} swagger.HttpError   "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError   "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError   "Some of the services/resources are temporarily unavailable"
+// @Router /reading-lists [POST]
+func (instance ReadingList) CreateReadingList(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	var readingListRequest request.ReadingList
+	err := context.Bind(&readingListRequest)
+	if err != nil {
+		log.Warn("Error assigning data from reading list creation request to DTO: ", err.Error())
+		return context.JSON(http.StatusBadRequest, response.NewBadRequestError())
+	}
+
+	readingListData, err := readinglist.NewBuilder().
+		Name(readingListRequest.Name).
+		Description(readingListRequest.Description).
+		IsPublic(readingListRequest.IsPublic).
+		Build()
+	if err != nil {
+		log.Warn("Error validating reading list data: ", err.Error())
+		return context.JSON(http.StatusUnprocessableEntity, response.NewHttpError(http.StatusUnprocessableEntity,
+			err.Error()))
+	}
+
+	createdReadingList, err := instance.readingListService.CreateReadingList(*readingListData, userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error creating reading list %s for user %s: %s", readingListData.Name(), userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.JSON(http.StatusCreated, response.NewReadingList(*createdReadingList))
+}
+
+// GetReadingListById
+// @ID          GetReadingListById
+// @Summary     Get the details of a reading list
+// @Tags        Reading Lists
+// @Description This request is responsible for returning the details of a reading list of the user, along with its articles in the order defined by the user.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       readingListId path  string true  "Reading list ID"
+// @Param       page          query int    false "Page number. By default, it is 1"
+// @Param       itemsPerPage  query int    false "Number of articles returned per page. The default is 15 and the allowed values are between 1 and 100"
+// @Success 200 {object} swagger.ReadingListWithArticles "Successful request"
+// @Failure 400 {object} swagger.HttpError               "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError               "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError               "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError               "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError               "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError               "Some of the services/resources are temporarily unavailable"
+// @Router /reading-lists/{readingListId} [GET]
+func (instance ReadingList) GetReadingListById(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	readingListId, httpError := getReadingListIdFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	pagination, httpError := getPaginationQueryParametersFromContext(context)
+	if httpError != nil {
+		log.Warn("getPaginationQueryParametersFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	readingListData, err := instance.readingListService.GetReadingListById(readingListId, userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Reading list %s of user %s could not be found: %s", readingListId, userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Reading list not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving reading list %s of user %s: %s", readingListId, userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return instance.getReadingListWithArticles(context, *readingListData, *pagination, userId)
+}
+
+// UpdateReadingList
+// @ID          UpdateReadingList
+// @Summary     Update a reading list
+// @Tags        Reading Lists
+// @Description This request is responsible for renaming a reading list of the user, changing its description or making it public or private. Making a reading list private invalidates its share link.
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       readingListId path string              true "Reading list ID"
+// @Param       requestBody   body request.ReadingList true "Request body"
+// @Success 200 {object} swagger.ReadingList "Successful request"
+// @Failure 400 {object} swagger.HttpError   "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError   "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError   "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError   "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError   "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError   "Some of the services/resources are temporarily unavailable"
+// @Router /reading-lists/{readingListId} [PUT]
+func (instance ReadingList) UpdateReadingList(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	readingListId, httpError := getReadingListIdFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	var readingListRequest request.ReadingList
+	err := context.Bind(&readingListRequest)
+	if err != nil {
+		log.Warn("Error assigning data from reading list update request to DTO: ", err.Error())
+		return context.JSON(http.StatusBadRequest, response.NewBadRequestError())
+	}
+
+	readingListData, err := readinglist.NewBuilder().
+		Id(readingListId).
+		Name(readingListRequest.Name).
+		Description(readingListRequest.Description).
+		IsPublic(readingListRequest.IsPublic).
+		Build()
+	if err != nil {
+		log.Warn("Error validating reading list data: ", err.Error())
+		return context.JSON(http.StatusUnprocessableEntity, response.NewHttpError(http.StatusUnprocessableEntity,
+			err.Error()))
+	}
+
+	updatedReadingList, err := instance.readingListService.UpdateReadingList(*readingListData, userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Reading list %s of user %s could not be found: %s", readingListId, userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Reading list not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error updating reading list %s of user %s: %s", readingListId, userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.JSON(http.StatusOK, response.NewReadingList(*updatedReadingList))
+}
+
+// DeleteReadingList
+// @ID          DeleteReadingList
+// @Summary     Delete a reading list
+// @Tags        Reading Lists
+// @Description This request is responsible for deleting a reading list of the user. The default reading list, which contains the articles bookmarked for later viewing, cannot be deleted.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       readingListId path string true "Reading list ID"
+// @Success 204 {object} nil               "Successful request"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /reading-lists/{readingListId} [DELETE]
+func (instance ReadingList) DeleteReadingList(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	readingListId, httpError := getReadingListIdFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	err := instance.readingListService.DeleteReadingList(readingListId, userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Reading list %s of user %s could not be found: %s", readingListId, userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Reading list not found"))
+		} else if strings.Contains(err.Error(), "default reading list") {
+			log.Warnf("Could not delete reading list %s of user %s: %s", readingListId, userId, err.Error())
+			return context.JSON(http.StatusUnprocessableEntity, response.NewHttpError(http.StatusUnprocessableEntity,
+				"The default reading list cannot be deleted"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error deleting reading list %s of user %s: %s", readingListId, userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.NoContent(http.StatusNoContent)
+}
+
+// SaveArticleToReadingList
+// @ID          SaveArticleToReadingList
+// @Summary     Add an article to a reading list
+// @Tags        Reading Lists
+// @Description This request is responsible for adding an article to the end of a reading list of the user or, if the article is already in the reading list, updating its note. When the note is omitted, the current note of the article is kept. Adding an article to the default reading list also bookmarks it for later viewing.
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       readingListId path string                  true "Reading list ID"
+// @Param       articleId     path string                  true "Article ID"
+// @Param       requestBody   body request.ReadingListItem true "Request body"
+// @Success 204 {object} nil               "Successful request"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /reading-lists/{readingListId}/articles/{articleId} [PUT]
+func (instance ReadingList) SaveArticleToReadingList(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	readingListId, httpError := getReadingListIdFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	articleIdParameter := context.Param("articleId")
+	parameter, parameterDescription := "articleId", "Article ID"
+	articleId, httpError := utils.ConvertFromStringToUuid(articleIdParameter, parameter, parameterDescription)
+	if httpError != nil {
+		log.Warn("Error converting the articleId parameter: ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	var readingListItem request.ReadingListItem
+	err := context.Bind(&readingListItem)
+	if err != nil {
+		log.Warn("Error assigning data from reading list article request to DTO: ", err.Error())
+		return context.JSON(http.StatusBadRequest, response.NewBadRequestError())
+	}
+
+	if readingListItem.Note != nil {
+		note := strings.TrimSpace(*readingListItem.Note)
+		if utf8.RuneCountInString(note) > 1000 {
+			errorMessage := "The note of the reading list item must be at most 1000 characters long"
+			log.Warn("Error validating reading list item data: ", errorMessage)
+			return context.JSON(http.StatusUnprocessableEntity, response.NewHttpError(http.StatusUnprocessableEntity,
+				errorMessage))
+		}
+		readingListItem.Note = &note
+	}
+
+	err = instance.readingListService.SaveArticleToReadingList(readingListId, userId, articleId,
+		readingListItem.Note)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Reading list %s of user %s could not be found: %s", readingListId, userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Reading list not found"))
+		} else if strings.Contains(err.Error(), "article_fk") {
+			log.Warnf("Could not find article %s added to reading list %s: %s", articleId, readingListId,
+				err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Article not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error adding article %s to reading list %s of user %s: %s", articleId, readingListId, userId,
+			err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.NoContent(http.StatusNoContent)
+}
+
+// RemoveArticleFromReadingList
+// @ID          RemoveArticleFromReadingList
+// @Summary     Remove an article from a reading list
+// @Tags        Reading Lists
+// @Description This request is responsible for removing an article from a reading list of the user. Removing an article from the default reading list also removes its bookmark for later viewing.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       readingListId path string true "Reading list ID"
+// @Param       articleId     path string true "Article ID"
+// @Success 204 {object} nil               "Successful request"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError "Requested resource not found"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /reading-lists/{readingListId}/articles/{articleId} [DELETE]
+func (instance ReadingList) RemoveArticleFromReadingList(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	readingListId, httpError := getReadingListIdFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	articleIdParameter := context.Param("articleId")
+	parameter, parameterDescription := "articleId", "Article ID"
+	articleId, httpError := utils.ConvertFromStringToUuid(articleIdParameter, parameter, parameterDescription)
+	if httpError != nil {
+		log.Warn("Error converting the articleId parameter: ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	err := instance.readingListService.RemoveArticleFromReadingList(readingListId, userId, articleId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Article %s could not be found in reading list %s of user %s: %s", articleId, readingListId,
+				userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Article not found in the reading list"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error removing article %s from reading list %s of user %s: %s", articleId, readingListId,
+			userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.NoContent(http.StatusNoContent)
+}
+
+// SortReadingListArticles
+// @ID          SortReadingListArticles
+// @Summary     Sort the articles of a reading list
+// @Tags        Reading Lists
+// @Description This request is responsible for changing the order of the articles in a reading list of the user. The articles informed are moved to the beginning of the reading list in the order given, and the remaining articles keep their relative order after them.
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       readingListId path string                   true "Reading list ID"
+// @Param       requestBody   body request.ReadingListOrder true "Request body"
+// @Success 204 {object} nil               "Successful request"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /reading-lists/{readingListId}/order [PUT]
+func (instance ReadingList) SortReadingListArticles(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	readingListId, httpError := getReadingListIdFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	var readingListOrder request.ReadingListOrder
+	err := context.Bind(&readingListOrder)
+	if err != nil {
+		log.Warn("Error assigning data from reading list sorting request to DTO: ", err.Error())
+		return context.JSON(http.StatusBadRequest, response.NewBadRequestError())
+	}
+
+	if len(readingListOrder.ArticleIds) == 0 {
+		errorMessage := "Invalid parameter: The list of article IDs (article_ids) must not be empty"
+		log.Warn("Error validating reading list order data: ", errorMessage)
+		return context.JSON(http.StatusUnprocessableEntity, response.NewHttpError(http.StatusUnprocessableEntity,
+			errorMessage))
+	}
+
+	articleIds := make(map[uuid.UUID]bool)
+	for _, articleId := range readingListOrder.ArticleIds {
+		if articleIds[articleId] {
+			errorMessage := fmt.Sprintf("Invalid parameter: Article %s is duplicated in the list of article IDs "+
+				"(article_ids)", articleId)
+			log.Warn("Error validating reading list order data: ", errorMessage)
+			return context.JSON(http.StatusUnprocessableEntity, response.NewHttpError(http.StatusUnprocessableEntity,
+				errorMessage))
+		}
+		articleIds[articleId] = true
+	}
+
+	err = instance.readingListService.SortReadingListArticles(readingListId, userId, readingListOrder.ArticleIds)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Reading list %s of user %s could not be found: %s", readingListId, userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Reading list not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error sorting the articles of reading list %s of user %s: %s", readingListId, userId,
+			err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.NoContent(http.StatusNoContent)
+}
+
+// GetSharedReadingList
+// @ID          GetSharedReadingList
+// @Summary     Get the details of a shared reading list
+// @Tags        Reading Lists
+// @Description This request is responsible for returning the details of a public reading list through its share token, along with its articles in the order defined by its owner.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       shareToken   path  string true  "Share token of the reading list"
+// @Param       page         query int    false "Page number. By default, it is 1"
+// @Param       itemsPerPage query int    false "Number of articles returned per page. The default is 15 and the allowed values are between 1 and 100"
+// @Success 200 {object} swagger.ReadingListWithArticles "Successful request"
+// @Failure 400 {object} swagger.HttpError               "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError               "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError               "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError               "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError               "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError               "Some of the services/resources are temporarily unavailable"
+// @Router /reading-lists/shared/{shareToken} [GET]
+func (instance ReadingList) GetSharedReadingList(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+	shareToken := context.Param("shareToken")
+
+	pagination, httpError := getPaginationQueryParametersFromContext(context)
+	if httpError != nil {
+		log.Warn("getPaginationQueryParametersFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	readingListData, err := instance.readingListService.GetSharedReadingList(shareToken)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warn("Shared reading list could not be found: ", err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Reading list not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Error("Error retrieving the shared reading list: ", err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return instance.getReadingListWithArticles(context, *readingListData, *pagination, userId)
+}
+
+func (instance ReadingList) getReadingListWithArticles(context echo.Context, readingListData readinglist.ReadingList,
+	pagination filters.Pagination, userId uuid.UUID) error {
+	readingListItemSlice, err := instance.readingListService.GetReadingListItems(readingListData.Id(), pagination,
+		userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the articles of reading list %s: %s", readingListData.Id(), err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	readingListItems := make([]response.ReadingListItem, 0)
+	for _, readingListItemData := range readingListItemSlice {
+		readingListItems = append(readingListItems, *response.NewReadingListItem(readingListItemData))
+	}
+
+	readingList := response.NewReadingList(readingListData)
+	readingList.Articles = &response.Pagination{
+		Page:         pagination.GetPage(),
+		ItemsPerPage: pagination.GetItemsPerPage(),
+		Total:        readingListData.NumberOfArticles(),
+		Data:         readingListItems,
+	}
+
+	return context.JSON(http.StatusOK, readingList)
+}
+
+func getReadingListIdFromContext(context echo.Context) (uuid.UUID, *response.HttpError) {
+	readingListIdParameter := context.Param("readingListId")
+	parameter, parameterDescription := "readingListId", "Reading list ID"
+	readingListId, httpError := utils.ConvertFromStringToUuid(readingListIdParameter, parameter,
+		parameterDescription)
+	if httpError != nil {
+		log.Warn("Error converting the readingListId parameter: ", httpError.Message)
+		return uuid.Nil, httpError
+	}
+
+	return readingListId, nil
+}
+
+func getPaginationQueryParametersFromContext(context echo.Context) (*filters.Pagination, *response.HttpError) {
+	var pagination filters.Pagination
+
+	pageParameter := context.QueryParam("page")
+	if pageParameter != "" {
+		parameter, parameterDescription := "page", "Page"
+		page, httpError := utils.ConvertFromStringToInt(pageParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the page parameter: ", httpError.Message)
+			return nil, httpError
+		}
+
+		pagination.Page = &page
+	}
+
+	itemsPerPageParameter := context.QueryParam("itemsPerPage")
+	if itemsPerPageParameter != "" {
+		parameter, parameterDescription := "itemsPerPage", "Items per page"
+		itemsPerPage, httpError := utils.ConvertFromStringToInt(itemsPerPageParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the itemsPerPage parameter: ", httpError.Message)
+			return nil, httpError
+		}
+
+		if itemsPerPage > 100 {
+			errorMessage := fmt.Sprint("Invalid parameter: Items per page (itemsPerPage) must be less than or " +
+				"equal to 100")
+			log.Warnf("Parameter out of allowed range: %s (Value: %d)", errorMessage, itemsPerPage)
+			return nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
+		}
+
+		pagination.ItemsPerPage = &itemsPerPage
+	}
+
+	return &pagination, nil
+}
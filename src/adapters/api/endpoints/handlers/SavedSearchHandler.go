@@ -0,0 +1,467 @@
+package handlers
+
+import (
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"vnc-api/adapters/api/endpoints/dto/request"
+	"vnc-api/adapters/api/endpoints/dto/response"
+	"vnc-api/adapters/api/endpoints/handlers/utils"
+	"vnc-api/core/domains/savedsearch"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/services"
+)
+
+type SavedSearch struct {
+	savedSearchService services.SavedSearch
+}
+
+func NewSavedSearchHandler(savedSearchService services.SavedSearch) *SavedSearch {
+	return &SavedSearch{
+		savedSearchService: savedSearchService,
+	}
+}
+
+// GetSavedSearches
+// @ID          GetSavedSearches
+// @Summary     List the saved searches of the user
+// @Tags        Saved Searches
+// @Description This request is responsible for listing the searches saved by the user, along with the number of new articles that match each search since it was last opened.
+// @Security    BearerAuth
+// @Produce     json
+// @Success 200 {array}  swagger.SavedSearch "Successful request"
+// @Failure 401 {object} swagger.HttpError   "Unauthorized access"
+// @Failure 500 {object} swagger.HttpError   "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError   "Some of the services/resources are temporarily unavailable"
+// @Router /saved-searches [GET]
+func (instance SavedSearch) GetSavedSearches(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	savedSearchSlice, err := instance.savedSearchService.GetSavedSearches(userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving the saved searches of user %s: %s", userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	savedSearches := make([]response.SavedSearch, 0)
+	for _, savedSearchData := range savedSearchSlice {
+		savedSearches = append(savedSearches, *response.NewSavedSearch(savedSearchData))
+	}
+
+	return context.JSON(http.StatusOK, savedSearches)
+}
+
+// CreateSavedSearch
+// @ID          CreateSavedSearch
+// @Summary     Save a search
+// @Tags        Saved Searches
+// @Description This request is responsible for saving a search of articles for the user. The query accepts the same filters as the article listing (GET /articles), in query string format, and the pagination parameters are ignored. When the email notification is enabled, the user receives an email whenever new articles match the search.
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       requestBody body request.SavedSearch true "Request body"
+// @Success 201 {object} swagger.SavedSearch "Successful request"
+// @Failure 400 {object} swagger.HttpError   "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError   "Unauthorized access"
+// @Failure 422 {object} swagger.HttpError   "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError   "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError   "Some of the services/resources are temporarily unavailable"
+// @Router /saved-searches [POST]
+func (instance SavedSearch) CreateSavedSearch(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	var savedSearchRequest request.SavedSearch
+	err := context.Bind(&savedSearchRequest)
+	if err != nil {
+		log.Warn("Error assigning data from saved search creation request to DTO: ", err.Error())
+		return context.JSON(http.StatusBadRequest, response.NewBadRequestError())
+	}
+
+	savedSearchData, httpError := getSavedSearchFromRequest(savedSearchRequest, uuid.Nil)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	createdSavedSearch, err := instance.savedSearchService.CreateSavedSearch(*savedSearchData, userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "maximum number of saved searches") {
+			log.Warnf("Could not create saved search %s for user %s: %s", savedSearchData.Name(), userId,
+				err.Error())
+			return context.JSON(http.StatusUnprocessableEntity, response.NewHttpError(http.StatusUnprocessableEntity,
+				"The maximum number of saved searches has been reached"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error creating saved search %s for user %s: %s", savedSearchData.Name(), userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.JSON(http.StatusCreated, response.NewSavedSearch(*createdSavedSearch))
+}
+
+// GetSavedSearchById
+// @ID          GetSavedSearchById
+// @Summary     Get the details of a saved search
+// @Tags        Saved Searches
+// @Description This request is responsible for returning the details of a saved search of the user.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       savedSearchId path string true "Saved search ID"
+// @Success 200 {object} swagger.SavedSearch "Successful request"
+// @Failure 400 {object} swagger.HttpError   "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError   "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError   "Requested resource not found"
+// @Failure 500 {object} swagger.HttpError   "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError   "Some of the services/resources are temporarily unavailable"
+// @Router /saved-searches/{savedSearchId} [GET]
+func (instance SavedSearch) GetSavedSearchById(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	savedSearchId, httpError := getSavedSearchIdFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	savedSearchData, err := instance.savedSearchService.GetSavedSearchById(savedSearchId, userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Saved search %s of user %s could not be found: %s", savedSearchId, userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Saved search not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error retrieving saved search %s of user %s: %s", savedSearchId, userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.JSON(http.StatusOK, response.NewSavedSearch(*savedSearchData))
+}
+
+// GetSavedSearchAlertUnsubscribePage
+// @ID          GetSavedSearchAlertUnsubscribePage
+// @Summary     Get the confirmation page to disable the email alerts of a saved search
+// @Tags        Saved Searches
+// @Description This request is responsible for returning the page opened by the signed link included in each alert of a saved search, in which the user confirms that they want to disable the email alerts of the search. Opening the link does not disable the alerts, so that link previews and email scanners cannot disable them.
+// @Produce     html
+// @Param       savedSearchId query string true "Saved search ID"
+// @Param       signature     query string true "Signature of the unsubscribe link"
+// @Success 200 {string} string            "Successful request"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 403 {object} swagger.HttpError "Access denied"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Router /saved-searches/unsubscribe [GET]
+func (instance SavedSearch) GetSavedSearchAlertUnsubscribePage(context echo.Context) error {
+	savedSearchId, httpError := utils.ConvertFromStringToUuid(context.QueryParam("savedSearchId"), "savedSearchId",
+		"Saved search ID")
+	if httpError != nil {
+		log.Warn("Error converting the savedSearchId parameter: ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	page, err := instance.savedSearchService.GetSavedSearchAlertUnsubscribePage(savedSearchId,
+		context.QueryParam("signature"))
+	if err != nil {
+		if strings.Contains(err.Error(), "signature") {
+			log.Warnf("Invalid alert unsubscribe link for saved search %s: %s", savedSearchId, err.Error())
+			return context.JSON(http.StatusForbidden, response.NewHttpError(http.StatusForbidden,
+				"The unsubscribe link is invalid"))
+		}
+
+		log.Errorf("Error generating the alert unsubscribe page of saved search %s: %s", savedSearchId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.HTMLBlob(http.StatusOK, page)
+}
+
+// UnsubscribeFromSavedSearchAlertByLink
+// @ID          UnsubscribeFromSavedSearchAlertByLink
+// @Summary     Disable the email alerts of a saved search through the link sent by email
+// @Tags        Saved Searches
+// @Description This request is responsible for disabling the email alerts of a saved search through the signed link included in each alert, without requiring authentication. The search itself is kept. It is sent by the confirmation page, after which the user is redirected to the platform, and by the one-click unsubscribe of email clients (RFC 8058), which send the List-Unsubscribe=One-Click body.
+// @Accept      x-www-form-urlencoded
+// @Produce     json
+// @Param       savedSearchId    query    string true  "Saved search ID"
+// @Param       signature        query    string true  "Signature of the unsubscribe link"
+// @Param       List-Unsubscribe formData string false "One-click unsubscribe of email clients. Accepted value: One-Click"
+// @Success 204 {object} nil               "Successful request"
+// @Success 303 {object} nil               "Successful request, redirecting to the platform"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 403 {object} swagger.HttpError "Access denied"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /saved-searches/unsubscribe [POST]
+func (instance SavedSearch) UnsubscribeFromSavedSearchAlertByLink(context echo.Context) error {
+	savedSearchId, httpError := utils.ConvertFromStringToUuid(context.QueryParam("savedSearchId"), "savedSearchId",
+		"Saved search ID")
+	if httpError != nil {
+		log.Warn("Error converting the savedSearchId parameter: ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	err := instance.savedSearchService.UnsubscribeFromSavedSearchAlertWithSignature(savedSearchId,
+		context.QueryParam("signature"))
+	if err != nil && !strings.Contains(err.Error(), "no rows") {
+		if strings.Contains(err.Error(), "signature") {
+			log.Warnf("Invalid alert unsubscribe link for saved search %s: %s", savedSearchId, err.Error())
+			return context.JSON(http.StatusForbidden, response.NewHttpError(http.StatusForbidden,
+				"The unsubscribe link is invalid"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error disabling the alerts of saved search %s through the link: %s", savedSearchId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	if context.FormValue("List-Unsubscribe") == "One-Click" {
+		return context.NoContent(http.StatusNoContent)
+	}
+
+	return context.Redirect(http.StatusSeeOther, os.Getenv("APPLICATION_URL")+"/saved-searches/unsubscribed")
+}
+
+// GetSavedSearchArticles
+// @ID          GetSavedSearchArticles
+// @Summary     Run a saved search
+// @Tags        Saved Searches
+// @Description This request is responsible for listing the articles that match a saved search of the user. Running the search marks it as opened, resetting its number of new articles.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       savedSearchId path  string true  "Saved search ID"
+// @Param       page          query int    false "Page number. By default, it is 1"
+// @Param       itemsPerPage  query int    false "Number of articles returned per page. The default is 15 and the allowed values are between 1 and 100"
+// @Success 200 {object} swagger.ArticlePagination "Successful request"
+// @Failure 400 {object} swagger.HttpError         "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError         "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError         "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError         "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError         "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError         "Some of the services/resources are temporarily unavailable"
+// @Router /saved-searches/{savedSearchId}/articles [GET]
+func (instance SavedSearch) GetSavedSearchArticles(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	savedSearchId, httpError := getSavedSearchIdFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	pagination, httpError := getPaginationQueryParametersFromContext(context)
+	if httpError != nil {
+		log.Warn("getPaginationQueryParametersFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	articleSlice, totalNumberOfArticles, err := instance.savedSearchService.GetSavedSearchArticles(savedSearchId,
+		*pagination, userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Saved search %s of user %s could not be found: %s", savedSearchId, userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Saved search not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error running saved search %s of user %s: %s", savedSearchId, userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	articles := make([]response.Article, 0)
+	for _, articleData := range articleSlice {
+		articles = append(articles, *response.NewArticle(articleData))
+	}
+
+	return context.JSON(http.StatusOK, response.Pagination{
+		Page:         pagination.GetPage(),
+		ItemsPerPage: pagination.GetItemsPerPage(),
+		Total:        totalNumberOfArticles,
+		Data:         articles,
+	})
+}
+
+// UpdateSavedSearch
+// @ID          UpdateSavedSearch
+// @Summary     Update a saved search
+// @Tags        Saved Searches
+// @Description This request is responsible for renaming a saved search of the user, changing its query or enabling or disabling its email notification. Changing the query resets the number of new articles of the search.
+// @Security    BearerAuth
+// @Accept      json
+// @Produce     json
+// @Param       savedSearchId path string              true "Saved search ID"
+// @Param       requestBody   body request.SavedSearch true "Request body"
+// @Success 200 {object} swagger.SavedSearch "Successful request"
+// @Failure 400 {object} swagger.HttpError   "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError   "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError   "Requested resource not found"
+// @Failure 422 {object} swagger.HttpError   "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError   "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError   "Some of the services/resources are temporarily unavailable"
+// @Router /saved-searches/{savedSearchId} [PUT]
+func (instance SavedSearch) UpdateSavedSearch(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	savedSearchId, httpError := getSavedSearchIdFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	var savedSearchRequest request.SavedSearch
+	err := context.Bind(&savedSearchRequest)
+	if err != nil {
+		log.Warn("Error assigning data from saved search update request to DTO: ", err.Error())
+		return context.JSON(http.StatusBadRequest, response.NewBadRequestError())
+	}
+
+	savedSearchData, httpError := getSavedSearchFromRequest(savedSearchRequest, savedSearchId)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	updatedSavedSearch, err := instance.savedSearchService.UpdateSavedSearch(*savedSearchData, userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Saved search %s of user %s could not be found: %s", savedSearchId, userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Saved search not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error updating saved search %s of user %s: %s", savedSearchId, userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.JSON(http.StatusOK, response.NewSavedSearch(*updatedSavedSearch))
+}
+
+// DeleteSavedSearch
+// @ID          DeleteSavedSearch
+// @Summary     Delete a saved search
+// @Tags        Saved Searches
+// @Description This request is responsible for deleting a saved search of the user, which also stops its email notifications.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       savedSearchId path string true "Saved search ID"
+// @Success 204 {object} nil               "Successful request"
+// @Failure 400 {object} swagger.HttpError "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError "Unauthorized access"
+// @Failure 404 {object} swagger.HttpError "Requested resource not found"
+// @Failure 500 {object} swagger.HttpError "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError "Some of the services/resources are temporarily unavailable"
+// @Router /saved-searches/{savedSearchId} [DELETE]
+func (instance SavedSearch) DeleteSavedSearch(context echo.Context) error {
+	userId := utils.GetUserIdFromAuthorizationHeader(context)
+
+	savedSearchId, httpError := getSavedSearchIdFromContext(context)
+	if httpError != nil {
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	err := instance.savedSearchService.DeleteSavedSearch(savedSearchId, userId)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows") {
+			log.Warnf("Saved search %s of user %s could not be found: %s", savedSearchId, userId, err.Error())
+			return context.JSON(http.StatusNotFound, response.NewHttpError(http.StatusNotFound,
+				"Saved search not found"))
+		} else if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Errorf("Error deleting saved search %s of user %s: %s", savedSearchId, userId, err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	return context.NoContent(http.StatusNoContent)
+}
+
+func getSavedSearchIdFromContext(context echo.Context) (uuid.UUID, *response.HttpError) {
+	savedSearchIdParameter := context.Param("savedSearchId")
+	parameter, parameterDescription := "savedSearchId", "Saved search ID"
+	savedSearchId, httpError := utils.ConvertFromStringToUuid(savedSearchIdParameter, parameter,
+		parameterDescription)
+	if httpError != nil {
+		log.Warn("Error converting the savedSearchId parameter: ", httpError.Message)
+		return uuid.Nil, httpError
+	}
+
+	return savedSearchId, nil
+}
+
+func getSavedSearchFromRequest(savedSearchRequest request.SavedSearch, savedSearchId uuid.UUID) (
+	*savedsearch.SavedSearch, *response.HttpError) {
+	articleFilter, httpError := getSavedSearchFilterFromQuery(savedSearchRequest.Query)
+	if httpError != nil {
+		return nil, httpError
+	}
+
+	savedSearchBuilder := savedsearch.NewBuilder()
+
+	if savedSearchId != uuid.Nil {
+		savedSearchBuilder.Id(savedSearchId)
+	}
+
+	savedSearchData, err := savedSearchBuilder.
+		Name(savedSearchRequest.Name).
+		Filter(*articleFilter).
+		NotifyByEmail(savedSearchRequest.NotifyByEmail).
+		Build()
+	if err != nil {
+		log.Warn("Error validating saved search data: ", err.Error())
+		return nil, response.NewHttpError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	return savedSearchData, nil
+}
+
+func getSavedSearchFilterFromQuery(query string) (*filters.Article, *response.HttpError) {
+	queryParameters, err := url.ParseQuery(strings.TrimPrefix(strings.TrimSpace(query), "?"))
+	if err != nil {
+		errorMessage := "Invalid parameter: The saved search query (query) is not a valid query string"
+		log.Warnf("%s: %s", errorMessage, err.Error())
+		return nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
+	}
+
+	articleFilter, httpError := getArticleFilterFromQueryParameters(queryParameters)
+	if httpError != nil {
+		log.Warn("getArticleFilterFromQueryParameters(): ", httpError.Message)
+		return nil, httpError
+	}
+
+	excludeReadParameter := queryParameters.Get("excludeRead")
+	if excludeReadParameter != "" {
+		parameter, parameterDescription := "excludeRead", "Remove articles already read by the user?"
+		excludeRead, httpError := utils.ConvertFromStringToBool(excludeReadParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the excludeRead parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		articleFilter.ExcludeRead = excludeRead
+	}
+
+	// The end date of events in the future is calculated whenever the search runs
+	if articleFilter.Event.RemoveEventsInTheFuture != nil && *articleFilter.Event.RemoveEventsInTheFuture {
+		articleFilter.Event.EndDate = nil
+	}
+
+	return articleFilter, nil
+}
@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+	"vnc-api/adapters/api/endpoints/dto/response"
+	"vnc-api/core/interfaces/services"
+)
+
+type Search struct {
+	service services.Search
+}
+
+func NewSearchHandler(service services.Search) *Search {
+	return &Search{service: service}
+}
+
+// GetSuggestions
+// @ID          GetSuggestions
+// @Summary     List search suggestions
+// @Tags        Search
+// @Description This request is responsible for listing the suggestions (deputies, parties, legislative bodies, propositions and articles) that match the text typed in the search box. Propositions can be searched by the acronym of their type, number and year (e.g. PL 1234/2024). The search ignores accents and tolerates small typos. The ID of each suggestion can be used directly in the filter parameters indicated by it.
+// @Security    BearerAuth
+// @Produce     json
+// @Param       q query string true "Text typed in the search box. It must contain at least 2 characters"
+// @Success 200 {array}  swagger.Suggestion "Successful request"
+// @Failure 400 {object} swagger.HttpError  "Badly formatted request"
+// @Failure 401 {object} swagger.HttpError  "Unauthorized access"
+// @Failure 500 {object} swagger.HttpError  "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError  "Some of the services/resources are temporarily unavailable"
+// @Router /search/suggest [GET]
+func (instance Search) GetSuggestions(context echo.Context) error {
+	text := strings.TrimSpace(context.QueryParam("q"))
+	if utf8.RuneCountInString(text) < 2 {
+		errorMessage := fmt.Sprint("Invalid parameter: The search text (q) must contain at least 2 characters")
+		log.Warn(errorMessage)
+		return context.JSON(http.StatusBadRequest, response.NewHttpError(http.StatusBadRequest, errorMessage))
+	}
+
+	suggestionSlice, err := instance.service.GetSuggestions(text)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Error("Error retrieving search suggestions: ", err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	suggestions := make([]response.Suggestion, 0)
+	for _, suggestionData := range suggestionSlice {
+		suggestions = append(suggestions, *response.NewSuggestion(suggestionData))
+	}
+
+	return context.JSON(http.StatusOK, suggestions)
+}
@@ -0,0 +1,260 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/gommon/log"
+	"net/http"
+	"net/url"
+	"strings"
+	"vnc-api/adapters/api/endpoints/dto/response"
+	"vnc-api/adapters/api/endpoints/handlers/utils"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/services"
+)
+
+type Statistics struct {
+	statisticsService services.Statistics
+}
+
+func NewStatisticsHandler(statisticsService services.Statistics) *Statistics {
+	return &Statistics{
+		statisticsService: statisticsService,
+	}
+}
+
+// GetVotingStatistics
+// @ID          GetVotingStatistics
+// @Summary     Get the aggregated outcomes of the votes
+// @Tags        Statistics
+// @Description This request is responsible for returning the number of approved, rejected and undetermined votes (according to whether the voting was approved), grouped by the legislative body responsible for the voting, the type of the main proposition of the voting and the month in which the result was announced. Votes without a main proposition are grouped without a proposition type. The result can be returned as JSON or, with the format parameter, as a CSV file with one line per group.
+// @Produce     json,text/csv
+// @Param       votingStartDate         query string false "Date from which the voting results were announced. Accepted format: YYYY-MM-DD"
+// @Param       votingEndDate           query string false "Date until which the voting results were announced. Accepted format: YYYY-MM-DD"
+// @Param       votingResult            query string false "Voting result. Accepted values: approved, rejected and undetermined"
+// @Param       votingLegislativeBodyId query string false "ID of the legislative body responsible for the voting"
+// @Param       format                  query string false "Format of the response. Accepted values: json and csv. By default, it is json"
+// @Success 200 {object} swagger.VotingStatistics "Successful request"
+// @Failure 400 {object} swagger.HttpError        "Badly formatted request"
+// @Failure 422 {object} swagger.HttpError        "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError        "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError        "Some of the services/resources are temporarily unavailable"
+// @Router /stats/votes [GET]
+func (instance Statistics) GetVotingStatistics(context echo.Context) error {
+	votingFilter, httpError := getVotingFilterFromQueryParameters(context.QueryParams())
+	if httpError != nil {
+		log.Warn("getVotingFilterFromQueryParameters(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	format, httpError := getStatisticsFormatFromContext(context)
+	if httpError != nil {
+		log.Warn("getStatisticsFormatFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	votingOutcomes, err := instance.statisticsService.GetVotingOutcomes(*votingFilter)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Error("Error retrieving the voting outcomes: ", err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	if format == "csv" {
+		votingOutcomesCsv, err := response.NewVotingOutcomesCsv(votingOutcomes)
+		if err != nil {
+			log.Error("Error encoding the voting outcomes as CSV: ", err.Error())
+			return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+		}
+
+		context.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename=\"votes.csv\"")
+		return context.Blob(http.StatusOK, "text/csv; charset=UTF-8", votingOutcomesCsv)
+	}
+
+	return context.JSON(http.StatusOK, response.NewVotingStatistics(votingOutcomes))
+}
+
+// GetFederatedUnitStatistics
+// @ID          GetFederatedUnitStatistics
+// @Summary     Get the volume of propositions per federated unit
+// @Tags        Statistics
+// @Description This request is responsible for returning, for each federated unit (UF), the number of propositions drafted by its deputies and the number of deputies who drafted them. The federated unit considered is the one the deputy represented when the proposition was drafted. Propositions drafted by deputies from more than one federated unit are counted in each of them. The result can be returned as JSON or, with the format parameter, as a CSV file with one line per federated unit.
+// @Produce     json,text/csv
+// @Param       startDate         query string false "Date from which the propositions were submitted. Accepted format: YYYY-MM-DD"
+// @Param       endDate           query string false "Date until which the propositions were submitted. Accepted format: YYYY-MM-DD"
+// @Param       propositionTypeId query string false "Proposition type ID"
+// @Param       format            query string false "Format of the response. Accepted values: json and csv. By default, it is json"
+// @Success 200 {object} swagger.FederatedUnitStatistics "Successful request"
+// @Failure 400 {object} swagger.HttpError               "Badly formatted request"
+// @Failure 422 {object} swagger.HttpError               "Some of the data provided is invalid"
+// @Failure 500 {object} swagger.HttpError               "An unexpected error occurred while processing the request"
+// @Failure 503 {object} swagger.HttpError               "Some of the services/resources are temporarily unavailable"
+// @Router /stats/states [GET]
+func (instance Statistics) GetFederatedUnitStatistics(context echo.Context) error {
+	federatedUnitActivityFilter, httpError := getFederatedUnitActivityFilterFromContext(context)
+	if httpError != nil {
+		log.Warn("getFederatedUnitActivityFilterFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	format, httpError := getStatisticsFormatFromContext(context)
+	if httpError != nil {
+		log.Warn("getStatisticsFormatFromContext(): ", httpError.Message)
+		return context.JSON(httpError.Code, httpError)
+	}
+
+	federatedUnitActivities, err := instance.statisticsService.GetFederatedUnitActivities(
+		*federatedUnitActivityFilter)
+	if err != nil {
+		if strings.Contains(err.Error(), "connection refused") {
+			log.Error("Database unavailable: ", err.Error())
+			return context.JSON(http.StatusServiceUnavailable, response.NewServiceUnavailableError())
+		}
+
+		log.Error("Error retrieving the federated unit activities: ", err.Error())
+		return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+	}
+
+	if format == "csv" {
+		federatedUnitActivitiesCsv, err := response.NewFederatedUnitActivitiesCsv(federatedUnitActivities)
+		if err != nil {
+			log.Error("Error encoding the federated unit activities as CSV: ", err.Error())
+			return context.JSON(http.StatusInternalServerError, response.NewInternalServerError())
+		}
+
+		context.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename=\"states.csv\"")
+		return context.Blob(http.StatusOK, "text/csv; charset=UTF-8", federatedUnitActivitiesCsv)
+	}
+
+	return context.JSON(http.StatusOK, response.NewFederatedUnitStatistics(federatedUnitActivities))
+}
+
+func getVotingFilterFromQueryParameters(queryParameters url.Values) (*filters.Voting, *response.HttpError) {
+	var votingFilter filters.Voting
+
+	votingStartDateParameter := queryParameters.Get("votingStartDate")
+	if votingStartDateParameter != "" {
+		parameter, parameterDescription := "votingStartDate", "Voting start date"
+		votingStartDate, httpError := utils.ConvertFromStringToTime(votingStartDateParameter, parameter,
+			parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the votingStartDate parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		votingFilter.StartDate = &votingStartDate
+	}
+
+	votingEndDateParameter := queryParameters.Get("votingEndDate")
+	if votingEndDateParameter != "" {
+		parameter, parameterDescription := "votingEndDate", "Voting end date"
+		votingEndDate, httpError := utils.ConvertFromStringToTime(votingEndDateParameter, parameter,
+			parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the votingEndDate parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		votingFilter.EndDate = &votingEndDate
+	}
+
+	if votingFilter.StartDate != nil && votingFilter.EndDate != nil &&
+		votingFilter.StartDate.After(*votingFilter.EndDate) {
+		errorMessage := fmt.Sprint("Invalid parameters: The voting start date parameter (votingStartDate) " +
+			"cannot be greater than the voting end date parameter (votingEndDate)")
+		log.Warn(errorMessage)
+		return nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
+	}
+
+	votingResultParameter := queryParameters.Get("votingResult")
+	if votingResultParameter != "" {
+		if votingResultParameter != "approved" && votingResultParameter != "rejected" &&
+			votingResultParameter != "undetermined" {
+			errorMessage := fmt.Sprint("Invalid parameter: Voting result (votingResult)")
+			log.Warn(errorMessage)
+			return nil, response.NewHttpError(http.StatusBadRequest, errorMessage)
+		}
+		votingFilter.Result = votingResultParameter
+	}
+
+	votingLegislativeBodyIdParameter := queryParameters.Get("votingLegislativeBodyId")
+	if votingLegislativeBodyIdParameter != "" {
+		parameter, parameterDescription := "votingLegislativeBodyId", "Voting legislative body ID"
+		votingLegislativeBodyId, httpError := utils.ConvertFromStringToUuid(votingLegislativeBodyIdParameter, parameter,
+			parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the votingLegislativeBodyId parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		votingFilter.LegislativeBodyId = &votingLegislativeBodyId
+	}
+
+	return &votingFilter, nil
+}
+
+func getFederatedUnitActivityFilterFromContext(context echo.Context) (*filters.FederatedUnitActivity,
+	*response.HttpError) {
+	var federatedUnitActivityFilter filters.FederatedUnitActivity
+	queryParameters := context.QueryParams()
+
+	startDateParameter := queryParameters.Get("startDate")
+	if startDateParameter != "" {
+		parameter, parameterDescription := "startDate", "Start date"
+		startDate, httpError := utils.ConvertFromStringToTime(startDateParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the startDate parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		federatedUnitActivityFilter.StartDate = &startDate
+	}
+
+	endDateParameter := queryParameters.Get("endDate")
+	if endDateParameter != "" {
+		parameter, parameterDescription := "endDate", "End date"
+		endDate, httpError := utils.ConvertFromStringToTime(endDateParameter, parameter, parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the endDate parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		federatedUnitActivityFilter.EndDate = &endDate
+	}
+
+	if federatedUnitActivityFilter.StartDate != nil && federatedUnitActivityFilter.EndDate != nil &&
+		federatedUnitActivityFilter.StartDate.After(*federatedUnitActivityFilter.EndDate) {
+		errorMessage := fmt.Sprint("Invalid parameters: The start date parameter (startDate) cannot be greater " +
+			"than the end date parameter (endDate)")
+		log.Warn(errorMessage)
+		return nil, response.NewHttpError(http.StatusUnprocessableEntity, errorMessage)
+	}
+
+	propositionTypeIdParameter := queryParameters.Get("propositionTypeId")
+	if propositionTypeIdParameter != "" {
+		parameter, parameterDescription := "propositionTypeId", "Proposition type ID"
+		propositionTypeId, httpError := utils.ConvertFromStringToUuid(propositionTypeIdParameter, parameter,
+			parameterDescription)
+		if httpError != nil {
+			log.Warn("Error converting the propositionTypeId parameter: ", httpError.Message)
+			return nil, httpError
+		}
+		federatedUnitActivityFilter.PropositionTypeId = &propositionTypeId
+	}
+
+	return &federatedUnitActivityFilter, nil
+}
+
+func getStatisticsFormatFromContext(context echo.Context) (string, *response.HttpError) {
+	format := context.QueryParam("format")
+	if format == "" {
+		return "json", nil
+	}
+
+	if format != "json" && format != "csv" {
+		errorMessage := fmt.Sprint("Invalid parameter: Format (format) must be json or csv")
+		log.Warn(errorMessage)
+		return "", response.NewHttpError(http.StatusBadRequest, errorMessage)
+	}
+
+	return format, nil
+}
@@ -13,11 +13,20 @@ func loadArticleRoutes(group *echo.Group) {
 	group.GET("", newsHandler.GetArticles)
 	group.GET("/trending", newsHandler.GetTrendingArticles)
 	group.GET("/trending/type", newsHandler.GetTrendingArticlesByType)
+	group.GET("/recommended", newsHandler.GetRecommendedArticles)
+	group.GET("/following", newsHandler.GetFollowedArticles)
 	group.GET("/view-later", newsHandler.GetArticlesToViewLater)
+	group.PUT("/view-later/batch", newsHandler.SaveArticlesToViewLater)
+	group.PUT("/rating/batch", newsHandler.SaveArticleRatings)
+	group.GET("/history", newsHandler.GetArticlesFromHistory)
+	group.DELETE("/history", newsHandler.ClearHistory)
+	group.DELETE("/history/:articleId", newsHandler.DeleteArticleFromHistory)
 	group.GET("/:articleId/proposition", newsHandler.GetPropositionArticleById)
+	group.GET("/:articleId/proposition/timeline", newsHandler.GetPropositionTimelineByArticleId)
 	group.GET("/:articleId/voting", newsHandler.GetVotingArticleById)
 	group.GET("/:articleId/event", newsHandler.GetEventArticleById)
 	group.GET("/:articleId/newsletter", newsHandler.GetNewsletterArticleById)
+	group.POST("/:articleId/view", newsHandler.RegisterArticleView)
 	group.PUT("/:articleId/rating", newsHandler.SaveArticleRating)
 	group.PUT("/:articleId/view-later", newsHandler.SaveArticleToViewLater)
 }
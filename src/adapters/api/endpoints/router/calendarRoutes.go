@@ -0,0 +1,15 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"vnc-api/config/dicontainer"
+)
+
+func loadCalendarRoutes(group *echo.Group) {
+	calendarHandler := dicontainer.GetCalendarHandler()
+
+	group = group.Group("/calendar")
+
+	group.GET("/events.ics", calendarHandler.GetEventCalendar)
+	group.GET("/private/:calendarToken/events.ics", calendarHandler.GetPrivateEventCalendar)
+}
@@ -0,0 +1,16 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"vnc-api/config/dicontainer"
+)
+
+func loadDeputyRoutes(group *echo.Group) {
+	deputyHandler := dicontainer.GetDeputyHandler()
+
+	group = group.Group("/deputies")
+
+	group.GET("/compare", deputyHandler.GetDeputyComparison)
+	group.GET("/:deputyId", deputyHandler.GetDeputyById)
+	group.GET("/:deputyId/votes", deputyHandler.GetDeputyVotes)
+}
@@ -0,0 +1,15 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"vnc-api/config/dicontainer"
+)
+
+func loadEmailDigestRoutes(group *echo.Group) {
+	emailDigestHandler := dicontainer.GetEmailDigestHandler()
+
+	group = group.Group("/email-digest")
+
+	group.GET("/unsubscribe", emailDigestHandler.GetEmailDigestUnsubscribePage)
+	group.POST("/unsubscribe", emailDigestHandler.UnsubscribeFromEmailDigestByLink)
+}
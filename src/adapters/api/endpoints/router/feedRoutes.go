@@ -0,0 +1,14 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"vnc-api/config/dicontainer"
+)
+
+func loadFeedRoutes(group *echo.Group) {
+	feedHandler := dicontainer.GetFeedHandler()
+
+	group = group.Group("/feeds")
+
+	group.GET("/:feed", feedHandler.GetArticleFeed)
+}
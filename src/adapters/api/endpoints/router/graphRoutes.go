@@ -0,0 +1,14 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"vnc-api/config/dicontainer"
+)
+
+func loadGraphRoutes(group *echo.Group) {
+	graphHandler := dicontainer.GetGraphHandler()
+
+	group = group.Group("/graphs")
+
+	group.GET("/coauthorship", graphHandler.GetCoAuthorshipGraph)
+}
@@ -0,0 +1,14 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"vnc-api/config/dicontainer"
+)
+
+func loadLegislativeBodyRoutes(group *echo.Group) {
+	legislativeBodyHandler := dicontainer.GetLegislativeBodyHandler()
+
+	group = group.Group("/legislative-bodies")
+
+	group.GET("/:legislativeBodyId", legislativeBodyHandler.GetLegislativeBodyById)
+}
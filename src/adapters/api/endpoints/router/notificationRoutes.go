@@ -0,0 +1,18 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"vnc-api/config/dicontainer"
+)
+
+func loadNotificationRoutes(group *echo.Group) {
+	notificationHandler := dicontainer.GetNotificationHandler()
+
+	group = group.Group("/notifications")
+
+	group.GET("", notificationHandler.GetNotifications)
+	group.PATCH("/read", notificationHandler.MarkAllNotificationsAsRead)
+	group.GET("/preferences", notificationHandler.GetNotificationPreferences)
+	group.PUT("/preferences/:type", notificationHandler.SaveNotificationPreference)
+	group.PATCH("/:notificationId/read", notificationHandler.MarkNotificationAsRead)
+}
@@ -0,0 +1,14 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"vnc-api/config/dicontainer"
+)
+
+func loadPartyRoutes(group *echo.Group) {
+	partyHandler := dicontainer.GetPartyHandler()
+
+	group = group.Group("/parties")
+
+	group.GET("/:partyId", partyHandler.GetPartyById)
+}
@@ -0,0 +1,22 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"vnc-api/config/dicontainer"
+)
+
+func loadReadingListRoutes(group *echo.Group) {
+	readingListHandler := dicontainer.GetReadingListHandler()
+
+	group = group.Group("/reading-lists")
+
+	group.GET("", readingListHandler.GetReadingLists)
+	group.POST("", readingListHandler.CreateReadingList)
+	group.GET("/shared/:shareToken", readingListHandler.GetSharedReadingList)
+	group.GET("/:readingListId", readingListHandler.GetReadingListById)
+	group.PUT("/:readingListId", readingListHandler.UpdateReadingList)
+	group.DELETE("/:readingListId", readingListHandler.DeleteReadingList)
+	group.PUT("/:readingListId/order", readingListHandler.SortReadingListArticles)
+	group.PUT("/:readingListId/articles/:articleId", readingListHandler.SaveArticleToReadingList)
+	group.DELETE("/:readingListId/articles/:articleId", readingListHandler.RemoveArticleFromReadingList)
+}
@@ -26,5 +26,17 @@ func (instance *router) Load(group *echo.Group) {
 	loadAuthenticationRoutes(v1Group)
 	loadUserRoutes(v1Group)
 	loadResourcesRoutes(v1Group)
+	loadDeputyRoutes(v1Group)
+	loadPartyRoutes(v1Group)
+	loadLegislativeBodyRoutes(v1Group)
+	loadStatisticsRoutes(v1Group)
+	loadGraphRoutes(v1Group)
 	loadArticleRoutes(v1Group)
+	loadReadingListRoutes(v1Group)
+	loadSavedSearchRoutes(v1Group)
+	loadFeedRoutes(v1Group)
+	loadCalendarRoutes(v1Group)
+	loadSearchRoutes(v1Group)
+	loadEmailDigestRoutes(v1Group)
+	loadNotificationRoutes(v1Group)
 }
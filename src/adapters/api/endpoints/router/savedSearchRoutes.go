@@ -0,0 +1,21 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"vnc-api/config/dicontainer"
+)
+
+func loadSavedSearchRoutes(group *echo.Group) {
+	savedSearchHandler := dicontainer.GetSavedSearchHandler()
+
+	group = group.Group("/saved-searches")
+
+	group.GET("", savedSearchHandler.GetSavedSearches)
+	group.POST("", savedSearchHandler.CreateSavedSearch)
+	group.GET("/:savedSearchId", savedSearchHandler.GetSavedSearchById)
+	group.PUT("/:savedSearchId", savedSearchHandler.UpdateSavedSearch)
+	group.DELETE("/:savedSearchId", savedSearchHandler.DeleteSavedSearch)
+	group.GET("/:savedSearchId/articles", savedSearchHandler.GetSavedSearchArticles)
+	group.GET("/unsubscribe", savedSearchHandler.GetSavedSearchAlertUnsubscribePage)
+	group.POST("/unsubscribe", savedSearchHandler.UnsubscribeFromSavedSearchAlertByLink)
+}
@@ -0,0 +1,14 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"vnc-api/config/dicontainer"
+)
+
+func loadSearchRoutes(group *echo.Group) {
+	searchHandler := dicontainer.GetSearchHandler()
+
+	group = group.Group("/search")
+
+	group.GET("/suggest", searchHandler.GetSuggestions)
+}
@@ -0,0 +1,15 @@
+package router
+
+import (
+	"github.com/labstack/echo/v4"
+	"vnc-api/config/dicontainer"
+)
+
+func loadStatisticsRoutes(group *echo.Group) {
+	statisticsHandler := dicontainer.GetStatisticsHandler()
+
+	group = group.Group("/stats")
+
+	group.GET("/votes", statisticsHandler.GetVotingStatistics)
+	group.GET("/states", statisticsHandler.GetFederatedUnitStatistics)
+}
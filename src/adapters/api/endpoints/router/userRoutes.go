@@ -7,9 +7,21 @@ import (
 
 func loadUserRoutes(group *echo.Group) {
 	userHandler := dicontainer.GetUserHandler()
+	followHandler := dicontainer.GetFollowHandler()
+	emailDigestHandler := dicontainer.GetEmailDigestHandler()
+	calendarHandler := dicontainer.GetCalendarHandler()
 
 	group = group.Group("/user")
 
 	group.PATCH("/resend-activation-email", userHandler.ResendActivationEmail)
 	group.PATCH("/activate-account", userHandler.ActivateAccount)
+	group.GET("/following", followHandler.GetFollows)
+	group.PUT("/following/:resourceType/:resourceId", followHandler.FollowResource)
+	group.DELETE("/following/:resourceType/:resourceId", followHandler.UnfollowResource)
+	group.GET("/email-digest", emailDigestHandler.GetEmailDigest)
+	group.PUT("/email-digest", emailDigestHandler.SubscribeToEmailDigest)
+	group.DELETE("/email-digest", emailDigestHandler.UnsubscribeFromEmailDigest)
+	group.GET("/calendar", calendarHandler.GetPrivateCalendar)
+	group.PUT("/calendar", calendarHandler.CreatePrivateCalendar)
+	group.DELETE("/calendar", calendarHandler.DeletePrivateCalendar)
 }
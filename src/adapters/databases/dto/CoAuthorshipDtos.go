@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+)
+
+type CoAuthorshipNode struct {
+	Id                   uuid.UUID `db:"coauthorship_node_id"`
+	Label                string    `db:"coauthorship_node_label"`
+	Type                 string    `db:"coauthorship_node_type"`
+	PartyAcronym         string    `db:"coauthorship_node_party_acronym"`
+	FederatedUnit        string    `db:"coauthorship_node_federated_unit"`
+	ExternalAuthorType   string    `db:"coauthorship_node_external_author_type"`
+	NumberOfPropositions int       `db:"coauthorship_node_number_of_propositions"`
+}
+
+type CoAuthorshipEdge struct {
+	SourceId uuid.UUID `db:"coauthorship_edge_source_id"`
+	TargetId uuid.UUID `db:"coauthorship_edge_target_id"`
+	Weight   int       `db:"coauthorship_edge_weight"`
+}
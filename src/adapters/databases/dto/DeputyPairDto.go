@@ -0,0 +1,13 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+)
+
+type DeputyPair struct {
+	FirstDeputyId                  uuid.UUID `db:"deputy_pair_first_deputy_id"`
+	SecondDeputyId                 uuid.UUID `db:"deputy_pair_second_deputy_id"`
+	NumberOfCoAuthoredPropositions int       `db:"deputy_pair_number_of_co_authored_propositions"`
+	NumberOfCommonVotes            int       `db:"deputy_pair_number_of_common_votes"`
+	NumberOfMatchingVotes          int       `db:"deputy_pair_number_of_matching_votes"`
+}
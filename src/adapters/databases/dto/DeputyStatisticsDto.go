@@ -0,0 +1,8 @@
+package dto
+
+type DeputyStatistics struct {
+	NumberOfPropositions         int `db:"number_of_propositions"`
+	NumberOfRapporteurships      int `db:"number_of_rapporteurships"`
+	NumberOfVotedPropositions    int `db:"number_of_voted_propositions"`
+	NumberOfApprovedPropositions int `db:"number_of_approved_propositions"`
+}
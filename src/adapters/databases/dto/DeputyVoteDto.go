@@ -0,0 +1,11 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+)
+
+type DeputyVote struct {
+	Id   uuid.UUID `db:"deputy_vote_id"`
+	Vote string    `db:"deputy_vote_vote"`
+	*Deputy
+}
@@ -0,0 +1,12 @@
+package dto
+
+import "time"
+
+type EmailDigest struct {
+	Frequency  string    `db:"email_digest_frequency"`
+	LastSentAt time.Time `db:"email_digest_last_sent_at"`
+	SentAt     time.Time `db:"email_digest_sent_at"`
+	CreatedAt  time.Time `db:"email_digest_created_at"`
+	UpdatedAt  time.Time `db:"email_digest_updated_at"`
+	*User
+}
@@ -17,6 +17,8 @@ type Event struct {
 	VideoUrl          string    `db:"event_video_url"`
 	SpecificType      string    `db:"event_specific_type"`
 	SpecificSituation string    `db:"event_specific_situation"`
+	CreatedAt         time.Time `db:"event_created_at"`
+	UpdatedAt         time.Time `db:"event_updated_at"`
 	*EventType
 	*EventSituation
 }
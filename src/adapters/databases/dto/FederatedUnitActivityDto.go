@@ -0,0 +1,7 @@
+package dto
+
+type FederatedUnitActivity struct {
+	FederatedUnit        string `db:"federated_unit_activity_federated_unit"`
+	NumberOfPropositions int    `db:"federated_unit_activity_number_of_propositions"`
+	NumberOfDeputies     int    `db:"federated_unit_activity_number_of_deputies"`
+}
@@ -0,0 +1,13 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Follow struct {
+	ResourceType string    `db:"user_follow_resource_type"`
+	ResourceId   uuid.UUID `db:"user_follow_resource_id"`
+	ResourceName string    `db:"user_follow_resource_name"`
+	CreatedAt    time.Time `db:"user_follow_created_at"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+type FrequentRapporteur struct {
+	NumberOfRapporteurships int `db:"number_of_rapporteurships"`
+	*Deputy
+}
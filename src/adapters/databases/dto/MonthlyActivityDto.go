@@ -0,0 +1,13 @@
+package dto
+
+import (
+	"time"
+)
+
+type MonthlyActivity struct {
+	Month                        time.Time `db:"monthly_activity_month"`
+	NumberOfPropositions         int       `db:"monthly_activity_number_of_propositions"`
+	NumberOfVotedPropositions    int       `db:"monthly_activity_number_of_voted_propositions"`
+	NumberOfApprovedPropositions int       `db:"monthly_activity_number_of_approved_propositions"`
+	NumberOfRejectedPropositions int       `db:"monthly_activity_number_of_rejected_propositions"`
+}
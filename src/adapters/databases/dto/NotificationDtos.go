@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Notification struct {
+	Id                   uuid.UUID `db:"notification_id"`
+	Type                 string    `db:"notification_type"`
+	PropositionArticleId uuid.UUID `db:"notification_proposition_article_id"`
+	PropositionTitle     string    `db:"notification_proposition_title"`
+	IsRead               bool      `db:"notification_is_read"`
+	ReadAt               time.Time `db:"notification_read_at"`
+	CreatedAt            time.Time `db:"notification_created_at"`
+	UserArticle
+}
+
+type NotificationPreference struct {
+	Type    string `db:"notification_preference_type"`
+	Enabled bool   `db:"notification_preference_enabled"`
+}
+
+type NumberOfNotifications struct {
+	Total  int `db:"total"`
+	Unread int `db:"unread"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+type PartyOrientation struct {
+	Orientation string `db:"party_orientation_orientation"`
+	*Party
+}
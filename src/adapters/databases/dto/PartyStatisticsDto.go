@@ -0,0 +1,9 @@
+package dto
+
+type PartyStatistics struct {
+	NumberOfDeputies             int `db:"number_of_deputies"`
+	NumberOfPropositions         int `db:"number_of_propositions"`
+	NumberOfVotedPropositions    int `db:"number_of_voted_propositions"`
+	NumberOfApprovedPropositions int `db:"number_of_approved_propositions"`
+	NumberOfRejectedPropositions int `db:"number_of_rejected_propositions"`
+}
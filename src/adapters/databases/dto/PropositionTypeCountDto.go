@@ -0,0 +1,6 @@
+package dto
+
+type PropositionTypeCount struct {
+	NumberOfPropositions int `db:"number_of_propositions"`
+	*PropositionType
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Rapporteurship struct {
+	Id                   uuid.UUID `db:"rapporteurship_id"`
+	Title                string    `db:"rapporteurship_title"`
+	Topic                string    `db:"rapporteurship_topic"`
+	Regime               string    `db:"rapporteurship_regime"`
+	Situation            string    `db:"rapporteurship_situation"`
+	PropositionArticleId uuid.UUID `db:"rapporteurship_proposition_article_id"`
+	PropositionTitle     string    `db:"rapporteurship_proposition_title"`
+	EventArticleId       uuid.UUID `db:"rapporteurship_event_article_id"`
+	EventTitle           string    `db:"rapporteurship_event_title"`
+	EventStartsAt        time.Time `db:"rapporteurship_event_starts_at"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"database/sql"
+	"github.com/google/uuid"
+	"time"
+)
+
+type ReadingList struct {
+	Id               uuid.UUID      `db:"reading_list_id"`
+	Name             string         `db:"reading_list_name"`
+	Description      string         `db:"reading_list_description"`
+	IsDefault        bool           `db:"reading_list_is_default"`
+	IsPublic         bool           `db:"reading_list_is_public"`
+	ShareToken       sql.NullString `db:"reading_list_share_token"`
+	NumberOfArticles int            `db:"reading_list_number_of_articles"`
+	CreatedAt        time.Time      `db:"reading_list_created_at"`
+	UpdatedAt        time.Time      `db:"reading_list_updated_at"`
+}
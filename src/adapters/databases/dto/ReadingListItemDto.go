@@ -0,0 +1,10 @@
+package dto
+
+import "time"
+
+type ReadingListItem struct {
+	Position  int       `db:"reading_list_item_position"`
+	Note      string    `db:"reading_list_item_note"`
+	CreatedAt time.Time `db:"reading_list_item_created_at"`
+	UserArticle
+}
@@ -0,0 +1,11 @@
+package dto
+
+import "github.com/google/uuid"
+
+type Recommendation struct {
+	Reason             string    `db:"recommendation_reason"`
+	ReasonArticleId    uuid.UUID `db:"recommendation_reason_article_id"`
+	ReasonArticleTitle string    `db:"recommendation_reason_article_title"`
+	Score              float64   `db:"recommendation_score"`
+	UserArticle
+}
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type SavedSearch struct {
+	Id                  uuid.UUID `db:"saved_search_id"`
+	Name                string    `db:"saved_search_name"`
+	Filter              []byte    `db:"saved_search_filter"`
+	NotifyByEmail       bool      `db:"saved_search_notify_by_email"`
+	NumberOfNewArticles int       `db:"saved_search_number_of_new_articles"`
+	LastOpenedAt        time.Time `db:"saved_search_last_opened_at"`
+	LastCheckedAt       time.Time `db:"saved_search_last_checked_at"`
+	CheckedAt           time.Time `db:"saved_search_checked_at"`
+	CreatedAt           time.Time `db:"saved_search_created_at"`
+	UpdatedAt           time.Time `db:"saved_search_updated_at"`
+	*User
+}
+
+type SavedSearchFilter struct {
+	TypeId                       *uuid.UUID `json:"type_id,omitempty"`
+	SpecificTypeId               *uuid.UUID `json:"specific_type_id,omitempty"`
+	Content                      string     `json:"content,omitempty"`
+	StartDate                    *time.Time `json:"start_date,omitempty"`
+	EndDate                      *time.Time `json:"end_date,omitempty"`
+	ExcludeRead                  bool       `json:"exclude_read,omitempty"`
+	OnlyFollowed                 bool       `json:"only_followed,omitempty"`
+	PropositionDeputyId          *uuid.UUID `json:"proposition_deputy_id,omitempty"`
+	PropositionPartyId           *uuid.UUID `json:"proposition_party_id,omitempty"`
+	PropositionExternalAuthorId  *uuid.UUID `json:"proposition_external_author_id,omitempty"`
+	PropositionFederatedUnits    []string   `json:"proposition_federated_units,omitempty"`
+	VotingStartDate              *time.Time `json:"voting_start_date,omitempty"`
+	VotingEndDate                *time.Time `json:"voting_end_date,omitempty"`
+	VotingResult                 string     `json:"voting_result,omitempty"`
+	VotingLegislativeBodyId      *uuid.UUID `json:"voting_legislative_body_id,omitempty"`
+	EventStartDate               *time.Time `json:"event_start_date,omitempty"`
+	EventEndDate                 *time.Time `json:"event_end_date,omitempty"`
+	EventSituationId             *uuid.UUID `json:"event_situation_id,omitempty"`
+	EventLegislativeBodyId       *uuid.UUID `json:"event_legislative_body_id,omitempty"`
+	EventRapporteurId            *uuid.UUID `json:"event_rapporteur_id,omitempty"`
+	EventRemoveEventsInTheFuture *bool      `json:"event_remove_events_in_the_future,omitempty"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+)
+
+type Suggestion struct {
+	Id          uuid.UUID `db:"suggestion_id"`
+	Type        string    `db:"suggestion_type"`
+	Label       string    `db:"suggestion_label"`
+	Description string    `db:"suggestion_description"`
+	Score       float64   `db:"suggestion_score"`
+}
+
+type SpellingCorrection struct {
+	Word       string  `db:"spelling_correction_word"`
+	Term       string  `db:"spelling_correction_term"`
+	Similarity float64 `db:"spelling_correction_similarity"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type TimelineItem struct {
+	Type                string    `db:"timeline_item_type"`
+	Date                time.Time `db:"timeline_item_date"`
+	Title               string    `db:"timeline_item_title"`
+	Description         string    `db:"timeline_item_description"`
+	ArticleId           uuid.UUID `db:"timeline_item_article_id"`
+	LegislativeBody     string    `db:"timeline_item_legislative_body"`
+	AgendaItemRegime    string    `db:"timeline_item_agenda_item_regime"`
+	AgendaItemSituation string    `db:"timeline_item_agenda_item_situation"`
+	EventSituation      string    `db:"timeline_item_event_situation"`
+	VotingResult        string    `db:"timeline_item_voting_result"`
+	VotingIsApproved    *bool     `db:"timeline_item_voting_is_approved"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"time"
+)
+
+type VotingOutcome struct {
+	Month                     time.Time `db:"voting_outcome_month"`
+	NumberOfApprovedVotes     int       `db:"voting_outcome_number_of_approved_votes"`
+	NumberOfRejectedVotes     int       `db:"voting_outcome_number_of_rejected_votes"`
+	NumberOfUndeterminedVotes int       `db:"voting_outcome_number_of_undetermined_votes"`
+	*LegislativeBody
+	*PropositionType
+}
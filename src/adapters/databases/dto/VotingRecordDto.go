@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+)
+
+type VotingRecord struct {
+	Id               uuid.UUID `db:"voting_record_id"`
+	Vote             string    `db:"voting_record_vote"`
+	PartyOrientation string    `db:"voting_record_party_orientation"`
+	VotingArticleId  uuid.UUID `db:"voting_record_voting_article_id"`
+	*Voting
+	*Party
+}
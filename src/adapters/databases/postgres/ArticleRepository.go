@@ -8,9 +8,13 @@ import (
 	"github.com/devlucassantos/vnc-domains/src/domains/articlesituation"
 	"github.com/devlucassantos/vnc-domains/src/domains/articletype"
 	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 	"github.com/labstack/gommon/log"
+	"github.com/lib/pq"
 	"vnc-api/adapters/databases/dto"
 	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/domains/articleoperation"
+	"vnc-api/core/domains/recommendation"
 	"vnc-api/core/filters"
 )
 
@@ -32,28 +36,33 @@ func (instance Article) GetArticles(filter filters.Article, userId uuid.UUID) ([
 	}
 	defer instance.connectionManager.closeConnection(postgresConnection)
 
+	readerIdToExclude := getReaderIdToExclude(filter, userId)
+	followerId := getFollowerId(filter, userId)
+
 	var articles []dto.Article
 	if !filter.Proposition.IsZero() {
 		err = postgresConnection.Select(&articles, queries.Article().Select().Propositions(),
 			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
 			filter.EndDate, filter.Proposition.DeputyId, filter.Proposition.PartyId,
-			filter.Proposition.ExternalAuthorId, filter.Pagination.CalculateOffset(),
-			filter.Pagination.GetItemsPerPage())
+			filter.Proposition.ExternalAuthorId, pq.Array(filter.Proposition.FederatedUnits), readerIdToExclude,
+			followerId, filter.CreatedAfter, filter.CreatedUntil, filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage())
 	} else if !filter.Voting.IsZero() {
 		err = postgresConnection.Select(&articles, queries.Article().Select().Votes(),
 			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
 			filter.EndDate, filter.Voting.StartDate, filter.Voting.EndDate, filter.Voting.Result,
-			filter.Voting.LegislativeBodyId, filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage())
+			filter.Voting.LegislativeBodyId, readerIdToExclude, followerId,
+			filter.CreatedAfter, filter.CreatedUntil, filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage())
 	} else if !filter.Event.IsZero() {
 		err = postgresConnection.Select(&articles, queries.Article().Select().Events(),
 			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
 			filter.EndDate, filter.Event.StartDate, filter.Event.EndDate, filter.Event.SituationId,
-			filter.Event.LegislativeBodyId, filter.Event.RapporteurId, filter.Pagination.CalculateOffset(),
-			filter.Pagination.GetItemsPerPage())
+			filter.Event.LegislativeBodyId, filter.Event.RapporteurId, readerIdToExclude, followerId,
+			filter.CreatedAfter, filter.CreatedUntil, filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage())
 	} else {
 		err = postgresConnection.Select(&articles, queries.Article().Select().All(),
 			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
-			filter.EndDate, filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage())
+			filter.EndDate, readerIdToExclude, followerId,
+			filter.CreatedAfter, filter.CreatedUntil, filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage())
 	}
 	if err != nil {
 		log.Error("Error retrieving data for articles from the database: ", err.Error())
@@ -210,21 +219,27 @@ func (instance Article) GetArticles(filter filters.Article, userId uuid.UUID) ([
 		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().TotalNumberOfPropositions(),
 			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
 			filter.EndDate, filter.Proposition.DeputyId, filter.Proposition.PartyId,
-			filter.Proposition.ExternalAuthorId)
+			filter.Proposition.ExternalAuthorId, pq.Array(filter.Proposition.FederatedUnits), readerIdToExclude,
+			followerId, filter.CreatedAfter,
+			filter.CreatedUntil)
 	} else if !filter.Voting.IsZero() {
 		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().TotalNumberOfVotes(),
 			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
 			filter.EndDate, filter.Voting.StartDate, filter.Voting.EndDate, filter.Voting.Result,
-			filter.Voting.LegislativeBodyId)
+			filter.Voting.LegislativeBodyId, readerIdToExclude, followerId, filter.CreatedAfter,
+			filter.CreatedUntil)
 	} else if !filter.Event.IsZero() {
 		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().TotalNumberOfEvents(),
 			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
 			filter.EndDate, filter.Event.StartDate, filter.Event.EndDate, filter.Event.SituationId,
-			filter.Event.LegislativeBodyId, filter.Event.RapporteurId)
+			filter.Event.LegislativeBodyId, filter.Event.RapporteurId, readerIdToExclude, followerId,
+			filter.CreatedAfter,
+			filter.CreatedUntil)
 	} else {
 		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().TotalNumberOfArticles(),
 			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
-			filter.EndDate)
+			filter.EndDate, readerIdToExclude, followerId, filter.CreatedAfter,
+			filter.CreatedUntil)
 	}
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		log.Error("Error retrieving the total number of articles from the database: ", err.Error())
@@ -234,7 +249,8 @@ func (instance Article) GetArticles(filter filters.Article, userId uuid.UUID) ([
 	return articleSlice, totalNumberOfArticles, nil
 }
 
-func (instance Article) GetTrendingArticles(filter filters.Article, userId uuid.UUID) ([]article.Article, int, error) {
+func (instance Article) GetTrendingArticles(filter filters.Article, trendingFilter filters.Trending, userId uuid.UUID) (
+	[]article.Article, int, error) {
 	postgresConnection, err := instance.connectionManager.createConnection()
 	if err != nil {
 		log.Error("Error creating a connection to the Postgres database: ", err.Error())
@@ -242,28 +258,39 @@ func (instance Article) GetTrendingArticles(filter filters.Article, userId uuid.
 	}
 	defer instance.connectionManager.closeConnection(postgresConnection)
 
+	readerIdToExclude := getReaderIdToExclude(filter, userId)
 	var trendingArticles []dto.Article
 	if !filter.Proposition.IsZero() {
-		err = postgresConnection.Select(&trendingArticles, queries.Article().Select().TrendingPropositions(),
-			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
-			filter.EndDate, filter.Proposition.DeputyId, filter.Proposition.PartyId,
-			filter.Proposition.ExternalAuthorId, filter.Pagination.CalculateOffset(),
-			filter.Pagination.GetItemsPerPage())
+		trendingArticleFilters := []interface{}{filter.TypeId, filter.SpecificTypeId,
+			fmt.Sprint("%", filter.Content, "%"), filter.StartDate, filter.EndDate, filter.Proposition.DeputyId,
+			filter.Proposition.PartyId, filter.Proposition.ExternalAuthorId, pq.Array(filter.Proposition.FederatedUnits),
+			readerIdToExclude, filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage()}
+		err = postgresConnection.Select(&trendingArticles,
+			queries.Article().Select().TrendingPropositions(trendingFilter.UsePrecomputedScores),
+			append(trendingArticleFilters, getTrendingScoreArguments(trendingFilter)...)...)
 	} else if !filter.Voting.IsZero() {
-		err = postgresConnection.Select(&trendingArticles, queries.Article().Select().TrendingVotes(),
-			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
-			filter.EndDate, filter.Voting.StartDate, filter.Voting.EndDate, filter.Voting.Result,
-			filter.Voting.LegislativeBodyId, filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage())
+		trendingArticleFilters := []interface{}{filter.TypeId, filter.SpecificTypeId,
+			fmt.Sprint("%", filter.Content, "%"), filter.StartDate, filter.EndDate, filter.Voting.StartDate,
+			filter.Voting.EndDate, filter.Voting.Result, filter.Voting.LegislativeBodyId, readerIdToExclude,
+			filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage()}
+		err = postgresConnection.Select(&trendingArticles,
+			queries.Article().Select().TrendingVotes(trendingFilter.UsePrecomputedScores),
+			append(trendingArticleFilters, getTrendingScoreArguments(trendingFilter)...)...)
 	} else if !filter.Event.IsZero() {
-		err = postgresConnection.Select(&trendingArticles, queries.Article().Select().TrendingEvents(),
-			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
-			filter.EndDate, filter.Event.StartDate, filter.Event.EndDate, filter.Event.SituationId,
-			filter.Event.LegislativeBodyId, filter.Event.RapporteurId, filter.Pagination.CalculateOffset(),
-			filter.Pagination.GetItemsPerPage())
+		trendingArticleFilters := []interface{}{filter.TypeId, filter.SpecificTypeId,
+			fmt.Sprint("%", filter.Content, "%"), filter.StartDate, filter.EndDate, filter.Event.StartDate,
+			filter.Event.EndDate, filter.Event.SituationId, filter.Event.LegislativeBodyId, filter.Event.RapporteurId,
+			readerIdToExclude, filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage()}
+		err = postgresConnection.Select(&trendingArticles,
+			queries.Article().Select().TrendingEvents(trendingFilter.UsePrecomputedScores),
+			append(trendingArticleFilters, getTrendingScoreArguments(trendingFilter)...)...)
 	} else {
-		err = postgresConnection.Select(&trendingArticles, queries.Article().Select().TrendingArticles(),
-			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
-			filter.EndDate, filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage())
+		trendingArticleFilters := []interface{}{filter.TypeId, filter.SpecificTypeId,
+			fmt.Sprint("%", filter.Content, "%"), filter.StartDate, filter.EndDate, readerIdToExclude,
+			filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage()}
+		err = postgresConnection.Select(&trendingArticles,
+			queries.Article().Select().TrendingArticles(trendingFilter.UsePrecomputedScores),
+			append(trendingArticleFilters, getTrendingScoreArguments(trendingFilter)...)...)
 	}
 	if err != nil {
 		log.Error("Error searching for trending articles in the database: ", err.Error())
@@ -419,21 +446,30 @@ func (instance Article) GetTrendingArticles(filter filters.Article, userId uuid.
 	if !filter.Proposition.IsZero() {
 		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().TotalNumberOfPropositions(),
 			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
-			filter.EndDate, filter.Proposition.DeputyId, filter.Proposition.PartyId, filter.Proposition.ExternalAuthorId)
+			filter.EndDate, filter.Proposition.DeputyId, filter.Proposition.PartyId, filter.Proposition.ExternalAuthorId,
+			pq.Array(filter.Proposition.FederatedUnits), readerIdToExclude,
+			getFollowerId(filter, userId), filter.CreatedAfter,
+			filter.CreatedUntil)
 	} else if !filter.Voting.IsZero() {
 		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().TotalNumberOfVotes(),
 			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
 			filter.EndDate, filter.Voting.StartDate, filter.Voting.EndDate, filter.Voting.Result,
-			filter.Voting.LegislativeBodyId)
+			filter.Voting.LegislativeBodyId, readerIdToExclude,
+			getFollowerId(filter, userId), filter.CreatedAfter,
+			filter.CreatedUntil)
 	} else if !filter.Event.IsZero() {
 		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().TotalNumberOfEvents(),
 			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
 			filter.EndDate, filter.Event.StartDate, filter.Event.EndDate, filter.Event.SituationId,
-			filter.Event.LegislativeBodyId, filter.Event.RapporteurId)
+			filter.Event.LegislativeBodyId, filter.Event.RapporteurId, readerIdToExclude,
+			getFollowerId(filter, userId), filter.CreatedAfter,
+			filter.CreatedUntil)
 	} else {
 		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().TotalNumberOfArticles(),
 			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
-			filter.EndDate)
+			filter.EndDate, readerIdToExclude, getFollowerId(filter, userId),
+			filter.CreatedAfter,
+			filter.CreatedUntil)
 	}
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		log.Error("Error retrieving the total number of articles from the database: ", err.Error())
@@ -443,8 +479,8 @@ func (instance Article) GetTrendingArticles(filter filters.Article, userId uuid.
 	return articles, totalNumberOfArticles, nil
 }
 
-func (instance Article) GetTrendingArticlesByTypeId(articleTypeId uuid.UUID, itemsPerType int, userId uuid.UUID) (
-	[]article.Article, error) {
+func (instance Article) GetTrendingArticlesByTypeId(articleTypeId uuid.UUID, itemsPerType int,
+	trendingFilter filters.Trending, userId uuid.UUID) ([]article.Article, error) {
 	postgresConnection, err := instance.connectionManager.createConnection()
 	if err != nil {
 		log.Error("Error creating a connection to the Postgres database: ", err.Error())
@@ -453,8 +489,10 @@ func (instance Article) GetTrendingArticlesByTypeId(articleTypeId uuid.UUID, ite
 	defer instance.connectionManager.closeConnection(postgresConnection)
 
 	var trendingArticles []dto.Article
-	err = postgresConnection.Select(&trendingArticles, queries.Article().Select().TrendingArticlesByTypeId(),
-		articleTypeId, itemsPerType)
+	trendingArticleFilters := []interface{}{articleTypeId, itemsPerType}
+	err = postgresConnection.Select(&trendingArticles,
+		queries.Article().Select().TrendingArticlesByTypeId(trendingFilter.UsePrecomputedScores),
+		append(trendingArticleFilters, getTrendingScoreArguments(trendingFilter)...)...)
 	if err != nil {
 		log.Errorf("Error searching for trending articles of article type %s in the database: %s", articleTypeId,
 			err.Error())
@@ -610,7 +648,7 @@ func (instance Article) GetTrendingArticlesByTypeId(articleTypeId uuid.UUID, ite
 }
 
 func (instance Article) GetTrendingArticlesBySpecificTypeId(articleSpecificTypeId uuid.UUID, itemsPerType int,
-	userId uuid.UUID) ([]article.Article, error) {
+	trendingFilter filters.Trending, userId uuid.UUID) ([]article.Article, error) {
 	postgresConnection, err := instance.connectionManager.createConnection()
 	if err != nil {
 		log.Error("Error creating a connection to the Postgres database: ", err.Error())
@@ -619,8 +657,10 @@ func (instance Article) GetTrendingArticlesBySpecificTypeId(articleSpecificTypeI
 	defer instance.connectionManager.closeConnection(postgresConnection)
 
 	var trendingArticles []dto.Article
-	err = postgresConnection.Select(&trendingArticles, queries.Article().Select().TrendingArticlesBySpecificTypeId(),
-		articleSpecificTypeId, itemsPerType)
+	trendingArticleFilters := []interface{}{articleSpecificTypeId, itemsPerType}
+	err = postgresConnection.Select(&trendingArticles,
+		queries.Article().Select().TrendingArticlesBySpecificTypeId(trendingFilter.UsePrecomputedScores),
+		append(trendingArticleFilters, getTrendingScoreArguments(trendingFilter)...)...)
 	if err != nil {
 		log.Errorf("Error searching for trending articles of article specific type %s in the database: %s",
 			articleSpecificTypeId, err.Error())
@@ -765,8 +805,8 @@ func (instance Article) GetArticlesToViewLater(filter filters.Article, userId uu
 		err = postgresConnection.Select(&userArticles, queries.Article().Select().PropositionsBookmarkedToViewLater(),
 			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
 			filter.EndDate, filter.Proposition.DeputyId, filter.Proposition.PartyId,
-			filter.Proposition.ExternalAuthorId, userId, filter.Pagination.CalculateOffset(),
-			filter.Pagination.GetItemsPerPage())
+			filter.Proposition.ExternalAuthorId, pq.Array(filter.Proposition.FederatedUnits), userId,
+			filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage())
 	} else if !filter.Voting.IsZero() {
 		err = postgresConnection.Select(&userArticles, queries.Article().Select().VotesBookmarkedToViewLater(),
 			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
@@ -790,6 +830,487 @@ func (instance Article) GetArticlesToViewLater(filter filters.Article, userId uu
 		return nil, 0, err
 	}
 
+	articleSlice, err := getArticlesFromUserArticles(postgresConnection, userArticles)
+	if err != nil {
+		log.Errorf("Error retrieving data for articles bookmarked for later viewing by user %s: %s", userId,
+			err.Error())
+		return nil, 0, err
+	}
+
+	var totalNumberOfArticles int
+	if !filter.Proposition.IsZero() {
+		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().
+			NumberOfPropositionsBookmarkedToViewLater(), filter.TypeId, filter.SpecificTypeId,
+			fmt.Sprint("%", filter.Content, "%"), filter.StartDate, filter.EndDate, filter.Proposition.DeputyId,
+			filter.Proposition.PartyId, filter.Proposition.ExternalAuthorId, pq.Array(filter.Proposition.FederatedUnits),
+			userId)
+	} else if !filter.Voting.IsZero() {
+		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().
+			NumberOfVotesBookmarkedToViewLater(), filter.TypeId, filter.SpecificTypeId,
+			fmt.Sprint("%", filter.Content, "%"), filter.StartDate, filter.EndDate, filter.Voting.StartDate,
+			filter.Voting.EndDate, filter.Voting.Result, filter.Voting.LegislativeBodyId, userId)
+	} else if !filter.Event.IsZero() {
+		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().
+			NumberOfEventsBookmarkedToViewLater(), filter.TypeId, filter.SpecificTypeId,
+			fmt.Sprint("%", filter.Content, "%"), filter.StartDate, filter.EndDate, filter.Event.StartDate,
+			filter.Event.EndDate, filter.Event.SituationId, filter.Event.LegislativeBodyId, filter.Event.RapporteurId,
+			userId)
+	} else {
+		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().
+			NumberOfArticlesBookmarkedToViewLater(), filter.TypeId, filter.SpecificTypeId,
+			fmt.Sprint("%", filter.Content, "%"), filter.StartDate, filter.EndDate, userId)
+	}
+	if err != nil {
+		log.Errorf("Error retrieving the total number of articles bookmarked for later viewing by user %s from "+
+			"the database: %s", userId, err.Error())
+		return nil, 0, err
+	}
+
+	return articleSlice, totalNumberOfArticles, nil
+}
+
+func (instance Article) GetArticlesFromHistory(filter filters.Article, userId uuid.UUID) ([]article.Article, int,
+	error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, 0, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var userArticles []dto.UserArticle
+	if !filter.Proposition.IsZero() {
+		err = postgresConnection.Select(&userArticles, queries.Article().Select().PropositionsInHistory(),
+			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
+			filter.EndDate, filter.Proposition.DeputyId, filter.Proposition.PartyId,
+			filter.Proposition.ExternalAuthorId, pq.Array(filter.Proposition.FederatedUnits), userId,
+			filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage())
+	} else if !filter.Voting.IsZero() {
+		err = postgresConnection.Select(&userArticles, queries.Article().Select().VotesInHistory(),
+			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
+			filter.EndDate, filter.Voting.StartDate, filter.Voting.EndDate, filter.Voting.Result,
+			filter.Voting.LegislativeBodyId, userId, filter.Pagination.CalculateOffset(),
+			filter.Pagination.GetItemsPerPage())
+	} else if !filter.Event.IsZero() {
+		err = postgresConnection.Select(&userArticles, queries.Article().Select().EventsInHistory(),
+			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
+			filter.EndDate, filter.Event.StartDate, filter.Event.EndDate, filter.Event.SituationId,
+			filter.Event.LegislativeBodyId, filter.Event.RapporteurId, userId, filter.Pagination.CalculateOffset(),
+			filter.Pagination.GetItemsPerPage())
+	} else {
+		err = postgresConnection.Select(&userArticles, queries.Article().Select().ArticlesInHistory(),
+			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
+			filter.EndDate, userId, filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage())
+	}
+	if err != nil {
+		log.Errorf("Error searching for articles in the reading history of user %s in the database: %s",
+			userId, err.Error())
+		return nil, 0, err
+	}
+
+	articleSlice, err := getArticlesFromUserArticles(postgresConnection, userArticles)
+	if err != nil {
+		log.Errorf("Error retrieving data for articles in the reading history of user %s: %s", userId, err.Error())
+		return nil, 0, err
+	}
+
+	var totalNumberOfArticles int
+	if !filter.Proposition.IsZero() {
+		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().NumberOfPropositionsInHistory(),
+			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
+			filter.EndDate, filter.Proposition.DeputyId, filter.Proposition.PartyId,
+			filter.Proposition.ExternalAuthorId, pq.Array(filter.Proposition.FederatedUnits), userId)
+	} else if !filter.Voting.IsZero() {
+		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().NumberOfVotesInHistory(),
+			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
+			filter.EndDate, filter.Voting.StartDate, filter.Voting.EndDate, filter.Voting.Result,
+			filter.Voting.LegislativeBodyId, userId)
+	} else if !filter.Event.IsZero() {
+		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().NumberOfEventsInHistory(),
+			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
+			filter.EndDate, filter.Event.StartDate, filter.Event.EndDate, filter.Event.SituationId,
+			filter.Event.LegislativeBodyId, filter.Event.RapporteurId, userId)
+	} else {
+		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().NumberOfArticlesInHistory(),
+			filter.TypeId, filter.SpecificTypeId, fmt.Sprint("%", filter.Content, "%"), filter.StartDate,
+			filter.EndDate, userId)
+	}
+	if err != nil {
+		log.Errorf("Error retrieving the total number of articles in the reading history of user %s from the "+
+			"database: %s", userId, err.Error())
+		return nil, 0, err
+	}
+
+	return articleSlice, totalNumberOfArticles, nil
+}
+
+func (instance Article) GetRecommendedArticles(recommendationFilter filters.Recommendation, userId uuid.UUID) (
+	[]recommendation.Recommendation, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var recommendationDtos []dto.Recommendation
+	err = postgresConnection.Select(&recommendationDtos, queries.Recommendation().Select().ByUserId(), userId,
+		recommendationFilter.GetSeedWindowInterval(), recommendationFilter.MaximumNumberOfSeeds,
+		recommendationFilter.GetCandidateWindowInterval(), recommendationFilter.SimilarReadersWeight,
+		recommendationFilter.SimilarContentWeight, recommendationFilter.GetNumberOfArticles(),
+		recommendationFilter.MaximumNumberOfViewsPerReader)
+	if err != nil {
+		log.Errorf("Error searching for recommended articles for user %s in the database: %s", userId, err.Error())
+		return nil, err
+	}
+
+	var userArticles []dto.UserArticle
+	for _, recommendationData := range recommendationDtos {
+		userArticles = append(userArticles, recommendationData.UserArticle)
+	}
+
+	articles, err := getArticlesFromUserArticles(postgresConnection, userArticles)
+	if err != nil {
+		log.Errorf("Error retrieving data for the recommended articles for user %s: %s", userId, err.Error())
+		return nil, err
+	}
+
+	var recommendations []recommendation.Recommendation
+	for index, recommendationData := range recommendationDtos {
+		recommendationDomain, err := recommendation.NewBuilder().
+			Article(articles[index]).
+			Reason(recommendationData.Reason).
+			ReasonArticleId(recommendationData.ReasonArticleId).
+			ReasonArticleTitle(recommendationData.ReasonArticleTitle).
+			Score(recommendationData.Score).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for the recommendation of article %s for user %s: %s",
+				recommendationData.UserArticle.Article.Id, userId, err.Error())
+			return nil, err
+		}
+
+		recommendations = append(recommendations, *recommendationDomain)
+	}
+
+	return recommendations, nil
+}
+
+func (instance Article) DeleteArticleFromHistory(userId uuid.UUID, articleId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	sqlResult, err := postgresConnection.Exec(queries.ArticleView().Update().AnonymizeByUserIdAndArticleId(), userId,
+		articleId)
+	if err != nil {
+		log.Errorf("Error removing article %s from the reading history of user %s: %s", articleId, userId,
+			err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by the removal of article %s from the reading "+
+			"history of user %s: %s", articleId, userId, err.Error())
+		return err
+	} else if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (instance Article) ClearHistory(userId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	_, err = postgresConnection.Exec(queries.ArticleView().Update().AnonymizeByUserId(), userId)
+	if err != nil {
+		log.Errorf("Error clearing the reading history of user %s: %s", userId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (instance Article) SaveArticleRating(userId uuid.UUID, articleId uuid.UUID, rating *int) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	sqlResult, err := postgresConnection.Exec(queries.UserArticle().Update().Rating(), rating, userId, articleId)
+	if err != nil {
+		log.Errorf("Error updating the rating for article %s with user %s: %s", articleId, userId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err == nil && rowsAffected == 0 {
+		_, err = postgresConnection.Exec(queries.UserArticle().Insert().Rating(), userId, articleId, rating)
+		if err != nil {
+			log.Errorf("Error inserting the rating for article %s with user %s: %s",
+				articleId, userId, err.Error())
+			return err
+		}
+	} else if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by the rating update for article %s with "+
+			"user %s: %s", articleId, userId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (instance Article) SaveArticleToViewLater(userId uuid.UUID, articleId uuid.UUID, viewLater bool) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	transaction, err := postgresConnection.Beginx()
+	if err != nil {
+		log.Errorf("Error starting transaction to update view later bookmark for article %s with user %s: %s",
+			articleId, userId, err.Error())
+		return err
+	}
+	defer instance.connectionManager.rollbackTransaction(transaction)
+
+	defaultReadingListId, err := getDefaultReadingListId(transaction, userId)
+	if err != nil {
+		return err
+	}
+
+	err = saveArticleToViewLater(transaction, userId, articleId, viewLater)
+	if err != nil {
+		return err
+	}
+
+	if viewLater {
+		err = saveArticleToReadingList(transaction, defaultReadingListId, articleId, nil)
+	} else {
+		_, err = transaction.Exec(queries.ReadingListItem().Delete().ReadingListItem(), defaultReadingListId,
+			articleId)
+		if err != nil {
+			log.Errorf("Error removing article %s from the default reading list %s: %s", articleId,
+				defaultReadingListId, err.Error())
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	err = transaction.Commit()
+	if err != nil {
+		log.Errorf("Error confirming transaction to update view later bookmark for article %s with user %s: %s",
+			articleId, userId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (instance Article) SaveArticleRatings(userId uuid.UUID, articleOperations []articleoperation.ArticleOperation) (
+	[]articleoperation.ArticleOperation, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	transaction, err := postgresConnection.Beginx()
+	if err != nil {
+		log.Errorf("Error starting transaction to rate articles with user %s: %s", userId, err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.rollbackTransaction(transaction)
+
+	var articleIds []string
+	var ratings []sql.NullInt64
+	for _, articleOperation := range articleOperations {
+		articleIds = append(articleIds, articleOperation.ArticleId().String())
+
+		var rating sql.NullInt64
+		if articleOperation.Rating() != nil {
+			rating = sql.NullInt64{Int64: int64(*articleOperation.Rating()), Valid: true}
+		}
+		ratings = append(ratings, rating)
+	}
+
+	var ratedArticleIds []uuid.UUID
+	err = transaction.Select(&ratedArticleIds, queries.UserArticle().Insert().Ratings(), userId,
+		pq.Array(articleIds), pq.Array(ratings))
+	if err != nil {
+		log.Errorf("Error rating articles with user %s: %s", userId, err.Error())
+		return nil, err
+	}
+
+	articleOperationSlice, err := getArticleOperationsWithStatus(transaction, articleOperations, ratedArticleIds)
+	if err != nil {
+		return nil, err
+	}
+
+	err = transaction.Commit()
+	if err != nil {
+		log.Errorf("Error confirming transaction to rate articles with user %s: %s", userId, err.Error())
+		return nil, err
+	}
+
+	return articleOperationSlice, nil
+}
+
+func (instance Article) SaveArticlesToViewLater(userId uuid.UUID,
+	articleOperations []articleoperation.ArticleOperation) ([]articleoperation.ArticleOperation, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	transaction, err := postgresConnection.Beginx()
+	if err != nil {
+		log.Errorf("Error starting transaction to update view later bookmarks with user %s: %s", userId,
+			err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.rollbackTransaction(transaction)
+
+	defaultReadingListId, err := getDefaultReadingListId(transaction, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var articleIds []string
+	var viewLaterBookmarks []bool
+	for _, articleOperation := range articleOperations {
+		articleIds = append(articleIds, articleOperation.ArticleId().String())
+		viewLaterBookmarks = append(viewLaterBookmarks, articleOperation.ViewLater())
+	}
+
+	var bookmarkedArticleIds []uuid.UUID
+	err = transaction.Select(&bookmarkedArticleIds, queries.UserArticle().Insert().ViewLaterBookmarks(), userId,
+		pq.Array(articleIds), pq.Array(viewLaterBookmarks))
+	if err != nil {
+		log.Errorf("Error updating view later bookmarks with user %s: %s", userId, err.Error())
+		return nil, err
+	}
+
+	articleOperationSlice, err := getArticleOperationsWithStatus(transaction, articleOperations,
+		bookmarkedArticleIds)
+	if err != nil {
+		return nil, err
+	}
+
+	var addedArticleIds, removedArticleIds []string
+	for _, articleOperation := range articleOperationSlice {
+		if articleOperation.Status() != articleoperation.SucceededStatus {
+			continue
+		} else if articleOperation.ViewLater() {
+			addedArticleIds = append(addedArticleIds, articleOperation.ArticleId().String())
+		} else {
+			removedArticleIds = append(removedArticleIds, articleOperation.ArticleId().String())
+		}
+	}
+
+	if len(addedArticleIds) > 0 {
+		err = lockReadingList(transaction, defaultReadingListId)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = transaction.Exec(queries.ReadingListItem().Insert().ReadingListItems(), defaultReadingListId,
+			pq.Array(addedArticleIds))
+		if err != nil {
+			log.Errorf("Error adding articles to the default reading list %s: %s", defaultReadingListId,
+				err.Error())
+			return nil, err
+		}
+	}
+
+	if len(removedArticleIds) > 0 {
+		_, err = transaction.Exec(queries.ReadingListItem().Delete().ReadingListItems(), defaultReadingListId,
+			pq.Array(removedArticleIds))
+		if err != nil {
+			log.Errorf("Error removing articles from the default reading list %s: %s", defaultReadingListId,
+				err.Error())
+			return nil, err
+		}
+	}
+
+	err = transaction.Commit()
+	if err != nil {
+		log.Errorf("Error confirming transaction to update view later bookmarks with user %s: %s", userId,
+			err.Error())
+		return nil, err
+	}
+
+	return articleOperationSlice, nil
+}
+
+func getArticleOperationsWithStatus(transaction *sqlx.Tx, articleOperations []articleoperation.ArticleOperation,
+	updatedArticleIds []uuid.UUID) ([]articleoperation.ArticleOperation, error) {
+	var articleIds []string
+	for _, articleOperation := range articleOperations {
+		articleIds = append(articleIds, articleOperation.ArticleId().String())
+	}
+
+	var existingArticleIds []uuid.UUID
+	err := transaction.Select(&existingArticleIds, queries.Article().Select().ExistingIds(), pq.Array(articleIds))
+	if err != nil {
+		log.Error("Error retrieving the IDs of the existing articles from the database: ", err.Error())
+		return nil, err
+	}
+
+	existingArticles := make(map[uuid.UUID]bool)
+	for _, articleId := range existingArticleIds {
+		existingArticles[articleId] = true
+	}
+
+	updatedArticles := make(map[uuid.UUID]bool)
+	for _, articleId := range updatedArticleIds {
+		updatedArticles[articleId] = true
+	}
+
+	var articleOperationSlice []articleoperation.ArticleOperation
+	for _, articleOperation := range articleOperations {
+		status := articleoperation.NotFoundStatus
+		if updatedArticles[articleOperation.ArticleId()] {
+			status = articleoperation.SucceededStatus
+		} else if existingArticles[articleOperation.ArticleId()] {
+			status = articleoperation.ForbiddenStatus
+		}
+
+		articleOperationData, err := articleOperation.NewUpdater().
+			Status(status).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating the result of the operation on article %s: %s",
+				articleOperation.ArticleId(), err.Error())
+			return nil, err
+		}
+
+		articleOperationSlice = append(articleOperationSlice, *articleOperationData)
+	}
+
+	return articleOperationSlice, nil
+}
+
+func getArticlesFromUserArticles(postgresConnection *sqlx.DB, userArticles []dto.UserArticle) ([]article.Article,
+	error) {
 	articles := make(map[uuid.UUID]dto.Article)
 	if userArticles != nil {
 		var articleIds []interface{}
@@ -798,11 +1319,10 @@ func (instance Article) GetArticlesToViewLater(filter filters.Article, userId uu
 		}
 
 		var articleDtos []dto.Article
-		err = postgresConnection.Select(&articleDtos, queries.Article().Select().In(len(userArticles)), articleIds...)
+		err := postgresConnection.Select(&articleDtos, queries.Article().Select().In(len(userArticles)), articleIds...)
 		if err != nil {
-			log.Errorf("Error retrieving data for articles bookmarked for later viewing by user %s from the "+
-				"database: %s", userId, err.Error())
-			return nil, 0, err
+			log.Error("Error retrieving data for articles from the database: ", err.Error())
+			return nil, err
 		}
 
 		for _, articleDto := range articleDtos {
@@ -823,7 +1343,7 @@ func (instance Article) GetArticlesToViewLater(filter filters.Article, userId uu
 		if err != nil {
 			log.Errorf("Error validating data for article type %s of article %s: %s", articleData.ArticleType.Id,
 				articleData.Id, err.Error())
-			return nil, 0, err
+			return nil, err
 		}
 
 		articleBuilder := article.NewBuilder().
@@ -847,7 +1367,7 @@ func (instance Article) GetArticlesToViewLater(filter filters.Article, userId uu
 			if err != nil {
 				log.Errorf("Error validating data for proposition type %s of proposition %s of article %s: %s",
 					articleData.Proposition.PropositionType.Id, articleData.Proposition.Id, articleData.Id, err.Error())
-				return nil, 0, err
+				return nil, err
 			}
 
 			if articleData.Proposition.ImageUrl != "" {
@@ -860,7 +1380,7 @@ func (instance Article) GetArticlesToViewLater(filter filters.Article, userId uu
 				Content(articleData.Proposition.Content).
 				SpecificType(*articleSpecificType).
 				Build()
-		} else if articleData.Voting.Id != uuid.Nil {
+		} else if articleData.Voting != nil && articleData.Voting.Id != uuid.Nil {
 			articleSituation, err := articlesituation.NewBuilder().
 				Result(articleData.Voting.Result).
 				ResultAnnouncedAt(articleData.Voting.ResultAnnouncedAt).
@@ -869,7 +1389,7 @@ func (instance Article) GetArticlesToViewLater(filter filters.Article, userId uu
 			if err != nil {
 				log.Errorf("Error validating data for article/voting situation of voting %s of article %s: %s",
 					articleData.Voting.Id, articleData.Id, err.Error())
-				return nil, 0, err
+				return nil, err
 			}
 
 			articleDomain, articleErr = articleBuilder.
@@ -897,7 +1417,7 @@ func (instance Article) GetArticlesToViewLater(filter filters.Article, userId uu
 			if err != nil {
 				log.Errorf("Error validating data for article/event situation %s of event %s of article %s: %s",
 					articleData.Event.EventSituation.Id, articleData.Event.Id, articleData.Id, err.Error())
-				return nil, 0, err
+				return nil, err
 			}
 
 			articleSpecificType, err := articletype.NewBuilder().
@@ -908,7 +1428,7 @@ func (instance Article) GetArticlesToViewLater(filter filters.Article, userId uu
 			if err != nil {
 				log.Errorf("Error validating data for event type %s of event %s of article %s: %s",
 					articleData.Event.EventType.Id, articleData.Event.Id, articleData.Id, err.Error())
-				return nil, 0, err
+				return nil, err
 			}
 
 			articleDomain, articleErr = articleBuilder.
@@ -926,102 +1446,36 @@ func (instance Article) GetArticlesToViewLater(filter filters.Article, userId uu
 		}
 		if articleErr != nil {
 			log.Errorf("Error validating data for article %s: %s", articleData.Id, articleErr.Error())
-			return nil, 0, articleErr
+			return nil, articleErr
 		}
 
 		articleSlice = append(articleSlice, *articleDomain)
 	}
 
-	var totalNumberOfArticles int
-	if !filter.Proposition.IsZero() {
-		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().
-			NumberOfPropositionsBookmarkedToViewLater(), filter.TypeId, filter.SpecificTypeId,
-			fmt.Sprint("%", filter.Content, "%"), filter.StartDate, filter.EndDate, filter.Proposition.DeputyId,
-			filter.Proposition.PartyId, filter.Proposition.ExternalAuthorId, userId)
-	} else if !filter.Voting.IsZero() {
-		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().
-			NumberOfVotesBookmarkedToViewLater(), filter.TypeId, filter.SpecificTypeId,
-			fmt.Sprint("%", filter.Content, "%"), filter.StartDate, filter.EndDate, filter.Voting.StartDate,
-			filter.Voting.EndDate, filter.Voting.Result, filter.Voting.LegislativeBodyId, userId)
-	} else if !filter.Event.IsZero() {
-		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().
-			NumberOfEventsBookmarkedToViewLater(), filter.TypeId, filter.SpecificTypeId,
-			fmt.Sprint("%", filter.Content, "%"), filter.StartDate, filter.EndDate, filter.Event.StartDate,
-			filter.Event.EndDate, filter.Event.SituationId, filter.Event.LegislativeBodyId, filter.Event.RapporteurId,
-			userId)
-	} else {
-		err = postgresConnection.Get(&totalNumberOfArticles, queries.Article().Select().
-			NumberOfArticlesBookmarkedToViewLater(), filter.TypeId, filter.SpecificTypeId,
-			fmt.Sprint("%", filter.Content, "%"), filter.StartDate, filter.EndDate, userId)
-	}
-	if err != nil {
-		log.Errorf("Error retrieving the total number of articles bookmarked for later viewing by user %s from "+
-			"the database: %s", userId, err.Error())
-		return nil, 0, err
-	}
-
-	return articleSlice, totalNumberOfArticles, nil
+	return articleSlice, nil
 }
 
-func (instance Article) SaveArticleRating(userId uuid.UUID, articleId uuid.UUID, rating *int) error {
-	postgresConnection, err := instance.connectionManager.createConnection()
-	if err != nil {
-		log.Error("Error creating a connection to the Postgres database: ", err.Error())
-		return err
+func getFollowerId(filter filters.Article, userId uuid.UUID) *uuid.UUID {
+	if !filter.OnlyFollowed || userId == uuid.Nil {
+		return nil
 	}
-	defer instance.connectionManager.closeConnection(postgresConnection)
 
-	sqlResult, err := postgresConnection.Exec(queries.UserArticle().Update().Rating(), rating, userId, articleId)
-	if err != nil {
-		log.Errorf("Error updating the rating for article %s with user %s: %s", articleId, userId, err.Error())
-		return err
-	}
-
-	rowsAffected, err := sqlResult.RowsAffected()
-	if err == nil && rowsAffected == 0 {
-		_, err = postgresConnection.Exec(queries.UserArticle().Insert().Rating(), userId, articleId, rating)
-		if err != nil {
-			log.Errorf("Error inserting the rating for article %s with user %s: %s",
-				articleId, userId, err.Error())
-			return err
-		}
-	} else if err != nil {
-		log.Errorf("Error retrieving the number of rows affected by the rating update for article %s with "+
-			"user %s: %s", articleId, userId, err.Error())
-		return err
-	}
-
-	return nil
+	return &userId
 }
 
-func (instance Article) SaveArticleToViewLater(userId uuid.UUID, articleId uuid.UUID, viewLater bool) error {
-	postgresConnection, err := instance.connectionManager.createConnection()
-	if err != nil {
-		log.Error("Error creating a connection to the Postgres database: ", err.Error())
-		return err
+func getReaderIdToExclude(filter filters.Article, userId uuid.UUID) *uuid.UUID {
+	if !filter.ExcludeRead || userId == uuid.Nil {
+		return nil
 	}
-	defer instance.connectionManager.closeConnection(postgresConnection)
 
-	sqlResult, err := postgresConnection.Exec(queries.UserArticle().Update().ViewLater(), viewLater, userId, articleId)
-	if err != nil {
-		log.Errorf("Error updating view later bookmark for article %s with user %s: %s",
-			articleId, userId, err.Error())
-		return err
-	}
+	return &userId
+}
 
-	rowsAffected, err := sqlResult.RowsAffected()
-	if err == nil && rowsAffected == 0 {
-		_, err = postgresConnection.Exec(queries.UserArticle().Insert().ViewLater(), userId, articleId, viewLater)
-		if err != nil {
-			log.Errorf("Error inserting view later bookmark for article %s with user %s: %s",
-				articleId, userId, err.Error())
-			return err
-		}
-	} else if err != nil {
-		log.Errorf("Error retrieving the number of rows affected by the view later bookmark update for article"+
-			" %s with user %s: %s", articleId, userId, err.Error())
-		return err
+func getTrendingScoreArguments(trendingFilter filters.Trending) []interface{} {
+	if trendingFilter.UsePrecomputedScores {
+		return []interface{}{trendingFilter.GetWindow()}
 	}
 
-	return nil
+	return []interface{}{trendingFilter.GetWindowInterval(), trendingFilter.ViewsWeight,
+		trendingFilter.AverageRatingWeight, trendingFilter.NumberOfRatingsWeight}
 }
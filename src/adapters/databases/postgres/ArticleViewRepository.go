@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"github.com/lib/pq"
+	"time"
+	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/domains/articleview"
+)
+
+type ArticleView struct {
+	connectionManager connectionManagerInterface
+}
+
+func NewArticleViewRepository(connectionManager connectionManagerInterface) *ArticleView {
+	return &ArticleView{
+		connectionManager: connectionManager,
+	}
+}
+
+func (instance ArticleView) CheckArticleAvailability(articleId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var existingArticleId uuid.UUID
+	err = postgresConnection.Get(&existingArticleId, queries.ArticleView().Select().Article(), articleId)
+	if err != nil {
+		log.Errorf("Error checking whether article %s can be viewed: %s", articleId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (instance ArticleView) SaveArticleViews(articleViews []articleview.ArticleView) error {
+	if len(articleViews) == 0 {
+		return nil
+	}
+
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var articleIds []string
+	var userIds []sql.NullString
+	var viewDates []string
+	for _, articleView := range articleViews {
+		articleIds = append(articleIds, articleView.ArticleId().String())
+		userIds = append(userIds, sql.NullString{
+			String: articleView.UserId().String(),
+			Valid:  articleView.UserId() != uuid.Nil,
+		})
+		viewDates = append(viewDates, articleView.CreatedAt().Format(time.RFC3339Nano))
+	}
+
+	_, err = postgresConnection.Exec(queries.ArticleView().Insert(), pq.Array(articleIds), pq.Array(userIds),
+		pq.Array(viewDates))
+	if err != nil {
+		log.Errorf("Error registering %d buffered article views: %s", len(articleViews), err.Error())
+		return err
+	}
+
+	return nil
+}
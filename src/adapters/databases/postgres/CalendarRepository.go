@@ -0,0 +1,232 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/devlucassantos/vnc-domains/src/domains/articletype"
+	"github.com/devlucassantos/vnc-domains/src/domains/event"
+	"github.com/devlucassantos/vnc-domains/src/domains/eventsituation"
+	"github.com/devlucassantos/vnc-domains/src/domains/eventtype"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"vnc-api/adapters/databases/dto"
+	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/filters"
+)
+
+type Calendar struct {
+	connectionManager connectionManagerInterface
+}
+
+func NewCalendarRepository(connectionManager connectionManagerInterface) *Calendar {
+	return &Calendar{
+		connectionManager: connectionManager,
+	}
+}
+
+func (instance Calendar) GetCalendarEvents(filter filters.Calendar, followerId uuid.UUID,
+	numberOfEvents int) ([]event.Event, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var follower *uuid.UUID
+	if followerId != uuid.Nil {
+		follower = &followerId
+	}
+
+	var eventsData []dto.Article
+	err = postgresConnection.Select(&eventsData, queries.Calendar().Select().Events(), filter.TypeId,
+		filter.Event.SituationId, filter.Event.LegislativeBodyId, filter.Event.RapporteurId, filter.Event.StartDate,
+		filter.Event.EndDate, follower, numberOfEvents)
+	if err != nil {
+		log.Error("Error retrieving the events of the calendar from the database: ", err.Error())
+		return nil, err
+	}
+
+	var events []event.Event
+	for _, eventData := range eventsData {
+		eventDomain, err := buildCalendarEvent(eventData)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, *eventDomain)
+	}
+
+	return events, nil
+}
+
+func (instance Calendar) GetCalendarTokenByUserId(userId uuid.UUID) (string, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return "", err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var token string
+	err = postgresConnection.Get(&token, queries.Calendar().Select().TokenByUserId(), userId)
+	if err != nil {
+		log.Errorf("Error retrieving the private calendar of user %s from the database: %s", userId, err.Error())
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (instance Calendar) GetUserIdByCalendarToken(token string) (uuid.UUID, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return uuid.Nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var userId uuid.UUID
+	err = postgresConnection.Get(&userId, queries.Calendar().Select().UserIdByToken(), token)
+	if err != nil {
+		log.Error("Error retrieving the owner of the private calendar from the database: ", err.Error())
+		return uuid.Nil, err
+	}
+
+	return userId, nil
+}
+
+func (instance Calendar) SaveCalendarToken(userId uuid.UUID, token string) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	sqlResult, err := postgresConnection.Exec(queries.Calendar().Update().Token(), token, userId)
+	if err != nil {
+		log.Errorf("Error updating the private calendar of user %s: %s", userId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err == nil && rowsAffected == 0 {
+		_, err = postgresConnection.Exec(queries.Calendar().Insert().Token(), userId, token)
+		if err != nil {
+			log.Errorf("Error registering the private calendar of user %s: %s", userId, err.Error())
+			return err
+		}
+	} else if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by the update of the private calendar of user "+
+			"%s: %s", userId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (instance Calendar) DeleteCalendarToken(userId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	sqlResult, err := postgresConnection.Exec(queries.Calendar().Delete().Token(), userId)
+	if err != nil {
+		log.Errorf("Error deleting the private calendar of user %s: %s", userId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by the deletion of the private calendar of user "+
+			"%s: %s", userId, err.Error())
+		return err
+	} else if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func buildCalendarEvent(eventData dto.Article) (*event.Event, error) {
+	articleType, err := articletype.NewBuilder().
+		Id(eventData.ArticleType.Id).
+		Description(eventData.ArticleType.Description).
+		Codes(eventData.ArticleType.Codes).
+		Color(eventData.ArticleType.Color).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating data for article type %s of article %s: %s", eventData.ArticleType.Id,
+			eventData.Id, err.Error())
+		return nil, err
+	}
+
+	articleDomain, err := article.NewBuilder().
+		Id(eventData.Id).
+		Type(*articleType).
+		CreatedAt(eventData.CreatedAt).
+		UpdatedAt(eventData.UpdatedAt).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating data for article %s of event %s: %s", eventData.Id, eventData.Event.Id,
+			err.Error())
+		return nil, err
+	}
+
+	eventType, err := eventtype.NewBuilder().
+		Id(eventData.Event.EventType.Id).
+		Description(eventData.Event.EventType.Description).
+		Color(eventData.Event.EventType.Color).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating data for event type %s of event %s: %s", eventData.Event.EventType.Id,
+			eventData.Event.Id, err.Error())
+		return nil, err
+	}
+
+	eventSituation, err := eventsituation.NewBuilder().
+		Id(eventData.Event.EventSituation.Id).
+		Description(eventData.Event.EventSituation.Description).
+		Color(eventData.Event.EventSituation.Color).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating data for event situation %s of event %s: %s",
+			eventData.Event.EventSituation.Id, eventData.Event.Id, err.Error())
+		return nil, err
+	}
+
+	eventBuilder := event.NewBuilder()
+
+	if !eventData.Event.EndsAt.IsZero() {
+		eventBuilder.EndsAt(eventData.Event.EndsAt)
+	}
+
+	if eventData.Event.VideoUrl != "" {
+		eventBuilder.VideoUrl(eventData.Event.VideoUrl)
+	}
+
+	eventDomain, err := eventBuilder.
+		Id(eventData.Event.Id).
+		Code(eventData.Event.Code).
+		Title(eventData.Event.Title).
+		Description(eventData.Event.Description).
+		StartsAt(eventData.Event.StartsAt).
+		Location(eventData.Event.Location).
+		IsInternal(eventData.Event.IsInternal).
+		Type(*eventType).
+		Situation(*eventSituation).
+		Article(*articleDomain).
+		CreatedAt(eventData.Event.CreatedAt).
+		UpdatedAt(eventData.Event.UpdatedAt).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating data for event %s of article %s: %s", eventData.Event.Id, eventData.Id,
+			err.Error())
+		return nil, err
+	}
+
+	return eventDomain, nil
+}
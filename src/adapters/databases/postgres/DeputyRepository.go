@@ -0,0 +1,365 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/devlucassantos/vnc-domains/src/domains/deputy"
+	"github.com/devlucassantos/vnc-domains/src/domains/legislativebody"
+	"github.com/devlucassantos/vnc-domains/src/domains/legislativebodytype"
+	"github.com/devlucassantos/vnc-domains/src/domains/party"
+	"github.com/devlucassantos/vnc-domains/src/domains/propositiontype"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"github.com/lib/pq"
+	"vnc-api/adapters/databases/dto"
+	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/domains/deputypair"
+	"vnc-api/core/domains/deputystatistics"
+	"vnc-api/core/domains/propositiontypecount"
+	"vnc-api/core/domains/rapporteurship"
+	"vnc-api/core/domains/votingrecord"
+	"vnc-api/core/filters"
+)
+
+type Deputy struct {
+	connectionManager connectionManagerInterface
+}
+
+func NewDeputyRepository(connectionManager connectionManagerInterface) *Deputy {
+	return &Deputy{
+		connectionManager: connectionManager,
+	}
+}
+
+func (instance Deputy) GetDeputyById(deputyId uuid.UUID) (*deputy.Deputy, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var deputyData dto.Deputy
+	err = postgresConnection.Get(&deputyData, queries.Deputy().Select().ById(), deputyId)
+	if err != nil {
+		log.Errorf("Error retrieving data for deputy %s from the database: %s", deputyId, err.Error())
+		return nil, err
+	}
+
+	return buildDeputy(deputyData)
+}
+
+func (instance Deputy) GetDeputyStatistics(deputyId uuid.UUID) (*deputystatistics.DeputyStatistics, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var statisticsData dto.DeputyStatistics
+	err = postgresConnection.Get(&statisticsData, queries.Deputy().Select().Statistics(), deputyId)
+	if err != nil {
+		log.Errorf("Error retrieving the statistics of deputy %s from the database: %s", deputyId, err.Error())
+		return nil, err
+	}
+
+	var propositionTypesData []dto.PropositionTypeCount
+	err = postgresConnection.Select(&propositionTypesData, queries.Deputy().Select().PropositionTypes(), deputyId)
+	if err != nil {
+		log.Errorf("Error retrieving the number of propositions by type of deputy %s from the database: %s",
+			deputyId, err.Error())
+		return nil, err
+	}
+
+	propositionTypes, err := buildPropositionTypeCounts(propositionTypesData)
+	if err != nil {
+		return nil, err
+	}
+
+	deputyStatistics, err := deputystatistics.NewBuilder().
+		NumberOfPropositions(statisticsData.NumberOfPropositions).
+		PropositionTypes(propositionTypes).
+		NumberOfRapporteurships(statisticsData.NumberOfRapporteurships).
+		NumberOfVotedPropositions(statisticsData.NumberOfVotedPropositions).
+		NumberOfApprovedPropositions(statisticsData.NumberOfApprovedPropositions).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating the statistics of deputy %s: %s", deputyId, err.Error())
+		return nil, err
+	}
+
+	return deputyStatistics, nil
+}
+
+func (instance Deputy) GetDeputyRapporteurships(deputyId uuid.UUID, pagination filters.Pagination) (
+	[]rapporteurship.Rapporteurship, int, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, 0, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var rapporteurshipsData []dto.Rapporteurship
+	err = postgresConnection.Select(&rapporteurshipsData, queries.Deputy().Select().Rapporteurships(), deputyId,
+		pagination.CalculateOffset(), pagination.GetItemsPerPage())
+	if err != nil {
+		log.Errorf("Error retrieving the rapporteurships of deputy %s from the database: %s", deputyId,
+			err.Error())
+		return nil, 0, err
+	}
+
+	var totalNumberOfRapporteurships int
+	err = postgresConnection.Get(&totalNumberOfRapporteurships, queries.Deputy().Select().NumberOfRapporteurships(),
+		deputyId)
+	if err != nil {
+		log.Errorf("Error retrieving the number of rapporteurships of deputy %s from the database: %s", deputyId,
+			err.Error())
+		return nil, 0, err
+	}
+
+	var rapporteurships []rapporteurship.Rapporteurship
+	for _, rapporteurshipData := range rapporteurshipsData {
+		rapporteurshipDomain, err := rapporteurship.NewBuilder().
+			Id(rapporteurshipData.Id).
+			Title(rapporteurshipData.Title).
+			Topic(rapporteurshipData.Topic).
+			Regime(rapporteurshipData.Regime).
+			Situation(rapporteurshipData.Situation).
+			PropositionArticleId(rapporteurshipData.PropositionArticleId).
+			PropositionTitle(rapporteurshipData.PropositionTitle).
+			EventArticleId(rapporteurshipData.EventArticleId).
+			EventTitle(rapporteurshipData.EventTitle).
+			EventStartsAt(rapporteurshipData.EventStartsAt).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for rapporteurship %s of deputy %s: %s", rapporteurshipData.Id,
+				deputyId, err.Error())
+			return nil, 0, err
+		}
+		rapporteurships = append(rapporteurships, *rapporteurshipDomain)
+	}
+
+	return rapporteurships, totalNumberOfRapporteurships, nil
+}
+
+func (instance Deputy) GetDeputyVotingRecords(deputyId uuid.UUID, filter filters.DeputyVote) (
+	[]votingrecord.VotingRecord, int, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, 0, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var votingRecordsData []dto.VotingRecord
+	err = postgresConnection.Select(&votingRecordsData, queries.Deputy().Select().VotingRecords(), deputyId,
+		filter.Voting.StartDate, filter.Voting.EndDate, filter.Voting.Result, filter.Voting.LegislativeBodyId,
+		filter.Vote, filter.Pagination.CalculateOffset(), filter.Pagination.GetItemsPerPage())
+	if err != nil {
+		log.Errorf("Error retrieving the votes of deputy %s from the database: %s", deputyId, err.Error())
+		return nil, 0, err
+	}
+
+	var totalNumberOfVotingRecords int
+	err = postgresConnection.Get(&totalNumberOfVotingRecords, queries.Deputy().Select().NumberOfVotingRecords(),
+		deputyId, filter.Voting.StartDate, filter.Voting.EndDate, filter.Voting.Result,
+		filter.Voting.LegislativeBodyId, filter.Vote)
+	if err != nil {
+		log.Errorf("Error retrieving the number of votes of deputy %s from the database: %s", deputyId,
+			err.Error())
+		return nil, 0, err
+	}
+
+	var votingRecords []votingrecord.VotingRecord
+	for _, votingRecordData := range votingRecordsData {
+		if votingRecordData.Party.Id == uuid.Nil {
+			log.Warnf("The party of vote %s of deputy %s could not be found, the vote will be ignored",
+				votingRecordData.Id, deputyId)
+			continue
+		}
+
+		legislativeBodyType, err := legislativebodytype.NewBuilder().
+			Id(votingRecordData.Voting.LegislativeBody.LegislativeBodyType.Id).
+			Description(votingRecordData.Voting.LegislativeBody.LegislativeBodyType.Description).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for the legislative body type %s of legislative body %s of voting "+
+				"%s: %s", votingRecordData.Voting.LegislativeBody.LegislativeBodyType.Id,
+				votingRecordData.Voting.LegislativeBody.Id, votingRecordData.Voting.Id, err.Error())
+			return nil, 0, err
+		}
+
+		legislativeBody, err := legislativebody.NewBuilder().
+			Id(votingRecordData.Voting.LegislativeBody.Id).
+			Name(votingRecordData.Voting.LegislativeBody.Name).
+			Acronym(votingRecordData.Voting.LegislativeBody.Acronym).
+			Type(*legislativeBodyType).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for legislative body %s of voting %s: %s",
+				votingRecordData.Voting.LegislativeBody.Id, votingRecordData.Voting.Id, err.Error())
+			return nil, 0, err
+		}
+
+		partyDomain, err := party.NewBuilder().
+			Id(votingRecordData.Party.Id).
+			Name(votingRecordData.Party.Name).
+			Acronym(votingRecordData.Party.Acronym).
+			ImageUrl(votingRecordData.Party.ImageUrl).
+			ImageDescription(fmt.Sprintf("Logo do %s (%s)", votingRecordData.Party.Name,
+				votingRecordData.Party.Acronym)).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for party %s of vote %s of deputy %s: %s", votingRecordData.Party.Id,
+				votingRecordData.Id, deputyId, err.Error())
+			return nil, 0, err
+		}
+
+		votingRecordBuilder := votingrecord.NewBuilder()
+
+		if votingRecordData.PartyOrientation != "" {
+			votingRecordBuilder.PartyOrientation(votingRecordData.PartyOrientation)
+		}
+
+		votingRecord, err := votingRecordBuilder.
+			Id(votingRecordData.Id).
+			VotingArticleId(votingRecordData.VotingArticleId).
+			VotingTitle(fmt.Sprint("Votação ", votingRecordData.Voting.Code)).
+			VotingResult(votingRecordData.Voting.Result).
+			VotingResultAnnouncedAt(votingRecordData.Voting.ResultAnnouncedAt).
+			VotingIsApproved(votingRecordData.Voting.IsApproved).
+			LegislativeBody(*legislativeBody).
+			Party(*partyDomain).
+			Vote(votingRecordData.Vote).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for vote %s of deputy %s: %s", votingRecordData.Id, deputyId,
+				err.Error())
+			return nil, 0, err
+		}
+		votingRecords = append(votingRecords, *votingRecord)
+	}
+
+	return votingRecords, totalNumberOfVotingRecords, nil
+}
+
+func (instance Deputy) GetDeputyPairs(deputyIds []uuid.UUID) ([]deputypair.DeputyPair, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var deputyPairsData []dto.DeputyPair
+	err = postgresConnection.Select(&deputyPairsData, queries.Deputy().Select().Pairs(), pq.Array(deputyIds))
+	if err != nil {
+		log.Errorf("Error retrieving the pairs of deputies %s from the database: %s", deputyIds, err.Error())
+		return nil, err
+	}
+
+	var deputyPairs []deputypair.DeputyPair
+	for _, deputyPairData := range deputyPairsData {
+		deputyPair, err := deputypair.NewBuilder().
+			FirstDeputyId(deputyPairData.FirstDeputyId).
+			SecondDeputyId(deputyPairData.SecondDeputyId).
+			NumberOfCoAuthoredPropositions(deputyPairData.NumberOfCoAuthoredPropositions).
+			NumberOfCommonVotes(deputyPairData.NumberOfCommonVotes).
+			NumberOfMatchingVotes(deputyPairData.NumberOfMatchingVotes).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for the pair of deputies %s and %s: %s", deputyPairData.FirstDeputyId,
+				deputyPairData.SecondDeputyId, err.Error())
+			return nil, err
+		}
+		deputyPairs = append(deputyPairs, *deputyPair)
+	}
+
+	return deputyPairs, nil
+}
+
+func buildDeputy(deputyData dto.Deputy) (*deputy.Deputy, error) {
+	currentParty, err := party.NewBuilder().
+		Id(deputyData.Party.Id).
+		Name(deputyData.Party.Name).
+		Acronym(deputyData.Party.Acronym).
+		ImageUrl(deputyData.Party.ImageUrl).
+		ImageDescription(fmt.Sprintf("Logo do %s (%s)", deputyData.Party.Name, deputyData.Party.Acronym)).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating data for the current party %s of deputy %s: %s", deputyData.Party.Id,
+			deputyData.Id, err.Error())
+		return nil, err
+	}
+
+	deputyBuilder := deputy.NewBuilder()
+
+	if deputyData.PreviousParty != nil && deputyData.PreviousParty.Id != uuid.Nil {
+		previousParty, err := party.NewBuilder().
+			Id(deputyData.PreviousParty.Id).
+			Name(deputyData.PreviousParty.Name).
+			Acronym(deputyData.PreviousParty.Acronym).
+			ImageUrl(deputyData.PreviousParty.ImageUrl).
+			ImageDescription(fmt.Sprintf("Logo do %s (%s)", deputyData.PreviousParty.Name,
+				deputyData.PreviousParty.Acronym)).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for the previous party %s of deputy %s: %s",
+				deputyData.PreviousParty.Id, deputyData.Id, err.Error())
+			return nil, err
+		}
+		deputyBuilder.PreviousParty(*previousParty)
+	}
+
+	if deputyData.PreviousFederatedUnit != "" {
+		deputyBuilder.PreviousFederatedUnit(deputyData.PreviousFederatedUnit)
+	}
+
+	deputyDomain, err := deputyBuilder.
+		Id(deputyData.Id).
+		Name(deputyData.Name).
+		ElectoralName(deputyData.ElectoralName).
+		ImageUrl(deputyData.ImageUrl).
+		ImageDescription(fmt.Sprintf("Foto do(a) deputado(a) federal %s (%s-%s)", deputyData.Name,
+			deputyData.Party.Acronym, deputyData.FederatedUnit)).
+		Party(*currentParty).
+		FederatedUnit(deputyData.FederatedUnit).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating data for deputy %s: %s", deputyData.Id, err.Error())
+		return nil, err
+	}
+
+	return deputyDomain, nil
+}
+
+func buildPropositionTypeCounts(propositionTypesData []dto.PropositionTypeCount) (
+	[]propositiontypecount.PropositionTypeCount, error) {
+	var propositionTypeCounts []propositiontypecount.PropositionTypeCount
+	for _, propositionTypeData := range propositionTypesData {
+		propositionType, err := propositiontype.NewBuilder().
+			Id(propositionTypeData.PropositionType.Id).
+			Description(propositionTypeData.PropositionType.Description).
+			Color(propositionTypeData.PropositionType.Color).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for proposition type %s: %s", propositionTypeData.PropositionType.Id,
+				err.Error())
+			return nil, err
+		}
+
+		propositionTypeCount, err := propositiontypecount.NewBuilder().
+			PropositionType(*propositionType).
+			NumberOfPropositions(propositionTypeData.NumberOfPropositions).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating the number of propositions of type %s: %s", propositionType.Id(),
+				err.Error())
+			return nil, err
+		}
+		propositionTypeCounts = append(propositionTypeCounts, *propositionTypeCount)
+	}
+
+	return propositionTypeCounts, nil
+}
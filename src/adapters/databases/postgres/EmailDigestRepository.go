@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/devlucassantos/vnc-domains/src/domains/user"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/adapters/databases/dto"
+	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/domains/emaildigest"
+)
+
+type EmailDigest struct {
+	connectionManager connectionManagerInterface
+}
+
+func NewEmailDigestRepository(connectionManager connectionManagerInterface) *EmailDigest {
+	return &EmailDigest{
+		connectionManager: connectionManager,
+	}
+}
+
+func (instance EmailDigest) GetEmailDigestByUserId(userId uuid.UUID) (*emaildigest.EmailDigest, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var emailDigestData dto.EmailDigest
+	err = postgresConnection.Get(&emailDigestData, queries.EmailDigest().Select().ByUserId(), userId)
+	if err != nil {
+		log.Errorf("Error retrieving the email digest subscription of user %s from the database: %s", userId,
+			err.Error())
+		return nil, err
+	}
+
+	return buildEmailDigest(emailDigestData)
+}
+
+func (instance EmailDigest) SaveEmailDigest(userId uuid.UUID, frequency string) (*emaildigest.EmailDigest, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	sqlResult, err := postgresConnection.Exec(queries.EmailDigest().Update().EmailDigest(), frequency, userId)
+	if err != nil {
+		log.Errorf("Error updating the email digest subscription of user %s: %s", userId, err.Error())
+		return nil, err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err == nil && rowsAffected == 0 {
+		_, err = postgresConnection.Exec(queries.EmailDigest().Insert().EmailDigest(), userId, frequency)
+		if err != nil {
+			log.Errorf("Error registering the email digest subscription of user %s: %s", userId, err.Error())
+			return nil, err
+		}
+	} else if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by the update of the email digest subscription "+
+			"of user %s: %s", userId, err.Error())
+		return nil, err
+	}
+
+	var emailDigestData dto.EmailDigest
+	err = postgresConnection.Get(&emailDigestData, queries.EmailDigest().Select().ByUserId(), userId)
+	if err != nil {
+		log.Errorf("Error retrieving the email digest subscription of user %s from the database: %s", userId,
+			err.Error())
+		return nil, err
+	}
+
+	return buildEmailDigest(emailDigestData)
+}
+
+func (instance EmailDigest) DeleteEmailDigest(userId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	sqlResult, err := postgresConnection.Exec(queries.EmailDigest().Delete().EmailDigest(), userId)
+	if err != nil {
+		log.Errorf("Error deleting the email digest subscription of user %s: %s", userId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by the deletion of the email digest subscription "+
+			"of user %s: %s", userId, err.Error())
+		return err
+	} else if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (instance EmailDigest) ClaimPendingEmailDigests(numberOfEmailDigests int) ([]emaildigest.EmailDigest, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var emailDigestDtos []dto.EmailDigest
+	err = postgresConnection.Select(&emailDigestDtos, queries.EmailDigest().Update().PendingEmailDigests(),
+		numberOfEmailDigests)
+	if err != nil {
+		log.Error("Error claiming the pending email digests in the database: ", err.Error())
+		return nil, err
+	}
+
+	var emailDigests []emaildigest.EmailDigest
+	for _, emailDigestData := range emailDigestDtos {
+		emailDigest, err := buildEmailDigest(emailDigestData)
+		if err != nil {
+			return nil, err
+		}
+
+		emailDigests = append(emailDigests, *emailDigest)
+	}
+
+	return emailDigests, nil
+}
+
+func (instance EmailDigest) RestoreEmailDigestLastSentAt(userId uuid.UUID, lastSentAt time.Time) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	_, err = postgresConnection.Exec(queries.EmailDigest().Update().LastSentAt(), lastSentAt, userId)
+	if err != nil {
+		log.Errorf("Error restoring the date the email digest of user %s was last sent: %s", userId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (instance EmailDigest) GetEmailDigestArticles(userId uuid.UUID, startDate time.Time, numberOfArticles int) (
+	[]article.Article, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var userArticles []dto.UserArticle
+	err = postgresConnection.Select(&userArticles, queries.EmailDigest().Select().Articles(), userId, startDate,
+		numberOfArticles)
+	if err != nil {
+		log.Errorf("Error searching for the articles of the email digest of user %s in the database: %s", userId,
+			err.Error())
+		return nil, err
+	}
+
+	articles, err := getArticlesFromUserArticles(postgresConnection, userArticles)
+	if err != nil {
+		log.Errorf("Error retrieving data for the articles of the email digest of user %s: %s", userId, err.Error())
+		return nil, err
+	}
+
+	return articles, nil
+}
+
+func buildEmailDigest(emailDigestData dto.EmailDigest) (*emaildigest.EmailDigest, error) {
+	userDomain, err := user.NewBuilder().
+		Id(emailDigestData.User.Id).
+		FirstName(emailDigestData.User.FirstName).
+		LastName(emailDigestData.User.LastName).
+		Email(emailDigestData.User.Email).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating data for user %s of the email digest: %s", emailDigestData.User.Id, err.Error())
+		return nil, err
+	}
+
+	emailDigest, err := emaildigest.NewBuilder().
+		User(*userDomain).
+		Frequency(emailDigestData.Frequency).
+		LastSentAt(emailDigestData.LastSentAt).
+		SentAt(emailDigestData.SentAt).
+		CreatedAt(emailDigestData.CreatedAt).
+		UpdatedAt(emailDigestData.UpdatedAt).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating data for the email digest of user %s: %s", emailDigestData.User.Id,
+			err.Error())
+		return nil, err
+	}
+
+	return emailDigest, nil
+}
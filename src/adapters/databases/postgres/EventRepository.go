@@ -663,15 +663,5 @@ func (instance Event) GetEventByArticleId(articleId uuid.UUID, userId uuid.UUID)
 		return nil, err
 	}
 
-	var userIdPointer *uuid.UUID
-	if userId != uuid.Nil {
-		userIdPointer = &userId
-	}
-
-	_, err = postgresConnection.Exec(queries.ArticleView().Insert(), articleId, userIdPointer)
-	if err != nil {
-		log.Errorf("Error registering the view for article %s: %s", articleId, err.Error())
-	}
-
 	return eventDomain, nil
 }
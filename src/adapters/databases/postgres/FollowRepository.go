@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"vnc-api/adapters/databases/dto"
+	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/domains/follow"
+)
+
+type Follow struct {
+	connectionManager connectionManagerInterface
+}
+
+func NewFollowRepository(connectionManager connectionManagerInterface) *Follow {
+	return &Follow{
+		connectionManager: connectionManager,
+	}
+}
+
+func (instance Follow) GetFollowsByUserId(userId uuid.UUID) ([]follow.Follow, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var followDtos []dto.Follow
+	err = postgresConnection.Select(&followDtos, queries.UserFollow().Select().ByUserId(), userId)
+	if err != nil {
+		log.Errorf("Error retrieving the resources followed by user %s from the database: %s", userId, err.Error())
+		return nil, err
+	}
+
+	var follows []follow.Follow
+	for _, followData := range followDtos {
+		followDomain, err := follow.NewBuilder().
+			ResourceType(followData.ResourceType).
+			ResourceId(followData.ResourceId).
+			ResourceName(followData.ResourceName).
+			CreatedAt(followData.CreatedAt).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for %s %s followed by user %s: %s", followData.ResourceType,
+				followData.ResourceId, userId, err.Error())
+			return nil, err
+		}
+
+		follows = append(follows, *followDomain)
+	}
+
+	return follows, nil
+}
+
+func (instance Follow) FollowResource(userId uuid.UUID, resourceType string, resourceId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	sqlResult, err := postgresConnection.Exec(queries.UserFollow().Insert().Follow(), userId, resourceType,
+		resourceId)
+	if err != nil {
+		log.Errorf("Error registering the follow of %s %s by user %s: %s", resourceType, resourceId, userId,
+			err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by the registration of the follow of %s %s by "+
+			"user %s: %s", resourceType, resourceId, userId, err.Error())
+		return err
+	} else if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (instance Follow) UnfollowResource(userId uuid.UUID, resourceType string, resourceId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	sqlResult, err := postgresConnection.Exec(queries.UserFollow().Delete().Follow(), userId, resourceType,
+		resourceId)
+	if err != nil {
+		log.Errorf("Error removing the follow of %s %s by user %s: %s", resourceType, resourceId, userId,
+			err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by the removal of the follow of %s %s by user "+
+			"%s: %s", resourceType, resourceId, userId, err.Error())
+		return err
+	} else if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
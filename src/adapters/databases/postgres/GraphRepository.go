@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"github.com/labstack/gommon/log"
+	"vnc-api/adapters/databases/dto"
+	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/domains/coauthorshipedge"
+	"vnc-api/core/domains/coauthorshipnode"
+	"vnc-api/core/filters"
+)
+
+type Graph struct {
+	connectionManager connectionManagerInterface
+}
+
+func NewGraphRepository(connectionManager connectionManagerInterface) *Graph {
+	return &Graph{
+		connectionManager: connectionManager,
+	}
+}
+
+func (instance Graph) GetCoAuthorshipGraph(filter filters.CoAuthorship) ([]coauthorshipnode.CoAuthorshipNode,
+	[]coauthorshipedge.CoAuthorshipEdge, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	startDate, endDate := filter.GetPeriod()
+	var nodesData []dto.CoAuthorshipNode
+	err = postgresConnection.Select(&nodesData, queries.Graph().Select().CoAuthorshipNodes(), startDate, endDate,
+		filter.PartyId, filter.PropositionTypeId, filter.GetMinimumWeight(), filter.MaximumNumberOfNodes)
+	if err != nil {
+		log.Error("Error retrieving the nodes of the co-authorship graph from the database: ", err.Error())
+		return nil, nil, err
+	}
+
+	var edgesData []dto.CoAuthorshipEdge
+	err = postgresConnection.Select(&edgesData, queries.Graph().Select().CoAuthorshipEdges(), startDate, endDate,
+		filter.PartyId, filter.PropositionTypeId, filter.GetMinimumWeight(), filter.MaximumNumberOfNodes)
+	if err != nil {
+		log.Error("Error retrieving the edges of the co-authorship graph from the database: ", err.Error())
+		return nil, nil, err
+	}
+
+	var nodes []coauthorshipnode.CoAuthorshipNode
+	for _, nodeData := range nodesData {
+		node, err := coauthorshipnode.NewBuilder().
+			Id(nodeData.Id).
+			Label(nodeData.Label).
+			Type(nodeData.Type).
+			PartyAcronym(nodeData.PartyAcronym).
+			FederatedUnit(nodeData.FederatedUnit).
+			ExternalAuthorType(nodeData.ExternalAuthorType).
+			NumberOfPropositions(nodeData.NumberOfPropositions).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for the co-authorship node %s: %s", nodeData.Id, err.Error())
+			return nil, nil, err
+		}
+		nodes = append(nodes, *node)
+	}
+
+	var edges []coauthorshipedge.CoAuthorshipEdge
+	for _, edgeData := range edgesData {
+		edge, err := coauthorshipedge.NewBuilder().
+			SourceId(edgeData.SourceId).
+			TargetId(edgeData.TargetId).
+			Weight(edgeData.Weight).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for the co-authorship edge between %s and %s: %s", edgeData.SourceId,
+				edgeData.TargetId, err.Error())
+			return nil, nil, err
+		}
+		edges = append(edges, *edge)
+	}
+
+	return nodes, edges, nil
+}
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/devlucassantos/vnc-domains/src/domains/legislativebody"
+	"github.com/devlucassantos/vnc-domains/src/domains/legislativebodytype"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/adapters/databases/dto"
+	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/domains/frequentrapporteur"
+)
+
+type LegislativeBody struct {
+	connectionManager connectionManagerInterface
+}
+
+func NewLegislativeBodyRepository(connectionManager connectionManagerInterface) *LegislativeBody {
+	return &LegislativeBody{
+		connectionManager: connectionManager,
+	}
+}
+
+func (instance LegislativeBody) GetLegislativeBodyById(legislativeBodyId uuid.UUID) (
+	*legislativebody.LegislativeBody, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var legislativeBodyData dto.LegislativeBody
+	err = postgresConnection.Get(&legislativeBodyData, queries.LegislativeBody().Select().ById(), legislativeBodyId)
+	if err != nil {
+		log.Errorf("Error retrieving data for legislative body %s from the database: %s", legislativeBodyId,
+			err.Error())
+		return nil, err
+	}
+
+	legislativeBodyType, err := legislativebodytype.NewBuilder().
+		Id(legislativeBodyData.LegislativeBodyType.Id).
+		Description(legislativeBodyData.LegislativeBodyType.Description).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating data for the legislative body type %s of legislative body %s: %s",
+			legislativeBodyData.LegislativeBodyType.Id, legislativeBodyData.Id, err.Error())
+		return nil, err
+	}
+
+	legislativeBodyDomain, err := legislativebody.NewBuilder().
+		Id(legislativeBodyData.Id).
+		Name(legislativeBodyData.Name).
+		Acronym(legislativeBodyData.Acronym).
+		Type(*legislativeBodyType).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating data for legislative body %s: %s", legislativeBodyData.Id, err.Error())
+		return nil, err
+	}
+
+	return legislativeBodyDomain, nil
+}
+
+func (instance LegislativeBody) GetLegislativeBodyUpcomingEvents(legislativeBodyId uuid.UUID, startDate time.Time,
+	endDate time.Time, numberOfEvents int) ([]article.Article, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var userArticles []dto.UserArticle
+	err = postgresConnection.Select(&userArticles, queries.LegislativeBody().Select().UpcomingEvents(),
+		legislativeBodyId, startDate, endDate, numberOfEvents)
+	if err != nil {
+		log.Errorf("Error retrieving the upcoming events of legislative body %s from the database: %s",
+			legislativeBodyId, err.Error())
+		return nil, err
+	}
+
+	events, err := getArticlesFromUserArticles(postgresConnection, userArticles)
+	if err != nil {
+		log.Errorf("Error retrieving data for the upcoming events of legislative body %s: %s", legislativeBodyId,
+			err.Error())
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (instance LegislativeBody) GetLegislativeBodyFrequentRapporteurs(legislativeBodyId uuid.UUID,
+	numberOfRapporteurs int) ([]frequentrapporteur.FrequentRapporteur, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var rapporteursData []dto.FrequentRapporteur
+	err = postgresConnection.Select(&rapporteursData, queries.LegislativeBody().Select().FrequentRapporteurs(),
+		legislativeBodyId, numberOfRapporteurs)
+	if err != nil {
+		log.Errorf("Error retrieving the most frequent rapporteurs of legislative body %s from the database: %s",
+			legislativeBodyId, err.Error())
+		return nil, err
+	}
+
+	var rapporteurs []frequentrapporteur.FrequentRapporteur
+	for _, rapporteurData := range rapporteursData {
+		deputyDomain, err := buildDeputy(*rapporteurData.Deputy)
+		if err != nil {
+			return nil, err
+		}
+
+		rapporteur, err := frequentrapporteur.NewBuilder().
+			Deputy(*deputyDomain).
+			NumberOfRapporteurships(rapporteurData.NumberOfRapporteurships).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for rapporteur %s of legislative body %s: %s", deputyDomain.Id(),
+				legislativeBodyId, err.Error())
+			return nil, err
+		}
+		rapporteurs = append(rapporteurs, *rapporteur)
+	}
+
+	return rapporteurs, nil
+}
@@ -239,15 +239,5 @@ func (instance Newsletter) GetNewsletterByArticleId(articleId uuid.UUID, userId
 		return nil, err
 	}
 
-	var userIdPointer *uuid.UUID
-	if userId != uuid.Nil {
-		userIdPointer = &userId
-	}
-
-	_, err = postgresConnection.Exec(queries.ArticleView().Insert(), articleId, userIdPointer)
-	if err != nil {
-		log.Errorf("Error registering the view for article %s: %s", articleId, err.Error())
-	}
-
 	return newsletterDomain, nil
 }
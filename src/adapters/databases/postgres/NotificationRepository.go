@@ -0,0 +1,213 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/adapters/databases/dto"
+	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/domains/notification"
+	"vnc-api/core/domains/notificationpreference"
+	"vnc-api/core/filters"
+)
+
+type Notification struct {
+	connectionManager connectionManagerInterface
+}
+
+func NewNotificationRepository(connectionManager connectionManagerInterface) *Notification {
+	return &Notification{
+		connectionManager: connectionManager,
+	}
+}
+
+func (instance Notification) GetNotificationsByUserId(userId uuid.UUID, onlyUnread bool,
+	pagination filters.Pagination) ([]notification.Notification, int, int, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, 0, 0, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var notificationDtos []dto.Notification
+	err = postgresConnection.Select(&notificationDtos, queries.Notification().Select().ByUserId(), userId,
+		onlyUnread, pagination.CalculateOffset(), pagination.GetItemsPerPage())
+	if err != nil {
+		log.Errorf("Error retrieving the notifications of user %s from the database: %s", userId, err.Error())
+		return nil, 0, 0, err
+	}
+
+	var userArticles []dto.UserArticle
+	for _, notificationData := range notificationDtos {
+		userArticles = append(userArticles, notificationData.UserArticle)
+	}
+
+	articles, err := getArticlesFromUserArticles(postgresConnection, userArticles)
+	if err != nil {
+		log.Errorf("Error retrieving data for the articles of the notifications of user %s: %s", userId,
+			err.Error())
+		return nil, 0, 0, err
+	}
+
+	var notifications []notification.Notification
+	for index, notificationData := range notificationDtos {
+		notificationBuilder := notification.NewBuilder()
+
+		if notificationData.IsRead && !notificationData.ReadAt.IsZero() {
+			notificationBuilder.ReadAt(notificationData.ReadAt)
+		}
+
+		notificationDomain, err := notificationBuilder.
+			Id(notificationData.Id).
+			Type(notificationData.Type).
+			Article(articles[index]).
+			PropositionArticleId(notificationData.PropositionArticleId).
+			PropositionTitle(notificationData.PropositionTitle).
+			IsRead(notificationData.IsRead).
+			CreatedAt(notificationData.CreatedAt).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for notification %s of user %s: %s", notificationData.Id, userId,
+				err.Error())
+			return nil, 0, 0, err
+		}
+
+		notifications = append(notifications, *notificationDomain)
+	}
+
+	var numberOfNotifications dto.NumberOfNotifications
+	err = postgresConnection.Get(&numberOfNotifications,
+		queries.Notification().Select().NumberOfNotificationsByUserId(), userId, onlyUnread)
+	if err != nil {
+		log.Errorf("Error retrieving the number of notifications of user %s from the database: %s", userId,
+			err.Error())
+		return nil, 0, 0, err
+	}
+
+	return notifications, numberOfNotifications.Total, numberOfNotifications.Unread, nil
+}
+
+func (instance Notification) MarkNotificationAsRead(userId uuid.UUID, notificationId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	sqlResult, err := postgresConnection.Exec(queries.Notification().Update().Read(), notificationId, userId)
+	if err != nil {
+		log.Errorf("Error marking notification %s of user %s as read: %s", notificationId, userId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by marking notification %s of user %s as read: %s",
+			notificationId, userId, err.Error())
+		return err
+	} else if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (instance Notification) MarkAllNotificationsAsRead(userId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	_, err = postgresConnection.Exec(queries.Notification().Update().AllRead(), userId)
+	if err != nil {
+		log.Errorf("Error marking all notifications of user %s as read: %s", userId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (instance Notification) GetNotificationPreferencesByUserId(userId uuid.UUID) (
+	[]notificationpreference.NotificationPreference, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var notificationPreferenceDtos []dto.NotificationPreference
+	err = postgresConnection.Select(&notificationPreferenceDtos,
+		queries.Notification().Select().PreferencesByUserId(), userId)
+	if err != nil {
+		log.Errorf("Error retrieving the notification preferences of user %s from the database: %s", userId,
+			err.Error())
+		return nil, err
+	}
+
+	var notificationPreferences []notificationpreference.NotificationPreference
+	for _, notificationPreferenceData := range notificationPreferenceDtos {
+		notificationPreference, err := notificationpreference.NewBuilder().
+			Type(notificationPreferenceData.Type).
+			Enabled(notificationPreferenceData.Enabled).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for the notification preference %s of user %s: %s",
+				notificationPreferenceData.Type, userId, err.Error())
+			return nil, err
+		}
+
+		notificationPreferences = append(notificationPreferences, *notificationPreference)
+	}
+
+	return notificationPreferences, nil
+}
+
+func (instance Notification) SaveNotificationPreference(userId uuid.UUID,
+	notificationPreference notificationpreference.NotificationPreference) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	_, err = postgresConnection.Exec(queries.Notification().Insert().Preference(), userId,
+		notificationPreference.Type(), notificationPreference.Enabled())
+	if err != nil {
+		log.Errorf("Error saving the notification preference %s of user %s: %s", notificationPreference.Type(),
+			userId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (instance Notification) GenerateNotifications(window time.Duration) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	sqlResult, err := postgresConnection.Exec(queries.Notification().Insert().PropositionUpdates(),
+		fmt.Sprintf("%d seconds", int64(window.Seconds())))
+	if err != nil {
+		log.Error("Error generating the notifications of the tracked propositions: ", err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err == nil && rowsAffected > 0 {
+		log.Infof("%d notifications of tracked propositions generated", rowsAffected)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"fmt"
+	"github.com/devlucassantos/vnc-domains/src/domains/deputy"
+	"github.com/devlucassantos/vnc-domains/src/domains/party"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/adapters/databases/dto"
+	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/domains/monthlyactivity"
+	"vnc-api/core/domains/partystatistics"
+)
+
+type Party struct {
+	connectionManager connectionManagerInterface
+}
+
+func NewPartyRepository(connectionManager connectionManagerInterface) *Party {
+	return &Party{
+		connectionManager: connectionManager,
+	}
+}
+
+func (instance Party) GetPartyById(partyId uuid.UUID) (*party.Party, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var partyData dto.Party
+	err = postgresConnection.Get(&partyData, queries.Party().Select().ById(), partyId)
+	if err != nil {
+		log.Errorf("Error retrieving data for party %s from the database: %s", partyId, err.Error())
+		return nil, err
+	}
+
+	partyDomain, err := party.NewBuilder().
+		Id(partyData.Id).
+		Name(partyData.Name).
+		Acronym(partyData.Acronym).
+		ImageUrl(partyData.ImageUrl).
+		ImageDescription(fmt.Sprintf("Logo do %s (%s)", partyData.Name, partyData.Acronym)).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating data for party %s: %s", partyData.Id, err.Error())
+		return nil, err
+	}
+
+	return partyDomain, nil
+}
+
+func (instance Party) GetPartyDeputies(partyId uuid.UUID) ([]deputy.Deputy, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var deputiesData []dto.Deputy
+	err = postgresConnection.Select(&deputiesData, queries.Deputy().Select().ByPartyId(), partyId)
+	if err != nil {
+		log.Errorf("Error retrieving the deputies of party %s from the database: %s", partyId, err.Error())
+		return nil, err
+	}
+
+	var deputies []deputy.Deputy
+	for _, deputyData := range deputiesData {
+		deputyDomain, err := buildDeputy(deputyData)
+		if err != nil {
+			return nil, err
+		}
+		deputies = append(deputies, *deputyDomain)
+	}
+
+	return deputies, nil
+}
+
+func (instance Party) GetPartyStatistics(partyId uuid.UUID, activityStartDate time.Time) (
+	*partystatistics.PartyStatistics, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var statisticsData dto.PartyStatistics
+	err = postgresConnection.Get(&statisticsData, queries.Party().Select().Statistics(), partyId)
+	if err != nil {
+		log.Errorf("Error retrieving the statistics of party %s from the database: %s", partyId, err.Error())
+		return nil, err
+	}
+
+	var propositionTypesData []dto.PropositionTypeCount
+	err = postgresConnection.Select(&propositionTypesData, queries.Party().Select().PropositionTypes(), partyId)
+	if err != nil {
+		log.Errorf("Error retrieving the number of propositions by type of party %s from the database: %s",
+			partyId, err.Error())
+		return nil, err
+	}
+
+	propositionTypes, err := buildPropositionTypeCounts(propositionTypesData)
+	if err != nil {
+		return nil, err
+	}
+
+	var monthlyActivityData []dto.MonthlyActivity
+	err = postgresConnection.Select(&monthlyActivityData, queries.Party().Select().MonthlyActivity(), partyId,
+		activityStartDate)
+	if err != nil {
+		log.Errorf("Error retrieving the monthly activity of party %s from the database: %s", partyId, err.Error())
+		return nil, err
+	}
+
+	var monthlyActivity []monthlyactivity.MonthlyActivity
+	for _, activityData := range monthlyActivityData {
+		activity, err := monthlyactivity.NewBuilder().
+			Month(activityData.Month).
+			NumberOfPropositions(activityData.NumberOfPropositions).
+			NumberOfVotedPropositions(activityData.NumberOfVotedPropositions).
+			NumberOfApprovedPropositions(activityData.NumberOfApprovedPropositions).
+			NumberOfRejectedPropositions(activityData.NumberOfRejectedPropositions).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating the activity of party %s in %s: %s", partyId,
+				activityData.Month.Format("2006-01"), err.Error())
+			return nil, err
+		}
+		monthlyActivity = append(monthlyActivity, *activity)
+	}
+
+	partyStatistics, err := partystatistics.NewBuilder().
+		NumberOfDeputies(statisticsData.NumberOfDeputies).
+		NumberOfPropositions(statisticsData.NumberOfPropositions).
+		PropositionTypes(propositionTypes).
+		NumberOfVotedPropositions(statisticsData.NumberOfVotedPropositions).
+		NumberOfApprovedPropositions(statisticsData.NumberOfApprovedPropositions).
+		NumberOfRejectedPropositions(statisticsData.NumberOfRejectedPropositions).
+		MonthlyActivity(monthlyActivity).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating the statistics of party %s: %s", partyId, err.Error())
+		return nil, err
+	}
+
+	return partyStatistics, nil
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"database/sql"
 	"fmt"
 	"github.com/devlucassantos/vnc-domains/src/domains/article"
 	"github.com/devlucassantos/vnc-domains/src/domains/articlesituation"
@@ -15,6 +16,7 @@ import (
 	"github.com/labstack/gommon/log"
 	"vnc-api/adapters/databases/dto"
 	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/domains/timelineitem"
 )
 
 type Proposition struct {
@@ -345,15 +347,54 @@ func (instance Proposition) GetPropositionByArticleId(articleId uuid.UUID, userI
 		return nil, err
 	}
 
-	var userIdPointer *uuid.UUID
-	if userId != uuid.Nil {
-		userIdPointer = &userId
+	return propositionDomain, nil
+}
+
+func (instance Proposition) GetPropositionTimelineByArticleId(articleId uuid.UUID) ([]timelineitem.TimelineItem, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
 	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
 
-	_, err = postgresConnection.Exec(queries.ArticleView().Insert(), articleId, userIdPointer)
+	var timelineItemsData []dto.TimelineItem
+	err = postgresConnection.Select(&timelineItemsData, queries.Proposition().Select().TimelineByArticleId(),
+		articleId)
 	if err != nil {
-		log.Errorf("Error registering the view for article %s: %s", articleId, err.Error())
+		log.Errorf("Error retrieving the timeline of the proposition of article %s from the database: %s",
+			articleId, err.Error())
+		return nil, err
 	}
 
-	return propositionDomain, nil
+	// The submission of the proposition is always part of the timeline, so an empty timeline means that the
+	// proposition does not exist
+	if len(timelineItemsData) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	var timelineItems []timelineitem.TimelineItem
+	for _, timelineItemData := range timelineItemsData {
+		timelineItem, err := timelineitem.NewBuilder().
+			Type(timelineItemData.Type).
+			Date(timelineItemData.Date).
+			Title(timelineItemData.Title).
+			Description(timelineItemData.Description).
+			ArticleId(timelineItemData.ArticleId).
+			LegislativeBody(timelineItemData.LegislativeBody).
+			AgendaItemRegime(timelineItemData.AgendaItemRegime).
+			AgendaItemSituation(timelineItemData.AgendaItemSituation).
+			EventSituation(timelineItemData.EventSituation).
+			VotingResult(timelineItemData.VotingResult).
+			VotingIsApproved(timelineItemData.VotingIsApproved).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for the %s item (article %s) of the timeline of the proposition of "+
+				"article %s: %s", timelineItemData.Type, timelineItemData.ArticleId, articleId, err.Error())
+			return nil, err
+		}
+		timelineItems = append(timelineItems, *timelineItem)
+	}
+
+	return timelineItems, nil
 }
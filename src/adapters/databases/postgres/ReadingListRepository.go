@@ -0,0 +1,492 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/labstack/gommon/log"
+	"github.com/lib/pq"
+	"vnc-api/adapters/databases/dto"
+	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/domains/readinglist"
+	"vnc-api/core/domains/readinglistitem"
+	"vnc-api/core/filters"
+)
+
+type ReadingList struct {
+	connectionManager connectionManagerInterface
+}
+
+func NewReadingListRepository(connectionManager connectionManagerInterface) *ReadingList {
+	return &ReadingList{
+		connectionManager: connectionManager,
+	}
+}
+
+func (instance ReadingList) GetReadingListsByUserId(userId uuid.UUID) ([]readinglist.ReadingList, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var readingListDtos []dto.ReadingList
+	err = postgresConnection.Select(&readingListDtos, queries.ReadingList().Select().ByUserId(), userId)
+	if err != nil {
+		log.Errorf("Error retrieving the reading lists of user %s from the database: %s", userId, err.Error())
+		return nil, err
+	}
+
+	var readingLists []readinglist.ReadingList
+	for _, readingListData := range readingListDtos {
+		readingListDomain, err := buildReadingList(readingListData)
+		if err != nil {
+			return nil, err
+		}
+		readingLists = append(readingLists, *readingListDomain)
+	}
+
+	return readingLists, nil
+}
+
+func (instance ReadingList) GetReadingListById(readingListId uuid.UUID, userId uuid.UUID) (*readinglist.ReadingList,
+	error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var readingListData dto.ReadingList
+	err = postgresConnection.Get(&readingListData, queries.ReadingList().Select().ById(), readingListId, userId)
+	if err != nil {
+		log.Errorf("Error retrieving reading list %s of user %s from the database: %s", readingListId, userId,
+			err.Error())
+		return nil, err
+	}
+
+	return buildReadingList(readingListData)
+}
+
+func (instance ReadingList) GetReadingListByShareToken(shareToken string) (*readinglist.ReadingList, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var readingListData dto.ReadingList
+	err = postgresConnection.Get(&readingListData, queries.ReadingList().Select().ByShareToken(), shareToken)
+	if err != nil {
+		log.Error("Error retrieving the shared reading list from the database: ", err.Error())
+		return nil, err
+	}
+
+	return buildReadingList(readingListData)
+}
+
+func (instance ReadingList) GetReadingListItems(readingListId uuid.UUID, pagination filters.Pagination,
+	userId uuid.UUID) ([]readinglistitem.ReadingListItem, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var readingListItemDtos []dto.ReadingListItem
+	err = postgresConnection.Select(&readingListItemDtos, queries.ReadingListItem().Select().ByReadingListId(),
+		readingListId, userId, pagination.CalculateOffset(), pagination.GetItemsPerPage())
+	if err != nil {
+		log.Errorf("Error retrieving the articles of reading list %s from the database: %s", readingListId,
+			err.Error())
+		return nil, err
+	}
+
+	var userArticles []dto.UserArticle
+	for _, readingListItemData := range readingListItemDtos {
+		userArticles = append(userArticles, readingListItemData.UserArticle)
+	}
+
+	articles, err := getArticlesFromUserArticles(postgresConnection, userArticles)
+	if err != nil {
+		log.Errorf("Error retrieving data for the articles of reading list %s: %s", readingListId, err.Error())
+		return nil, err
+	}
+
+	var readingListItems []readinglistitem.ReadingListItem
+	for index, readingListItemData := range readingListItemDtos {
+		readingListItem, err := readinglistitem.NewBuilder().
+			Article(articles[index]).
+			Position(readingListItemData.Position).
+			Note(readingListItemData.Note).
+			CreatedAt(readingListItemData.CreatedAt).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for article %s of reading list %s: %s",
+				readingListItemData.UserArticle.Article.Id, readingListId, err.Error())
+			return nil, err
+		}
+
+		readingListItems = append(readingListItems, *readingListItem)
+	}
+
+	return readingListItems, nil
+}
+
+func (instance ReadingList) CreateReadingList(readingList readinglist.ReadingList, userId uuid.UUID) (uuid.UUID,
+	error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return uuid.Nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var readingListId uuid.UUID
+	err = postgresConnection.QueryRow(queries.ReadingList().Insert().ReadingList(), userId, readingList.Name(),
+		readingList.Description(), readingList.IsPublic(), getNullableShareToken(readingList)).Scan(&readingListId)
+	if err != nil {
+		log.Errorf("Error registering reading list %s for user %s: %s", readingList.Name(), userId, err.Error())
+		return uuid.Nil, err
+	}
+
+	return readingListId, nil
+}
+
+func (instance ReadingList) UpdateReadingList(readingList readinglist.ReadingList, userId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	sqlResult, err := postgresConnection.Exec(queries.ReadingList().Update().ReadingList(), readingList.Name(),
+		readingList.Description(), readingList.IsPublic(), getNullableShareToken(readingList), readingList.Id(),
+		userId)
+	if err != nil {
+		log.Errorf("Error updating reading list %s of user %s: %s", readingList.Id(), userId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by the update of reading list %s of user %s: %s",
+			readingList.Id(), userId, err.Error())
+		return err
+	} else if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (instance ReadingList) DeleteReadingList(readingListId uuid.UUID, userId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	sqlResult, err := postgresConnection.Exec(queries.ReadingList().Delete().ReadingList(), readingListId, userId)
+	if err != nil {
+		log.Errorf("Error deleting reading list %s of user %s: %s", readingListId, userId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by the deletion of reading list %s of user %s: %s",
+			readingListId, userId, err.Error())
+		return err
+	} else if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (instance ReadingList) SaveArticleToReadingList(readingListId uuid.UUID, userId uuid.UUID, articleId uuid.UUID,
+	note *string) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	transaction, err := postgresConnection.Beginx()
+	if err != nil {
+		log.Errorf("Error starting transaction to save article %s to reading list %s: %s", articleId,
+			readingListId, err.Error())
+		return err
+	}
+	defer instance.connectionManager.rollbackTransaction(transaction)
+
+	var readingListData dto.ReadingList
+	err = transaction.Get(&readingListData, queries.ReadingList().Select().ById(), readingListId, userId)
+	if err != nil {
+		log.Errorf("Error retrieving reading list %s of user %s from the database: %s", readingListId, userId,
+			err.Error())
+		return err
+	}
+
+	err = saveArticleToReadingList(transaction, readingListId, articleId, note)
+	if err != nil {
+		return err
+	}
+
+	if readingListData.IsDefault {
+		err = saveArticleToViewLater(transaction, userId, articleId, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = transaction.Commit()
+	if err != nil {
+		log.Errorf("Error confirming transaction to save article %s to reading list %s: %s", articleId,
+			readingListId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (instance ReadingList) RemoveArticleFromReadingList(readingListId uuid.UUID, userId uuid.UUID,
+	articleId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	transaction, err := postgresConnection.Beginx()
+	if err != nil {
+		log.Errorf("Error starting transaction to remove article %s from reading list %s: %s", articleId,
+			readingListId, err.Error())
+		return err
+	}
+	defer instance.connectionManager.rollbackTransaction(transaction)
+
+	var readingListData dto.ReadingList
+	err = transaction.Get(&readingListData, queries.ReadingList().Select().ById(), readingListId, userId)
+	if err != nil {
+		log.Errorf("Error retrieving reading list %s of user %s from the database: %s", readingListId, userId,
+			err.Error())
+		return err
+	}
+
+	sqlResult, err := transaction.Exec(queries.ReadingListItem().Delete().ReadingListItem(), readingListId,
+		articleId)
+	if err != nil {
+		log.Errorf("Error removing article %s from reading list %s: %s", articleId, readingListId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by the removal of article %s from reading list "+
+			"%s: %s", articleId, readingListId, err.Error())
+		return err
+	} else if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	if readingListData.IsDefault {
+		err = saveArticleToViewLater(transaction, userId, articleId, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = transaction.Commit()
+	if err != nil {
+		log.Errorf("Error confirming transaction to remove article %s from reading list %s: %s", articleId,
+			readingListId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (instance ReadingList) SortReadingListArticles(readingListId uuid.UUID, userId uuid.UUID,
+	articleIds []uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	transaction, err := postgresConnection.Beginx()
+	if err != nil {
+		log.Errorf("Error starting transaction to sort the articles of reading list %s: %s", readingListId,
+			err.Error())
+		return err
+	}
+	defer instance.connectionManager.rollbackTransaction(transaction)
+
+	var readingListData dto.ReadingList
+	err = transaction.Get(&readingListData, queries.ReadingList().Select().ById(), readingListId, userId)
+	if err != nil {
+		log.Errorf("Error retrieving reading list %s of user %s from the database: %s", readingListId, userId,
+			err.Error())
+		return err
+	}
+
+	err = lockReadingList(transaction, readingListId)
+	if err != nil {
+		return err
+	}
+
+	var sortedArticleIds []string
+	for _, articleId := range articleIds {
+		sortedArticleIds = append(sortedArticleIds, articleId.String())
+	}
+
+	_, err = transaction.Exec(queries.ReadingListItem().Update().Positions(), readingListId,
+		pq.Array(sortedArticleIds))
+	if err != nil {
+		log.Errorf("Error sorting the articles of reading list %s: %s", readingListId, err.Error())
+		return err
+	}
+
+	err = transaction.Commit()
+	if err != nil {
+		log.Errorf("Error confirming transaction to sort the articles of reading list %s: %s", readingListId,
+			err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func getDefaultReadingListId(transaction *sqlx.Tx, userId uuid.UUID) (uuid.UUID, error) {
+	var readingListId uuid.UUID
+	err := transaction.QueryRow(queries.ReadingList().Insert().DefaultReadingList(), userId,
+		readinglist.DefaultReadingListName).Scan(&readingListId)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = transaction.Get(&readingListId, queries.ReadingList().Select().DefaultReadingListIdByUserId(), userId)
+		if err != nil {
+			log.Errorf("Error retrieving the default reading list of user %s from the database: %s", userId,
+				err.Error())
+			return uuid.Nil, err
+		}
+
+		return readingListId, nil
+	} else if err != nil {
+		log.Errorf("Error registering the default reading list for user %s: %s", userId, err.Error())
+		return uuid.Nil, err
+	}
+
+	_, err = transaction.Exec(queries.ReadingListItem().Insert().ArticlesBookmarkedToViewLater(), readingListId,
+		userId)
+	if err != nil {
+		log.Errorf("Error adding the articles bookmarked for later viewing by user %s to the default reading "+
+			"list %s: %s", userId, readingListId, err.Error())
+		return uuid.Nil, err
+	}
+
+	return readingListId, nil
+}
+
+func lockReadingList(transaction *sqlx.Tx, readingListId uuid.UUID) error {
+	var lockedReadingListId uuid.UUID
+	err := transaction.Get(&lockedReadingListId, queries.ReadingList().Select().LockById(), readingListId)
+	if err != nil {
+		log.Errorf("Error locking reading list %s: %s", readingListId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func saveArticleToReadingList(transaction *sqlx.Tx, readingListId uuid.UUID, articleId uuid.UUID,
+	note *string) error {
+	err := lockReadingList(transaction, readingListId)
+	if err != nil {
+		return err
+	}
+
+	sqlResult, err := transaction.Exec(queries.ReadingListItem().Update().Note(), note, readingListId, articleId)
+	if err != nil {
+		log.Errorf("Error updating article %s in reading list %s: %s", articleId, readingListId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err == nil && rowsAffected == 0 {
+		_, err = transaction.Exec(queries.ReadingListItem().Insert().ReadingListItem(), readingListId, articleId,
+			note)
+		if err != nil {
+			log.Errorf("Error adding article %s to reading list %s: %s", articleId, readingListId, err.Error())
+			return err
+		}
+	} else if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by the update of article %s in reading list "+
+			"%s: %s", articleId, readingListId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func saveArticleToViewLater(transaction *sqlx.Tx, userId uuid.UUID, articleId uuid.UUID, viewLater bool) error {
+	sqlResult, err := transaction.Exec(queries.UserArticle().Update().ViewLater(), viewLater, userId, articleId)
+	if err != nil {
+		log.Errorf("Error updating view later bookmark for article %s with user %s: %s",
+			articleId, userId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err == nil && rowsAffected == 0 {
+		_, err = transaction.Exec(queries.UserArticle().Insert().ViewLater(), userId, articleId, viewLater)
+		if err != nil {
+			log.Errorf("Error inserting view later bookmark for article %s with user %s: %s",
+				articleId, userId, err.Error())
+			return err
+		}
+	} else if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by the view later bookmark update for article"+
+			" %s with user %s: %s", articleId, userId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func getNullableShareToken(readingList readinglist.ReadingList) sql.NullString {
+	return sql.NullString{
+		String: readingList.ShareToken(),
+		Valid:  readingList.IsPublic() && readingList.ShareToken() != "",
+	}
+}
+
+func buildReadingList(readingListData dto.ReadingList) (*readinglist.ReadingList, error) {
+	readingListDomain, err := readinglist.NewBuilder().
+		Id(readingListData.Id).
+		Name(readingListData.Name).
+		Description(readingListData.Description).
+		IsDefault(readingListData.IsDefault).
+		IsPublic(readingListData.IsPublic).
+		ShareToken(readingListData.ShareToken.String).
+		NumberOfArticles(readingListData.NumberOfArticles).
+		CreatedAt(readingListData.CreatedAt).
+		UpdatedAt(readingListData.UpdatedAt).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating data for reading list %s: %s", readingListData.Id, err.Error())
+		return nil, err
+	}
+
+	return readingListDomain, nil
+}
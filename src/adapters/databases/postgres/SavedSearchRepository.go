@@ -0,0 +1,394 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/devlucassantos/vnc-domains/src/domains/user"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/adapters/databases/dto"
+	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/domains/savedsearch"
+	"vnc-api/core/filters"
+)
+
+type SavedSearch struct {
+	connectionManager connectionManagerInterface
+}
+
+func NewSavedSearchRepository(connectionManager connectionManagerInterface) *SavedSearch {
+	return &SavedSearch{
+		connectionManager: connectionManager,
+	}
+}
+
+func (instance SavedSearch) GetSavedSearchesByUserId(userId uuid.UUID) ([]savedsearch.SavedSearch, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var savedSearchDtos []dto.SavedSearch
+	err = postgresConnection.Select(&savedSearchDtos, queries.SavedSearch().Select().ByUserId(), userId)
+	if err != nil {
+		log.Errorf("Error retrieving the saved searches of user %s from the database: %s", userId, err.Error())
+		return nil, err
+	}
+
+	var savedSearches []savedsearch.SavedSearch
+	for _, savedSearchData := range savedSearchDtos {
+		savedSearchDomain, err := buildSavedSearch(savedSearchData)
+		if err != nil {
+			return nil, err
+		}
+		savedSearches = append(savedSearches, *savedSearchDomain)
+	}
+
+	return savedSearches, nil
+}
+
+func (instance SavedSearch) GetSavedSearchById(savedSearchId uuid.UUID, userId uuid.UUID) (*savedsearch.SavedSearch,
+	error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var savedSearchData dto.SavedSearch
+	err = postgresConnection.Get(&savedSearchData, queries.SavedSearch().Select().ById(), savedSearchId, userId)
+	if err != nil {
+		log.Errorf("Error retrieving saved search %s of user %s from the database: %s", savedSearchId, userId,
+			err.Error())
+		return nil, err
+	}
+
+	return buildSavedSearch(savedSearchData)
+}
+
+func (instance SavedSearch) GetNumberOfSavedSearchesByUserId(userId uuid.UUID) (int, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return 0, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var numberOfSavedSearches int
+	err = postgresConnection.Get(&numberOfSavedSearches, queries.SavedSearch().Select().NumberOfSavedSearchesByUserId(),
+		userId)
+	if err != nil {
+		log.Errorf("Error retrieving the number of saved searches of user %s from the database: %s", userId,
+			err.Error())
+		return 0, err
+	}
+
+	return numberOfSavedSearches, nil
+}
+
+func (instance SavedSearch) CreateSavedSearch(savedSearch savedsearch.SavedSearch, userId uuid.UUID) (uuid.UUID,
+	error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return uuid.Nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	savedSearchFilter, err := getSavedSearchFilterData(savedSearch.Filter())
+	if err != nil {
+		log.Errorf("Error converting the filter of saved search %s of user %s: %s", savedSearch.Name(), userId,
+			err.Error())
+		return uuid.Nil, err
+	}
+
+	var savedSearchId uuid.UUID
+	err = postgresConnection.QueryRow(queries.SavedSearch().Insert().SavedSearch(), userId, savedSearch.Name(),
+		savedSearchFilter, savedSearch.NotifyByEmail()).Scan(&savedSearchId)
+	if err != nil {
+		log.Errorf("Error registering saved search %s for user %s: %s", savedSearch.Name(), userId, err.Error())
+		return uuid.Nil, err
+	}
+
+	return savedSearchId, nil
+}
+
+func (instance SavedSearch) UpdateSavedSearch(savedSearch savedsearch.SavedSearch, userId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	savedSearchFilter, err := getSavedSearchFilterData(savedSearch.Filter())
+	if err != nil {
+		log.Errorf("Error converting the filter of saved search %s of user %s: %s", savedSearch.Id(), userId,
+			err.Error())
+		return err
+	}
+
+	sqlResult, err := postgresConnection.Exec(queries.SavedSearch().Update().SavedSearch(), savedSearch.Name(),
+		savedSearchFilter, savedSearch.NotifyByEmail(), savedSearch.Id(), userId)
+	if err != nil {
+		log.Errorf("Error updating saved search %s of user %s: %s", savedSearch.Id(), userId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by the update of saved search %s of user %s: %s",
+			savedSearch.Id(), userId, err.Error())
+		return err
+	} else if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (instance SavedSearch) DeleteSavedSearch(savedSearchId uuid.UUID, userId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	sqlResult, err := postgresConnection.Exec(queries.SavedSearch().Delete().SavedSearch(), savedSearchId, userId)
+	if err != nil {
+		log.Errorf("Error deleting saved search %s of user %s: %s", savedSearchId, userId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by the deletion of saved search %s of user %s: %s",
+			savedSearchId, userId, err.Error())
+		return err
+	} else if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (instance SavedSearch) DisableSavedSearchEmailNotifications(savedSearchId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	sqlResult, err := postgresConnection.Exec(queries.SavedSearch().Update().DisableEmailNotifications(),
+		savedSearchId)
+	if err != nil {
+		log.Errorf("Error disabling the email notifications of saved search %s: %s", savedSearchId, err.Error())
+		return err
+	}
+
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		log.Errorf("Error retrieving the number of rows affected by disabling the email notifications of saved "+
+			"search %s: %s", savedSearchId, err.Error())
+		return err
+	} else if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (instance SavedSearch) MarkSavedSearchAsOpened(savedSearchId uuid.UUID, userId uuid.UUID) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	_, err = postgresConnection.Exec(queries.SavedSearch().Update().LastOpenedAt(), savedSearchId, userId)
+	if err != nil {
+		log.Errorf("Error registering the opening of saved search %s of user %s: %s", savedSearchId, userId,
+			err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (instance SavedSearch) ClaimPendingSavedSearches(numberOfSavedSearches int, checkInterval time.Duration) (
+	[]savedsearch.SavedSearch, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var savedSearchDtos []dto.SavedSearch
+	err = postgresConnection.Select(&savedSearchDtos, queries.SavedSearch().Update().PendingSavedSearches(),
+		numberOfSavedSearches, fmt.Sprintf("%d seconds", int64(checkInterval.Seconds())))
+	if err != nil {
+		log.Error("Error claiming the pending saved searches in the database: ", err.Error())
+		return nil, err
+	}
+
+	var savedSearches []savedsearch.SavedSearch
+	for _, savedSearchData := range savedSearchDtos {
+		savedSearchDomain, err := buildSavedSearch(savedSearchData)
+		if err != nil {
+			return nil, err
+		}
+		savedSearches = append(savedSearches, *savedSearchDomain)
+	}
+
+	return savedSearches, nil
+}
+
+func (instance SavedSearch) SaveNumberOfNewArticles(savedSearch savedsearch.SavedSearch,
+	numberOfNewArticles int) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	// Searches opened while the check was running keep the count reset by the opening
+	_, err = postgresConnection.Exec(queries.SavedSearch().Update().NumberOfNewArticles(), numberOfNewArticles,
+		savedSearch.Id(), savedSearch.LastOpenedAt())
+	if err != nil {
+		log.Errorf("Error saving the number of new articles of saved search %s: %s", savedSearch.Id(), err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (instance SavedSearch) RestoreSavedSearchLastCheckedAt(savedSearchId uuid.UUID, lastCheckedAt time.Time) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	_, err = postgresConnection.Exec(queries.SavedSearch().Update().LastCheckedAt(), lastCheckedAt, savedSearchId)
+	if err != nil {
+		log.Errorf("Error restoring the date saved search %s was last checked: %s", savedSearchId, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func buildSavedSearch(savedSearchData dto.SavedSearch) (*savedsearch.SavedSearch, error) {
+	userDomain, err := user.NewBuilder().
+		Id(savedSearchData.User.Id).
+		FirstName(savedSearchData.User.FirstName).
+		LastName(savedSearchData.User.LastName).
+		Email(savedSearchData.User.Email).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating data for user %s of saved search %s: %s", savedSearchData.User.Id,
+			savedSearchData.Id, err.Error())
+		return nil, err
+	}
+
+	var savedSearchFilter dto.SavedSearchFilter
+	err = json.Unmarshal(savedSearchData.Filter, &savedSearchFilter)
+	if err != nil {
+		log.Errorf("Error reading the filter of saved search %s: %s", savedSearchData.Id, err.Error())
+		return nil, err
+	}
+
+	savedSearch, err := savedsearch.NewBuilder().
+		Id(savedSearchData.Id).
+		User(*userDomain).
+		Name(savedSearchData.Name).
+		Filter(getSavedSearchFilter(savedSearchFilter)).
+		NotifyByEmail(savedSearchData.NotifyByEmail).
+		NumberOfNewArticles(savedSearchData.NumberOfNewArticles).
+		LastOpenedAt(savedSearchData.LastOpenedAt).
+		LastCheckedAt(savedSearchData.LastCheckedAt).
+		CheckedAt(savedSearchData.CheckedAt).
+		CreatedAt(savedSearchData.CreatedAt).
+		UpdatedAt(savedSearchData.UpdatedAt).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating data for saved search %s: %s", savedSearchData.Id, err.Error())
+		return nil, err
+	}
+
+	return savedSearch, nil
+}
+
+func getSavedSearchFilterData(filter filters.Article) (string, error) {
+	savedSearchFilter, err := json.Marshal(dto.SavedSearchFilter{
+		TypeId:                       filter.TypeId,
+		SpecificTypeId:               filter.SpecificTypeId,
+		Content:                      filter.Content,
+		StartDate:                    filter.StartDate,
+		EndDate:                      filter.EndDate,
+		ExcludeRead:                  filter.ExcludeRead,
+		OnlyFollowed:                 filter.OnlyFollowed,
+		PropositionDeputyId:          filter.Proposition.DeputyId,
+		PropositionPartyId:           filter.Proposition.PartyId,
+		PropositionExternalAuthorId:  filter.Proposition.ExternalAuthorId,
+		PropositionFederatedUnits:    filter.Proposition.FederatedUnits,
+		VotingStartDate:              filter.Voting.StartDate,
+		VotingEndDate:                filter.Voting.EndDate,
+		VotingResult:                 filter.Voting.Result,
+		VotingLegislativeBodyId:      filter.Voting.LegislativeBodyId,
+		EventStartDate:               filter.Event.StartDate,
+		EventEndDate:                 filter.Event.EndDate,
+		EventSituationId:             filter.Event.SituationId,
+		EventLegislativeBodyId:       filter.Event.LegislativeBodyId,
+		EventRapporteurId:            filter.Event.RapporteurId,
+		EventRemoveEventsInTheFuture: filter.Event.RemoveEventsInTheFuture,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(savedSearchFilter), nil
+}
+
+func getSavedSearchFilter(savedSearchFilter dto.SavedSearchFilter) filters.Article {
+	return filters.Article{
+		TypeId:         savedSearchFilter.TypeId,
+		SpecificTypeId: savedSearchFilter.SpecificTypeId,
+		Content:        savedSearchFilter.Content,
+		StartDate:      savedSearchFilter.StartDate,
+		EndDate:        savedSearchFilter.EndDate,
+		ExcludeRead:    savedSearchFilter.ExcludeRead,
+		OnlyFollowed:   savedSearchFilter.OnlyFollowed,
+		Proposition: filters.Proposition{
+			DeputyId:         savedSearchFilter.PropositionDeputyId,
+			PartyId:          savedSearchFilter.PropositionPartyId,
+			ExternalAuthorId: savedSearchFilter.PropositionExternalAuthorId,
+			FederatedUnits:   savedSearchFilter.PropositionFederatedUnits,
+		},
+		Voting: filters.Voting{
+			StartDate:         savedSearchFilter.VotingStartDate,
+			EndDate:           savedSearchFilter.VotingEndDate,
+			Result:            savedSearchFilter.VotingResult,
+			LegislativeBodyId: savedSearchFilter.VotingLegislativeBodyId,
+		},
+		Event: filters.Event{
+			StartDate:               savedSearchFilter.EventStartDate,
+			EndDate:                 savedSearchFilter.EventEndDate,
+			SituationId:             savedSearchFilter.EventSituationId,
+			LegislativeBodyId:       savedSearchFilter.EventLegislativeBodyId,
+			RapporteurId:            savedSearchFilter.EventRapporteurId,
+			RemoveEventsInTheFuture: savedSearchFilter.EventRemoveEventsInTheFuture,
+		},
+	}
+}
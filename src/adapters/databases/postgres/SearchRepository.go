@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"github.com/labstack/gommon/log"
+	"github.com/lib/pq"
+	"strings"
+	"vnc-api/adapters/databases/dto"
+	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/domains/suggestion"
+)
+
+type Search struct {
+	connectionManager connectionManagerInterface
+}
+
+func NewSearchRepository(connectionManager connectionManagerInterface) *Search {
+	return &Search{
+		connectionManager: connectionManager,
+	}
+}
+
+func (instance Search) GetSuggestions(text string, numberOfSuggestionsPerType int) ([]suggestion.Suggestion, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var suggestionsData []dto.Suggestion
+	err = postgresConnection.Select(&suggestionsData, queries.Suggestion().Select().ByText(), text,
+		numberOfSuggestionsPerType, escapeLikePattern(text))
+	if err != nil {
+		log.Error("Error retrieving search suggestions from the database: ", err.Error())
+		return nil, err
+	}
+
+	var suggestions []suggestion.Suggestion
+	for _, suggestionData := range suggestionsData {
+		suggestionDomain, err := suggestion.NewBuilder().
+			Id(suggestionData.Id).
+			Type(suggestionData.Type).
+			Label(suggestionData.Label).
+			Description(suggestionData.Description).
+			Score(suggestionData.Score).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for suggestion %s: %s", suggestionData.Id, err.Error())
+			return nil, err
+		}
+		suggestions = append(suggestions, *suggestionDomain)
+	}
+
+	return suggestions, nil
+}
+
+func (instance Search) GetSpellingCorrections(words []string, numberOfCorrectionsPerWord int) (map[string][]string,
+	error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var spellingCorrectionsData []dto.SpellingCorrection
+	err = postgresConnection.Select(&spellingCorrectionsData, queries.Suggestion().Select().SpellingCorrectionsByWords(),
+		pq.Array(words), numberOfCorrectionsPerWord)
+	if err != nil {
+		log.Error("Error retrieving spelling corrections from the database: ", err.Error())
+		return nil, err
+	}
+
+	spellingCorrections := map[string][]string{}
+	for _, spellingCorrectionData := range spellingCorrectionsData {
+		spellingCorrections[spellingCorrectionData.Word] = append(spellingCorrections[spellingCorrectionData.Word],
+			spellingCorrectionData.Term)
+	}
+
+	return spellingCorrections, nil
+}
+
+func (instance Search) RefreshVocabulary() error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	transaction, err := postgresConnection.Beginx()
+	if err != nil {
+		log.Error("Error starting transaction to refresh the search vocabulary: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.rollbackTransaction(transaction)
+
+	var isRefreshLocked bool
+	err = transaction.Get(&isRefreshLocked, queries.Suggestion().Select().VocabularyRefreshLock())
+	if err != nil {
+		log.Error("Error locking the refresh of the search vocabulary: ", err.Error())
+		return err
+	}
+
+	// Another instance of the API is refreshing the vocabulary
+	if !isRefreshLocked {
+		return nil
+	}
+
+	_, err = transaction.Exec(queries.Suggestion().Update().Vocabulary())
+	if err != nil {
+		log.Error("Error refreshing the search vocabulary in the database: ", err.Error())
+		return err
+	}
+
+	err = transaction.Commit()
+	if err != nil {
+		log.Error("Error confirming transaction to refresh the search vocabulary: ", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func escapeLikePattern(text string) string {
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(text)
+}
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/legislativebody"
+	"github.com/devlucassantos/vnc-domains/src/domains/legislativebodytype"
+	"github.com/devlucassantos/vnc-domains/src/domains/propositiontype"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"vnc-api/adapters/databases/dto"
+	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/domains/federatedunitactivity"
+	"vnc-api/core/domains/votingoutcome"
+	"vnc-api/core/filters"
+)
+
+type Statistics struct {
+	connectionManager connectionManagerInterface
+}
+
+func NewStatisticsRepository(connectionManager connectionManagerInterface) *Statistics {
+	return &Statistics{
+		connectionManager: connectionManager,
+	}
+}
+
+func (instance Statistics) GetVotingOutcomes(filter filters.Voting) ([]votingoutcome.VotingOutcome, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var votingOutcomesData []dto.VotingOutcome
+	err = postgresConnection.Select(&votingOutcomesData, queries.Statistics().Select().VotingOutcomes(),
+		filter.StartDate, filter.EndDate, filter.Result, filter.LegislativeBodyId)
+	if err != nil {
+		log.Error("Error retrieving the voting outcomes from the database: ", err.Error())
+		return nil, err
+	}
+
+	var votingOutcomes []votingoutcome.VotingOutcome
+	for _, votingOutcomeData := range votingOutcomesData {
+		legislativeBodyType, err := legislativebodytype.NewBuilder().
+			Id(votingOutcomeData.LegislativeBody.LegislativeBodyType.Id).
+			Description(votingOutcomeData.LegislativeBody.LegislativeBodyType.Description).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for the legislative body type %s of legislative body %s: %s",
+				votingOutcomeData.LegislativeBody.LegislativeBodyType.Id, votingOutcomeData.LegislativeBody.Id,
+				err.Error())
+			return nil, err
+		}
+
+		legislativeBody, err := legislativebody.NewBuilder().
+			Id(votingOutcomeData.LegislativeBody.Id).
+			Name(votingOutcomeData.LegislativeBody.Name).
+			Acronym(votingOutcomeData.LegislativeBody.Acronym).
+			Type(*legislativeBodyType).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for legislative body %s: %s", votingOutcomeData.LegislativeBody.Id,
+				err.Error())
+			return nil, err
+		}
+
+		votingOutcomeBuilder := votingoutcome.NewBuilder()
+
+		// Votes without a main proposition are grouped without a proposition type
+		if votingOutcomeData.PropositionType.Id != uuid.Nil {
+			propositionType, err := propositiontype.NewBuilder().
+				Id(votingOutcomeData.PropositionType.Id).
+				Description(votingOutcomeData.PropositionType.Description).
+				Color(votingOutcomeData.PropositionType.Color).
+				Build()
+			if err != nil {
+				log.Errorf("Error validating data for proposition type %s: %s", votingOutcomeData.PropositionType.Id,
+					err.Error())
+				return nil, err
+			}
+			votingOutcomeBuilder.PropositionType(*propositionType)
+		}
+
+		votingOutcome, err := votingOutcomeBuilder.
+			LegislativeBody(*legislativeBody).
+			Month(votingOutcomeData.Month).
+			NumberOfApprovedVotes(votingOutcomeData.NumberOfApprovedVotes).
+			NumberOfRejectedVotes(votingOutcomeData.NumberOfRejectedVotes).
+			NumberOfUndeterminedVotes(votingOutcomeData.NumberOfUndeterminedVotes).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating the voting outcomes of legislative body %s in %s: %s",
+				votingOutcomeData.LegislativeBody.Id, votingOutcomeData.Month.Format("2006-01"), err.Error())
+			return nil, err
+		}
+		votingOutcomes = append(votingOutcomes, *votingOutcome)
+	}
+
+	return votingOutcomes, nil
+}
+
+func (instance Statistics) GetFederatedUnitActivities(filter filters.FederatedUnitActivity) (
+	[]federatedunitactivity.FederatedUnitActivity, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var federatedUnitActivitiesData []dto.FederatedUnitActivity
+	err = postgresConnection.Select(&federatedUnitActivitiesData,
+		queries.Statistics().Select().FederatedUnitActivities(), filter.StartDate, filter.EndDate,
+		filter.PropositionTypeId)
+	if err != nil {
+		log.Error("Error retrieving the federated unit activities from the database: ", err.Error())
+		return nil, err
+	}
+
+	var federatedUnitActivities []federatedunitactivity.FederatedUnitActivity
+	for _, federatedUnitActivityData := range federatedUnitActivitiesData {
+		federatedUnitActivity, err := federatedunitactivity.NewBuilder().
+			FederatedUnit(federatedUnitActivityData.FederatedUnit).
+			NumberOfPropositions(federatedUnitActivityData.NumberOfPropositions).
+			NumberOfDeputies(federatedUnitActivityData.NumberOfDeputies).
+			Build()
+		if err != nil {
+			log.Warnf("The activity of federated unit %s is invalid and will be ignored: %s",
+				federatedUnitActivityData.FederatedUnit, err.Error())
+			continue
+		}
+		federatedUnitActivities = append(federatedUnitActivities, *federatedUnitActivity)
+	}
+
+	return federatedUnitActivities, nil
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"database/sql"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/filters"
+)
+
+type TrendingScore struct {
+	connectionManager connectionManagerInterface
+}
+
+func NewTrendingScoreRepository(connectionManager connectionManagerInterface) *TrendingScore {
+	return &TrendingScore{
+		connectionManager: connectionManager,
+	}
+}
+
+func (instance TrendingScore) GetTrendingScoresAge(window string) (*time.Duration, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var ageInSeconds sql.NullFloat64
+	err = postgresConnection.Get(&ageInSeconds, queries.TrendingScore().Select().AgeInSecondsByWindow(), window)
+	if err != nil {
+		log.Errorf("Error retrieving the age of the trending scores for the window %s from the database: %s",
+			window, err.Error())
+		return nil, err
+	}
+
+	if !ageInSeconds.Valid {
+		return nil, nil
+	}
+
+	age := time.Duration(ageInSeconds.Float64 * float64(time.Second))
+	return &age, nil
+}
+
+func (instance TrendingScore) RefreshTrendingScores(trendingFilter filters.Trending,
+	minimumRefreshInterval time.Duration) error {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	transaction, err := postgresConnection.Beginx()
+	if err != nil {
+		log.Errorf("Error starting transaction to refresh the trending scores for the window %s: %s",
+			trendingFilter.GetWindow(), err.Error())
+		return err
+	}
+	defer instance.connectionManager.rollbackTransaction(transaction)
+
+	var isRefreshLocked bool
+	err = transaction.Get(&isRefreshLocked, queries.TrendingScore().Select().RefreshLockByWindow(),
+		trendingFilter.GetWindow())
+	if err != nil {
+		log.Errorf("Error locking the refresh of the trending scores for the window %s: %s",
+			trendingFilter.GetWindow(), err.Error())
+		return err
+	}
+
+	// Another instance of the API is refreshing the scores of the window
+	if !isRefreshLocked {
+		return nil
+	}
+
+	var ageInSeconds sql.NullFloat64
+	err = transaction.Get(&ageInSeconds, queries.TrendingScore().Select().AgeInSecondsByWindow(),
+		trendingFilter.GetWindow())
+	if err != nil {
+		log.Errorf("Error retrieving the age of the trending scores for the window %s from the database: %s",
+			trendingFilter.GetWindow(), err.Error())
+		return err
+	}
+
+	// Another instance of the API has refreshed the scores of the window recently
+	if ageInSeconds.Valid && time.Duration(ageInSeconds.Float64*float64(time.Second)) < minimumRefreshInterval {
+		return nil
+	}
+
+	_, err = transaction.Exec(queries.TrendingScore().Insert().ByWindow(), trendingFilter.GetWindow(),
+		trendingFilter.GetWindowInterval(), trendingFilter.ViewsWeight, trendingFilter.AverageRatingWeight,
+		trendingFilter.NumberOfRatingsWeight)
+	if err != nil {
+		log.Errorf("Error registering the trending scores for the window %s in the database: %s",
+			trendingFilter.GetWindow(), err.Error())
+		return err
+	}
+
+	_, err = transaction.Exec(queries.TrendingScore().Delete().OutdatedByWindow(), trendingFilter.GetWindow())
+	if err != nil {
+		log.Errorf("Error deleting the outdated trending scores for the window %s from the database: %s",
+			trendingFilter.GetWindow(), err.Error())
+		return err
+	}
+
+	err = transaction.Commit()
+	if err != nil {
+		log.Errorf("Error confirming transaction to refresh the trending scores for the window %s: %s",
+			trendingFilter.GetWindow(), err.Error())
+		return err
+	}
+
+	return nil
+}
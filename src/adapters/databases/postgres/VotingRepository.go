@@ -9,12 +9,15 @@ import (
 	"github.com/devlucassantos/vnc-domains/src/domains/articletype"
 	"github.com/devlucassantos/vnc-domains/src/domains/legislativebody"
 	"github.com/devlucassantos/vnc-domains/src/domains/legislativebodytype"
+	"github.com/devlucassantos/vnc-domains/src/domains/party"
 	"github.com/devlucassantos/vnc-domains/src/domains/proposition"
 	"github.com/devlucassantos/vnc-domains/src/domains/voting"
 	"github.com/google/uuid"
 	"github.com/labstack/gommon/log"
 	"vnc-api/adapters/databases/dto"
 	"vnc-api/adapters/databases/postgres/queries"
+	"vnc-api/core/domains/deputyvote"
+	"vnc-api/core/domains/partyorientation"
 )
 
 type Voting struct {
@@ -491,15 +494,99 @@ func (instance Voting) GetVotingByArticleId(articleId uuid.UUID, userId uuid.UUI
 		return nil, err
 	}
 
-	var userIdPointer *uuid.UUID
-	if userId != uuid.Nil {
-		userIdPointer = &userId
+	return votingDomain, nil
+}
+
+func (instance Voting) GetDeputyVotesByVotingId(votingId uuid.UUID) ([]deputyvote.DeputyVote, error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
 	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
 
-	_, err = postgresConnection.Exec(queries.ArticleView().Insert(), articleId, userIdPointer)
+	var deputyVotesData []dto.DeputyVote
+	err = postgresConnection.Select(&deputyVotesData, queries.Voting().Select().DeputyVotes(), votingId)
 	if err != nil {
-		log.Errorf("Error registering the view for article %s: %s", articleId, err.Error())
+		log.Errorf("Error retrieving the deputy votes of voting %s from the database: %s", votingId, err.Error())
+		return nil, err
 	}
 
-	return votingDomain, nil
+	var deputyVotes []deputyvote.DeputyVote
+	for _, deputyVoteData := range deputyVotesData {
+		if deputyVoteData.Deputy.Id == uuid.Nil || deputyVoteData.Party.Id == uuid.Nil {
+			log.Warnf("The deputy or the party of vote %s of voting %s could not be found, the vote will be ignored",
+				deputyVoteData.Id, votingId)
+			continue
+		}
+
+		deputyDomain, err := buildDeputy(*deputyVoteData.Deputy)
+		if err != nil {
+			log.Errorf("Error building deputy %s of vote %s of voting %s: %s", deputyVoteData.Deputy.Id,
+				deputyVoteData.Id, votingId, err.Error())
+			return nil, err
+		}
+
+		deputyVote, err := deputyvote.NewBuilder().
+			Id(deputyVoteData.Id).
+			Deputy(*deputyDomain).
+			Vote(deputyVoteData.Vote).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for vote %s of voting %s: %s", deputyVoteData.Id, votingId,
+				err.Error())
+			return nil, err
+		}
+		deputyVotes = append(deputyVotes, *deputyVote)
+	}
+
+	return deputyVotes, nil
+}
+
+func (instance Voting) GetPartyOrientationsByVotingId(votingId uuid.UUID) ([]partyorientation.PartyOrientation,
+	error) {
+	postgresConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Postgres database: ", err.Error())
+		return nil, err
+	}
+	defer instance.connectionManager.closeConnection(postgresConnection)
+
+	var partyOrientationsData []dto.PartyOrientation
+	err = postgresConnection.Select(&partyOrientationsData, queries.Voting().Select().PartyOrientations(), votingId)
+	if err != nil {
+		log.Errorf("Error retrieving the party orientations of voting %s from the database: %s", votingId,
+			err.Error())
+		return nil, err
+	}
+
+	var partyOrientations []partyorientation.PartyOrientation
+	for _, partyOrientationData := range partyOrientationsData {
+		partyDomain, err := party.NewBuilder().
+			Id(partyOrientationData.Party.Id).
+			Name(partyOrientationData.Party.Name).
+			Acronym(partyOrientationData.Party.Acronym).
+			ImageUrl(partyOrientationData.Party.ImageUrl).
+			ImageDescription(fmt.Sprintf("Logo do %s (%s)", partyOrientationData.Party.Name,
+				partyOrientationData.Party.Acronym)).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for party %s of voting %s: %s", partyOrientationData.Party.Id,
+				votingId, err.Error())
+			return nil, err
+		}
+
+		partyOrientation, err := partyorientation.NewBuilder().
+			Party(*partyDomain).
+			Orientation(partyOrientationData.Orientation).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for the orientation of party %s on voting %s: %s",
+				partyOrientationData.Party.Id, votingId, err.Error())
+			return nil, err
+		}
+		partyOrientations = append(partyOrientations, *partyOrientation)
+	}
+
+	return partyOrientations, nil
 }
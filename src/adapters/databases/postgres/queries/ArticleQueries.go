@@ -76,8 +76,14 @@ func (articleSelectSqlManager) In(numberOfArticles int) string {
 			ORDER BY article.reference_date_time DESC`, strings.Join(parameters, ","))
 }
 
+func (articleSelectSqlManager) ExistingIds() string {
+	return `SELECT article.id
+			FROM article
+			WHERE article.active = true AND article.id = ANY($1::UUID[])`
+}
+
 func (articleSelectSqlManager) TotalNumberOfArticles() string {
-	return `SELECT COUNT(DISTINCT article.id)
+	return fmt.Sprintf(`SELECT COUNT(DISTINCT article.id)
 			FROM article
 				INNER JOIN article_type ON article_type.id = article.article_type_id
 				LEFT JOIN proposition ON proposition.article_id = article.id
@@ -99,11 +105,14 @@ func (articleSelectSqlManager) TotalNumberOfArticles() string {
 				('Boletim do dia ' || TO_CHAR(newsletter.reference_date, 'DD/MM/YYYY') ILIKE $3 OR
 				newsletter.description ILIKE $3)) AND
 				DATE_TRUNC('day', article.created_at) >= DATE_TRUNC('day', COALESCE($4, article.created_at)) AND
-				DATE_TRUNC('day', article.created_at) <= DATE_TRUNC('day', COALESCE($5, article.created_at))`
+				DATE_TRUNC('day', article.created_at) <= DATE_TRUNC('day', COALESCE($5, article.created_at)) AND
+				($6::uuid IS NULL OR NOT EXISTS (SELECT 1 FROM article_view
+					WHERE article_view.article_id = article.id AND article_view.user_id = $6::uuid)) AND
+				%s AND %s`, getFollowedArticlesCondition(7), getCreationPeriodCondition(8))
 }
 
 func (articleSelectSqlManager) TotalNumberOfPropositions() string {
-	return `SELECT COUNT(DISTINCT article.id)
+	return fmt.Sprintf(`SELECT COUNT(DISTINCT article.id)
 			FROM article
 				INNER JOIN article_type ON article_type.id = article.article_type_id
 				INNER JOIN proposition prop ON prop.article_id = article.id
@@ -129,11 +138,17 @@ func (articleSelectSqlManager) TotalNumberOfPropositions() string {
 				WHERE pa.party_id = $7::uuid AND p.article_id = article.id)) AND
 				($8::uuid IS NULL OR EXISTS (SELECT 1 FROM proposition_author pa
 					INNER JOIN proposition p ON p.id = pa.proposition_id
-				WHERE pa.external_author_id = $8::uuid AND p.article_id = article.id))`
+				WHERE pa.external_author_id = $8::uuid AND p.article_id = article.id)) AND
+				($9::text[] IS NULL OR EXISTS (SELECT 1 FROM proposition_author pa
+					INNER JOIN proposition p ON p.id = pa.proposition_id
+				WHERE pa.federated_unit = ANY($9::text[]) AND p.article_id = article.id)) AND
+				($10::uuid IS NULL OR NOT EXISTS (SELECT 1 FROM article_view
+					WHERE article_view.article_id = article.id AND article_view.user_id = $10::uuid)) AND
+				%s AND %s`, getFollowedArticlesCondition(11), getCreationPeriodCondition(12))
 }
 
 func (articleSelectSqlManager) TotalNumberOfVotes() string {
-	return `SELECT COUNT(DISTINCT article.id)
+	return fmt.Sprintf(`SELECT COUNT(DISTINCT article.id)
 			FROM article
 				INNER JOIN article_type ON article_type.id = article.article_type_id
 				INNER JOIN voting ON voting.article_id = article.id
@@ -150,11 +165,14 @@ func (articleSelectSqlManager) TotalNumberOfVotes() string {
 			    (CASE WHEN $8 = 'approved' THEN voting.is_approved = true
 			        WHEN $8 = 'rejected' THEN voting.is_approved = false
     				WHEN $8 = 'undetermined' THEN voting.is_approved IS NULL ELSE TRUE END) AND
-				voting.legislative_body_id = COALESCE($9, voting.legislative_body_id)`
+				voting.legislative_body_id = COALESCE($9, voting.legislative_body_id) AND
+				($10::uuid IS NULL OR NOT EXISTS (SELECT 1 FROM article_view
+					WHERE article_view.article_id = article.id AND article_view.user_id = $10::uuid)) AND
+				%s AND %s`, getFollowedArticlesCondition(11), getCreationPeriodCondition(12))
 }
 
 func (articleSelectSqlManager) TotalNumberOfEvents() string {
-	return `SELECT COUNT(DISTINCT article.id)
+	return fmt.Sprintf(`SELECT COUNT(DISTINCT article.id)
 			FROM article
 				INNER JOIN article_type ON article_type.id = article.article_type_id
 				INNER JOIN event ON event.article_id = article.id
@@ -175,11 +193,14 @@ func (articleSelectSqlManager) TotalNumberOfEvents() string {
 				DATE_TRUNC('day', event.starts_at) <= DATE_TRUNC('day', COALESCE($7, event.starts_at)) AND
 				event_situation.id = COALESCE($8, event_situation.id) AND
 				event_legislative_body.legislative_body_id = COALESCE($9, event_legislative_body.legislative_body_id) AND
-				($10::uuid IS NULL OR event_agenda_item.rapporteur_id = COALESCE($10, event_agenda_item.rapporteur_id))`
+				($10::uuid IS NULL OR event_agenda_item.rapporteur_id = COALESCE($10, event_agenda_item.rapporteur_id)) AND
+				($11::uuid IS NULL OR NOT EXISTS (SELECT 1 FROM article_view
+					WHERE article_view.article_id = article.id AND article_view.user_id = $11::uuid)) AND
+				%s AND %s`, getFollowedArticlesCondition(12), getCreationPeriodCondition(13))
 }
 
 func (articleSelectSqlManager) All() string {
-	return `SELECT article.id AS article_id, article.created_at AS article_created_at,
+	return fmt.Sprintf(`SELECT article.id AS article_id, article.created_at AS article_created_at,
 				article.updated_at AS article_updated_at,
 				COALESCE(AVG(user_article.rating), 0) AS article_average_rating,
 				COUNT(user_article.rating) AS article_number_of_ratings,
@@ -234,15 +255,18 @@ func (articleSelectSqlManager) All() string {
 				('Boletim do dia ' || TO_CHAR(newsletter.reference_date, 'DD/MM/YYYY') ILIKE $3 OR
 				newsletter.description ILIKE $3)) AND
 				DATE_TRUNC('day', article.created_at) >= DATE_TRUNC('day', COALESCE($4, article.created_at)) AND
-				DATE_TRUNC('day', article.created_at) <= DATE_TRUNC('day', COALESCE($5, article.created_at))
+				DATE_TRUNC('day', article.created_at) <= DATE_TRUNC('day', COALESCE($5, article.created_at)) AND
+				($6::uuid IS NULL OR NOT EXISTS (SELECT 1 FROM article_view
+					WHERE article_view.article_id = article.id AND article_view.user_id = $6::uuid)) AND
+				%s AND %s
 			GROUP BY article.id, article.reference_date_time, article_type.id, proposition.id, proposition_type.id,
 				voting.id, event.id, event_type.id, event_situation.id, newsletter.id
 			ORDER BY article.reference_date_time DESC
-			OFFSET $6 LIMIT $7`
+			OFFSET $10 LIMIT $11`, getFollowedArticlesCondition(7), getCreationPeriodCondition(8))
 }
 
 func (articleSelectSqlManager) Propositions() string {
-	return `SELECT article.id AS article_id, article.created_at AS article_created_at,
+	return fmt.Sprintf(`SELECT article.id AS article_id, article.created_at AS article_created_at,
        article.updated_at AS article_updated_at,
        COALESCE(AVG(user_article.rating), 0) AS article_average_rating,
        COUNT(user_article.rating) AS article_number_of_ratings,
@@ -279,14 +303,20 @@ WHERE article.active = true AND article_type.active = true AND prop.active = tru
     WHERE pa.party_id = $7::uuid AND p.article_id = article.id)) AND
     ($8::uuid IS NULL OR EXISTS (SELECT 1 FROM proposition_author pa
     	INNER JOIN proposition p ON p.id = pa.proposition_id
-    WHERE pa.external_author_id = $8::uuid AND p.article_id = article.id))
+    WHERE pa.external_author_id = $8::uuid AND p.article_id = article.id)) AND
+    ($9::text[] IS NULL OR EXISTS (SELECT 1 FROM proposition_author pa
+    	INNER JOIN proposition p ON p.id = pa.proposition_id
+    WHERE pa.federated_unit = ANY($9::text[]) AND p.article_id = article.id)) AND
+    ($10::uuid IS NULL OR NOT EXISTS (SELECT 1 FROM article_view
+    	WHERE article_view.article_id = article.id AND article_view.user_id = $10::uuid)) AND
+				%s AND %s
 GROUP BY article.id, article.reference_date_time, article_type.id, prop.id, proposition_type.id
 ORDER BY article.reference_date_time DESC
-OFFSET $9 LIMIT $10`
+OFFSET $14 LIMIT $15`, getFollowedArticlesCondition(11), getCreationPeriodCondition(12))
 }
 
 func (articleSelectSqlManager) Votes() string {
-	return `SELECT article.id AS article_id, article.created_at AS article_created_at,
+	return fmt.Sprintf(`SELECT article.id AS article_id, article.created_at AS article_created_at,
 				article.updated_at AS article_updated_at,
 				COALESCE(AVG(user_article.rating), 0) AS article_average_rating,
 				COUNT(user_article.rating) AS article_number_of_ratings,
@@ -311,14 +341,17 @@ func (articleSelectSqlManager) Votes() string {
 			    (CASE WHEN $8 = 'approved' THEN voting.is_approved = true
 			        WHEN $8 = 'rejected' THEN voting.is_approved = false
     				WHEN $8 = 'undetermined' THEN voting.is_approved IS NULL ELSE TRUE END) AND
-				voting.legislative_body_id = COALESCE($9, voting.legislative_body_id)
+				voting.legislative_body_id = COALESCE($9, voting.legislative_body_id) AND
+				($10::uuid IS NULL OR NOT EXISTS (SELECT 1 FROM article_view
+					WHERE article_view.article_id = article.id AND article_view.user_id = $10::uuid)) AND
+				%s AND %s
 			GROUP BY article.id, article.reference_date_time, article_type.id, voting.id
 			ORDER BY article.reference_date_time DESC
-			OFFSET $10 LIMIT $11`
+			OFFSET $14 LIMIT $15`, getFollowedArticlesCondition(11), getCreationPeriodCondition(12))
 }
 
 func (articleSelectSqlManager) Events() string {
-	return `SELECT article.id AS article_id, article.created_at AS article_created_at,
+	return fmt.Sprintf(`SELECT article.id AS article_id, article.created_at AS article_created_at,
 				article.updated_at AS article_updated_at,
 				COALESCE(AVG(user_article.rating), 0) AS article_average_rating,
 				COUNT(user_article.rating) AS article_number_of_ratings,
@@ -354,18 +387,20 @@ func (articleSelectSqlManager) Events() string {
 				DATE_TRUNC('day', event.starts_at) <= DATE_TRUNC('day', COALESCE($7, event.starts_at)) AND
 				event_situation.id = COALESCE($8, event_situation.id) AND
 				event_legislative_body.legislative_body_id = COALESCE($9, event_legislative_body.legislative_body_id) AND
-				($10::uuid IS NULL OR event_agenda_item.rapporteur_id = COALESCE($10, event_agenda_item.rapporteur_id))
+				($10::uuid IS NULL OR event_agenda_item.rapporteur_id = COALESCE($10, event_agenda_item.rapporteur_id)) AND
+				($11::uuid IS NULL OR NOT EXISTS (SELECT 1 FROM article_view
+					WHERE article_view.article_id = article.id AND article_view.user_id = $11::uuid)) AND
+				%s AND %s
 			GROUP BY article.id, article.reference_date_time, article_type.id, event.id, event_type.id,
 				event_situation.id
 			ORDER BY article.reference_date_time DESC
-			OFFSET $11 LIMIT $12`
+			OFFSET $15 LIMIT $16`, getFollowedArticlesCondition(12), getCreationPeriodCondition(13))
 }
 
-func (articleSelectSqlManager) TrendingArticles() string {
-	return `SELECT article.id AS article_id, COUNT(article_view.id) AS article_views,
+func (articleSelectSqlManager) TrendingArticles(usePrecomputedScores bool) string {
+	return fmt.Sprintf(`SELECT article.id AS article_id, %s AS article_views,
 				article.created_at AS article_created_at, article.updated_at AS article_updated_at,
-				COALESCE(AVG(user_article.rating), 0) AS article_average_rating,
-				COUNT(user_article.rating) AS article_number_of_ratings,
+				%s,
 				article_type.id AS article_type_id, article_type.description AS article_type_description,
 				article_type.codes AS article_type_codes, article_type.color AS article_type_color,
 				COALESCE(proposition.id, '00000000-0000-0000-0000-000000000000') AS proposition_id,
@@ -395,7 +430,7 @@ func (articleSelectSqlManager) TrendingArticles() string {
 				COALESCE(newsletter.id, '00000000-0000-0000-0000-000000000000') AS newsletter_id,
 				COALESCE(newsletter.reference_date, '0001-01-01 00:00:00') AS newsletter_reference_date,
 				COALESCE(newsletter.description, '') AS newsletter_description
-			FROM article
+			FROM %s
 				INNER JOIN article_type ON article_type.id = article.article_type_id
 				LEFT JOIN proposition ON proposition.article_id = article.id
 				LEFT JOIN proposition_type ON proposition_type.id = proposition.proposition_type_id
@@ -404,14 +439,12 @@ func (articleSelectSqlManager) TrendingArticles() string {
 				LEFT JOIN event_type ON event_type.id = event.event_type_id
 				LEFT JOIN event_situation ON event_situation.id = event.event_situation_id
 				LEFT JOIN newsletter ON newsletter.article_id = article.id
-				LEFT JOIN user_article ON user_article.article_id = article.id
-				LEFT JOIN article_view ON article_view.article_id = article.id AND
-					article_view.created_at >= CURRENT_DATE - INTERVAL '1 week'
+				%s
 			WHERE article.active = true AND article_type.active = true AND proposition.active IS NOT false AND
 				proposition_type.active IS NOT false AND voting.active IS NOT false AND
 				event.active IS NOT false AND event_type.active IS NOT false AND
 				event_situation.active IS NOT false AND newsletter.active IS NOT false AND
-				user_article.active IS NOT false AND article_type.id = COALESCE($1, article_type.id) AND
+				article_type.id = COALESCE($1, article_type.id) AND
 				($2::uuid IS NULL OR proposition_type.id = $2 OR event_type.id = $2) AND
 				((proposition.title ILIKE $3 OR proposition.content ILIKE $3) OR
 				('Votação ' || voting.code ILIKE $3 OR voting.result ILIKE $3) OR
@@ -419,18 +452,23 @@ func (articleSelectSqlManager) TrendingArticles() string {
 				('Boletim do dia ' || TO_CHAR(newsletter.reference_date, 'DD/MM/YYYY') ILIKE $3 OR
 				newsletter.description ILIKE $3)) AND
 				DATE_TRUNC('day', article.created_at) >= DATE_TRUNC('day', COALESCE($4, article.created_at)) AND
-				DATE_TRUNC('day', article.created_at) <= DATE_TRUNC('day', COALESCE($5, article.created_at))
-			GROUP BY article.id, article.reference_date_time, article_type.id, proposition.id, proposition_type.id,
-				voting.id, event.id, event_type.id, event_situation.id, newsletter.id
-			ORDER BY article_views DESC, article.reference_date_time DESC
-			OFFSET $6 LIMIT $7`
+				DATE_TRUNC('day', article.created_at) <= DATE_TRUNC('day', COALESCE($5, article.created_at)) AND
+				($6::uuid IS NULL OR NOT EXISTS (SELECT 1 FROM article_view
+					WHERE article_view.article_id = article.id AND article_view.user_id = $6::uuid))
+			%s
+			ORDER BY %s DESC, article.reference_date_time DESC
+			OFFSET $7 LIMIT $8`, trendingArticleViews(usePrecomputedScores),
+		trendingArticleRatings(usePrecomputedScores), trendingArticleSource(usePrecomputedScores, 9),
+		trendingArticleJoins(usePrecomputedScores, 9), trendingArticleGrouping(usePrecomputedScores,
+			`article.id, article.reference_date_time, article_type.id, proposition.id, proposition_type.id,
+				voting.id, event.id, event_type.id, event_situation.id, newsletter.id`),
+		trendingScore(usePrecomputedScores, 10))
 }
 
-func (articleSelectSqlManager) TrendingPropositions() string {
-	return `SELECT article.id AS article_id, COUNT(article_view.id) AS article_views,
+func (articleSelectSqlManager) TrendingPropositions(usePrecomputedScores bool) string {
+	return fmt.Sprintf(`SELECT article.id AS article_id, %s AS article_views,
 				article.created_at AS article_created_at, article.updated_at AS article_updated_at,
-				COALESCE(AVG(user_article.rating), 0) AS article_average_rating,
-				COUNT(user_article.rating) AS article_number_of_ratings,
+				%s,
 				article_type.id AS article_type_id, article_type.description AS article_type_description,
 				article_type.codes AS article_type_codes, article_type.color AS article_type_color,
 				prop.id AS proposition_id, prop.title AS proposition_title, prop.content AS proposition_content,
@@ -439,21 +477,19 @@ func (articleSelectSqlManager) TrendingPropositions() string {
 				proposition_type.id AS proposition_type_id,
 				proposition_type.description AS proposition_type_description,
 				proposition_type.color AS proposition_type_color
-			FROM article
+			FROM %s
 				INNER JOIN article_type ON article_type.id = article.article_type_id
 				INNER JOIN proposition prop ON prop.article_id = article.id
 				INNER JOIN proposition_type ON proposition_type.id = prop.proposition_type_id
-				INNER JOIN proposition_author ON proposition_author.proposition_id = prop.id
-				LEFT JOIN deputy ON deputy.id = proposition_author.deputy_id
-				LEFT JOIN party previous_party ON previous_party.id = proposition_author.party_id
-				LEFT JOIN external_author ON external_author.id = proposition_author.external_author_id
-				LEFT JOIN user_article ON user_article.article_id = article.id
-				LEFT JOIN article_view ON article_view.article_id = article.id AND
-					article_view.created_at >= CURRENT_DATE - INTERVAL '1 week'
+				%s
 			WHERE article.active = true AND article_type.active = true AND prop.active = true AND
-				proposition_type.active = true AND proposition_author.active = true AND deputy.active IS NOT false AND
-				previous_party.active IS NOT false AND external_author.active IS NOT false AND
-				user_article.active IS NOT false AND article_type.id = COALESCE($1, article_type.id) AND
+				proposition_type.active = true AND EXISTS (SELECT 1 FROM proposition_author
+					LEFT JOIN deputy ON deputy.id = proposition_author.deputy_id
+					LEFT JOIN party previous_party ON previous_party.id = proposition_author.party_id
+					LEFT JOIN external_author ON external_author.id = proposition_author.external_author_id
+				WHERE proposition_author.proposition_id = prop.id AND proposition_author.active = true AND
+					deputy.active IS NOT false AND previous_party.active IS NOT false AND
+					external_author.active IS NOT false) AND article_type.id = COALESCE($1, article_type.id) AND
 				proposition_type.id = COALESCE($2, proposition_type.id) AND
 				(prop.title ILIKE $3 OR prop.content ILIKE $3) AND
 				DATE_TRUNC('day', article.created_at) >= DATE_TRUNC('day', COALESCE($4, article.created_at)) AND
@@ -466,30 +502,36 @@ func (articleSelectSqlManager) TrendingPropositions() string {
 				WHERE pa.party_id = $7::uuid AND p.article_id = article.id)) AND
 				($8::uuid IS NULL OR EXISTS (SELECT 1 FROM proposition_author pa
 					INNER JOIN proposition p ON p.id = pa.proposition_id
-				WHERE pa.external_author_id = $8::uuid AND p.article_id = article.id))
-			GROUP BY article.id, article.reference_date_time, article_type.id, prop.id, proposition_type.id
-			ORDER BY article_views DESC, article.reference_date_time DESC
-			OFFSET $9 LIMIT $10`
+				WHERE pa.external_author_id = $8::uuid AND p.article_id = article.id)) AND
+				($9::text[] IS NULL OR EXISTS (SELECT 1 FROM proposition_author pa
+					INNER JOIN proposition p ON p.id = pa.proposition_id
+				WHERE pa.federated_unit = ANY($9::text[]) AND p.article_id = article.id)) AND
+				($10::uuid IS NULL OR NOT EXISTS (SELECT 1 FROM article_view
+					WHERE article_view.article_id = article.id AND article_view.user_id = $10::uuid))
+			%s
+			ORDER BY %s DESC, article.reference_date_time DESC
+			OFFSET $11 LIMIT $12`, trendingArticleViews(usePrecomputedScores),
+		trendingArticleRatings(usePrecomputedScores), trendingArticleSource(usePrecomputedScores, 13),
+		trendingArticleJoins(usePrecomputedScores, 13), trendingArticleGrouping(usePrecomputedScores,
+			`article.id, article.reference_date_time, article_type.id, prop.id, proposition_type.id`),
+		trendingScore(usePrecomputedScores, 14))
 }
 
-func (articleSelectSqlManager) TrendingVotes() string {
-	return `SELECT article.id AS article_id, COUNT(article_view.id) AS article_views,
+func (articleSelectSqlManager) TrendingVotes(usePrecomputedScores bool) string {
+	return fmt.Sprintf(`SELECT article.id AS article_id, %s AS article_views,
 				article.created_at AS article_created_at, article.updated_at AS article_updated_at,
-				COALESCE(AVG(user_article.rating), 0) AS article_average_rating,
-				COUNT(user_article.rating) AS article_number_of_ratings,
+				%s,
 				article_type.id AS article_type_id, article_type.description AS article_type_description,
 				article_type.codes AS article_type_codes, article_type.color AS article_type_color,
 				voting.id AS voting_id, voting.code AS voting_code, voting.description AS voting_description,
     			voting.result AS voting_result, voting.result_announced_at AS voting_result_announced_at,
     			voting.is_approved AS voting_is_approved
-			FROM article
+			FROM %s
 				INNER JOIN article_type ON article_type.id = article.article_type_id
 				INNER JOIN voting ON voting.article_id = article.id
-				LEFT JOIN user_article ON user_article.article_id = article.id
-				LEFT JOIN article_view ON article_view.article_id = article.id AND
-					article_view.created_at >= CURRENT_DATE - INTERVAL '1 week'
+				%s
 			WHERE article.active = true AND article_type.active = true AND voting.active = true AND
-				user_article.active IS NOT false AND article_type.id = COALESCE($1, article_type.id) AND
+				article_type.id = COALESCE($1, article_type.id) AND
 				$2::uuid IS NULL AND ('Votação ' || voting.code ILIKE $3 OR voting.result ILIKE $3) AND
 				DATE_TRUNC('day', article.created_at) >= DATE_TRUNC('day', COALESCE($4, article.created_at)) AND
 				DATE_TRUNC('day', article.created_at) <= DATE_TRUNC('day', COALESCE($5, article.created_at)) AND
@@ -500,17 +542,22 @@ func (articleSelectSqlManager) TrendingVotes() string {
 			    (CASE WHEN $8 = 'approved' THEN voting.is_approved = true
 			        WHEN $8 = 'rejected' THEN voting.is_approved = false
     				WHEN $8 = 'undetermined' THEN voting.is_approved IS NULL ELSE TRUE END) AND
-				voting.legislative_body_id = COALESCE($9, voting.legislative_body_id)
-			GROUP BY article.id, article.reference_date_time, article_type.id, voting.id
-			ORDER BY article_views DESC, article.reference_date_time DESC
-			OFFSET $10 LIMIT $11`
+				voting.legislative_body_id = COALESCE($9, voting.legislative_body_id) AND
+				($10::uuid IS NULL OR NOT EXISTS (SELECT 1 FROM article_view
+					WHERE article_view.article_id = article.id AND article_view.user_id = $10::uuid))
+			%s
+			ORDER BY %s DESC, article.reference_date_time DESC
+			OFFSET $11 LIMIT $12`, trendingArticleViews(usePrecomputedScores),
+		trendingArticleRatings(usePrecomputedScores), trendingArticleSource(usePrecomputedScores, 13),
+		trendingArticleJoins(usePrecomputedScores, 13), trendingArticleGrouping(usePrecomputedScores,
+			`article.id, article.reference_date_time, article_type.id, voting.id`),
+		trendingScore(usePrecomputedScores, 14))
 }
 
-func (articleSelectSqlManager) TrendingEvents() string {
-	return `SELECT article.id AS article_id, COUNT(article_view.id) AS article_views,
+func (articleSelectSqlManager) TrendingEvents(usePrecomputedScores bool) string {
+	return fmt.Sprintf(`SELECT article.id AS article_id, %s AS article_views,
 				article.created_at AS article_created_at, article.updated_at AS article_updated_at,
-				COALESCE(AVG(user_article.rating), 0) AS article_average_rating,
-				COUNT(user_article.rating) AS article_number_of_ratings,
+				%s,
 				article_type.id AS article_type_id, article_type.description AS article_type_description,
 				article_type.codes AS article_type_codes, article_type.color AS article_type_color,
 				event.id AS event_id, event.title AS event_title, event.description AS event_description,
@@ -523,19 +570,14 @@ func (articleSelectSqlManager) TrendingEvents() string {
 				event_situation.id AS event_situation_id,
 				event_situation.description AS event_situation_description,
 				event_situation.color AS event_situation_color
-			FROM article
+			FROM %s
 				INNER JOIN article_type ON article_type.id = article.article_type_id
 				INNER JOIN event ON event.article_id = article.id
 				INNER JOIN event_type ON event_type.id = event.event_type_id
 				INNER JOIN event_situation ON event_situation.id = event.event_situation_id
-				INNER JOIN event_legislative_body ON event_legislative_body.event_id = event.id
-				LEFT JOIN event_agenda_item ON event_agenda_item.event_id = event.id
-				LEFT JOIN user_article ON user_article.article_id = article.id
-				LEFT JOIN article_view ON article_view.article_id = article.id AND
-					article_view.created_at >= CURRENT_DATE - INTERVAL '1 week'
+				%s
 			WHERE article.active = true AND article_type.active = true AND event.active = true AND
-				event_type.active = true AND event_situation.active = true AND event_legislative_body.active = true AND
-				event_agenda_item.active IS NOT false AND user_article.active IS NOT false AND
+				event_type.active = true AND event_situation.active = true AND
 				article_type.id = COALESCE($1, article_type.id) AND event_type.id = COALESCE($2, event_type.id) AND
 				(event.title ILIKE $3 OR event.description ILIKE $3) AND
 				DATE_TRUNC('day', article.created_at) >= DATE_TRUNC('day', COALESCE($4, article.created_at)) AND
@@ -544,19 +586,27 @@ func (articleSelectSqlManager) TrendingEvents() string {
 				DATE_TRUNC('day', COALESCE($6, COALESCE(event.ends_at, event.starts_at))) AND
 				DATE_TRUNC('day', event.starts_at) <= DATE_TRUNC('day', COALESCE($7, event.starts_at)) AND
 				event_situation.id = COALESCE($8, event_situation.id) AND
-				event_legislative_body.legislative_body_id = COALESCE($9, event_legislative_body.legislative_body_id) AND
-				($10::uuid IS NULL OR event_agenda_item.rapporteur_id = COALESCE($10, event_agenda_item.rapporteur_id))
-			GROUP BY article.id, article.reference_date_time, article_type.id, event.id, event_type.id,
-				event_situation.id
-			ORDER BY article_views DESC, article.reference_date_time DESC
-			OFFSET $11 LIMIT $12`
+				EXISTS (SELECT 1 FROM event_legislative_body
+				WHERE event_legislative_body.event_id = event.id AND event_legislative_body.active = true AND
+					($9::uuid IS NULL OR event_legislative_body.legislative_body_id = $9)) AND
+				($10::uuid IS NULL OR EXISTS (SELECT 1 FROM event_agenda_item
+				WHERE event_agenda_item.event_id = event.id AND event_agenda_item.active = true AND
+					event_agenda_item.rapporteur_id = $10)) AND
+				($11::uuid IS NULL OR NOT EXISTS (SELECT 1 FROM article_view
+					WHERE article_view.article_id = article.id AND article_view.user_id = $11::uuid))
+			%s
+			ORDER BY %s DESC, article.reference_date_time DESC
+			OFFSET $12 LIMIT $13`, trendingArticleViews(usePrecomputedScores),
+		trendingArticleRatings(usePrecomputedScores), trendingArticleSource(usePrecomputedScores, 14),
+		trendingArticleJoins(usePrecomputedScores, 14), trendingArticleGrouping(usePrecomputedScores,
+			`article.id, article.reference_date_time, article_type.id, event.id, event_type.id,
+				event_situation.id`), trendingScore(usePrecomputedScores, 15))
 }
 
-func (articleSelectSqlManager) TrendingArticlesByTypeId() string {
-	return `SELECT article.id AS article_id, COUNT(article_view.id) AS article_views,
+func (articleSelectSqlManager) TrendingArticlesByTypeId(usePrecomputedScores bool) string {
+	return fmt.Sprintf(`SELECT article.id AS article_id, %s AS article_views,
 				article.created_at AS article_created_at, article.updated_at AS article_updated_at,
-				COALESCE(AVG(user_article.rating), 0) AS article_average_rating,
-				COUNT(user_article.rating) AS article_number_of_ratings,
+				%s,
 				article_type.id AS article_type_id, article_type.description AS article_type_description,
 				article_type.codes AS article_type_codes, article_type.color AS article_type_color,
 				COALESCE(proposition.id, '00000000-0000-0000-0000-000000000000') AS proposition_id,
@@ -586,7 +636,7 @@ func (articleSelectSqlManager) TrendingArticlesByTypeId() string {
 				COALESCE(newsletter.id, '00000000-0000-0000-0000-000000000000') AS newsletter_id,
 				COALESCE(newsletter.reference_date, '0001-01-01 00:00:00') AS newsletter_reference_date,
 				COALESCE(newsletter.description, '') AS newsletter_description
-			FROM article
+			FROM %s
 				INNER JOIN article_type ON article_type.id = article.article_type_id
 				LEFT JOIN proposition ON proposition.article_id = article.id
 				LEFT JOIN proposition_type ON proposition_type.id = proposition.proposition_type_id
@@ -595,25 +645,26 @@ func (articleSelectSqlManager) TrendingArticlesByTypeId() string {
 				LEFT JOIN event_type ON event_type.id = event.event_type_id
 				LEFT JOIN event_situation ON event_situation.id = event.event_situation_id
 				LEFT JOIN newsletter ON newsletter.article_id = article.id
-				LEFT JOIN user_article ON user_article.article_id = article.id
-				LEFT JOIN article_view ON article_view.article_id = article.id AND
-					article_view.created_at >= CURRENT_DATE - INTERVAL '1 week'
+				%s
 			WHERE article.active = true AND article_type.active = true AND proposition.active IS NOT false AND
 				proposition_type.active IS NOT false AND voting.active IS NOT false AND
 				event.active IS NOT false AND event_type.active IS NOT false AND
 				event_situation.active IS NOT false AND newsletter.active IS NOT false AND
-				user_article.active IS NOT false AND article_type.id = $1
-			GROUP BY article.id, article.reference_date_time, article_type.id, proposition.id, proposition_type.id,
-				voting.id, event.id, event_type.id, event_situation.id, newsletter.id
-			ORDER BY article_views DESC, article.reference_date_time DESC
-			LIMIT $2`
+				article_type.id = $1
+			%s
+			ORDER BY %s DESC, article.reference_date_time DESC
+			LIMIT $2`, trendingArticleViews(usePrecomputedScores),
+		trendingArticleRatings(usePrecomputedScores), trendingArticleSource(usePrecomputedScores, 3),
+		trendingArticleJoins(usePrecomputedScores, 3), trendingArticleGrouping(usePrecomputedScores,
+			`article.id, article.reference_date_time, article_type.id, proposition.id, proposition_type.id,
+				voting.id, event.id, event_type.id, event_situation.id, newsletter.id`),
+		trendingScore(usePrecomputedScores, 4))
 }
 
-func (articleSelectSqlManager) TrendingArticlesBySpecificTypeId() string {
-	return `SELECT article.id AS article_id, COUNT(article_view.id) AS article_views,
+func (articleSelectSqlManager) TrendingArticlesBySpecificTypeId(usePrecomputedScores bool) string {
+	return fmt.Sprintf(`SELECT article.id AS article_id, %s AS article_views,
 				article.created_at AS article_created_at, article.updated_at AS article_updated_at,
-				COALESCE(AVG(user_article.rating), 0) AS article_average_rating,
-				COUNT(user_article.rating) AS article_number_of_ratings,
+				%s,
 				article_type.id AS article_type_id, article_type.description AS article_type_description,
 				article_type.codes AS article_type_codes, article_type.color AS article_type_color,
 				COALESCE(proposition.id, '00000000-0000-0000-0000-000000000000') AS proposition_id,
@@ -635,24 +686,25 @@ func (articleSelectSqlManager) TrendingArticlesBySpecificTypeId() string {
 				COALESCE(event_situation.id, '00000000-0000-0000-0000-000000000000') AS event_situation_id,
 				COALESCE(event_situation.description, '') AS event_situation_description,
 				COALESCE(event_situation.color, '') AS event_situation_color
-			FROM article
+			FROM %s
 				INNER JOIN article_type ON article_type.id = article.article_type_id
 				LEFT JOIN proposition ON proposition.article_id = article.id
 				LEFT JOIN proposition_type ON proposition_type.id = proposition.proposition_type_id
 				LEFT JOIN event ON event.article_id = article.id
 				LEFT JOIN event_type ON event_type.id = event.event_type_id
 				LEFT JOIN event_situation ON event_situation.id = event.event_situation_id
-				LEFT JOIN user_article ON user_article.article_id = article.id
-				LEFT JOIN article_view ON article_view.article_id = article.id AND
-					article_view.created_at >= CURRENT_DATE - INTERVAL '1 week'
+				%s
 			WHERE article.active = true AND article_type.active = true AND proposition.active IS NOT false AND
 				proposition_type.active IS NOT false AND event.active IS NOT false AND
 				event_type.active IS NOT false AND event_situation.active IS NOT false AND
-				user_article.active IS NOT false AND (proposition_type.id = $1 OR event_type.id = $1)
-			GROUP BY article.id, article.reference_date_time, article_type.id, proposition.id, proposition_type.id,
-				event.id, event_type.id, event_situation.id
-			ORDER BY article_views DESC, article.reference_date_time DESC
-			LIMIT $2`
+				(proposition_type.id = $1 OR event_type.id = $1)
+			%s
+			ORDER BY %s DESC, article.reference_date_time DESC
+			LIMIT $2`, trendingArticleViews(usePrecomputedScores),
+		trendingArticleRatings(usePrecomputedScores), trendingArticleSource(usePrecomputedScores, 3),
+		trendingArticleJoins(usePrecomputedScores, 3), trendingArticleGrouping(usePrecomputedScores,
+			`article.id, article.reference_date_time, article_type.id, proposition.id, proposition_type.id,
+				event.id, event_type.id, event_situation.id`), trendingScore(usePrecomputedScores, 4))
 }
 
 func (articleSelectSqlManager) RelatedArticlesByPropositionId() string {
@@ -898,115 +950,128 @@ func (articleSelectSqlManager) RatingsAndArticlesSavedForLaterViewing(numberOfAr
 }
 
 func (articleSelectSqlManager) NumberOfArticlesBookmarkedToViewLater() string {
-	return `SELECT COUNT(DISTINCT article.id)
-			FROM article
-				INNER JOIN article_type ON article_type.id = article.article_type_id
-				LEFT JOIN proposition ON proposition.article_id = article.id
-				LEFT JOIN proposition_type ON proposition_type.id = proposition.proposition_type_id
-				LEFT JOIN voting ON voting.article_id = article.id
-				LEFT JOIN event ON event.article_id = article.id
-				LEFT JOIN event_type ON event_type.id = event.event_type_id
-				LEFT JOIN event_situation ON event_situation.id = event.event_situation_id
-				LEFT JOIN newsletter ON newsletter.article_id = article.id
-				INNER JOIN user_article ON user_article.article_id = article.id
-			WHERE article.active = true AND article_type.active = true AND proposition.active IS NOT false AND
-				proposition_type.active IS NOT false AND voting.active IS NOT false AND
-				event.active IS NOT false AND event_type.active IS NOT false AND
-				event_situation.active IS NOT false AND newsletter.active IS NOT false AND user_article.active = true AND
-				article_type.id = COALESCE($1, article_type.id) AND ($2::uuid IS NULL OR proposition_type.id = $2 OR
-				event_type.id = $2) AND ((proposition.title ILIKE $3 OR proposition.content ILIKE $3) OR
-				('Votação ' || voting.code ILIKE $3 OR voting.result ILIKE $3) OR
-				(event.title ILIKE $3 OR event.description ILIKE $3) OR
-				('Boletim do dia ' || TO_CHAR(newsletter.reference_date, 'DD/MM/YYYY') ILIKE $3 OR
-				newsletter.description ILIKE $3)) AND
-				DATE_TRUNC('day', article.created_at) >= DATE_TRUNC('day', COALESCE($4, article.created_at)) AND
-				DATE_TRUNC('day', article.created_at) <= DATE_TRUNC('day', COALESCE($5, article.created_at)) AND
-				user_article.user_id = $6 AND user_article.view_later = true`
+	return countUserArticles(getUserArticlesSource(articlesBookmarkedToViewLater(6)))
 }
 
 func (articleSelectSqlManager) NumberOfPropositionsBookmarkedToViewLater() string {
-	return `SELECT COUNT(DISTINCT article.id)
-			FROM article
-				INNER JOIN article_type ON article_type.id = article.article_type_id
-				INNER JOIN proposition prop ON prop.article_id = article.id
-				INNER JOIN proposition_type ON proposition_type.id = prop.proposition_type_id
-				INNER JOIN proposition_author ON proposition_author.proposition_id = prop.id
-				LEFT JOIN deputy ON deputy.id = proposition_author.deputy_id
-				LEFT JOIN party previous_party ON previous_party.id = proposition_author.party_id
-				LEFT JOIN external_author ON external_author.id = proposition_author.external_author_id
-				LEFT JOIN user_article ON user_article.article_id = article.id
-			WHERE article.active = true AND article_type.active = true AND prop.active = true AND
-				proposition_type.active = true AND proposition_author.active = true AND deputy.active IS NOT false AND
-				previous_party.active IS NOT false AND external_author.active IS NOT false AND
-				user_article.active IS NOT false AND article_type.id = COALESCE($1, article_type.id) AND
-				proposition_type.id = COALESCE($2, proposition_type.id) AND
-				(prop.title ILIKE $3 OR prop.content ILIKE $3) AND
-				DATE_TRUNC('day', article.created_at) >= DATE_TRUNC('day', COALESCE($4, article.created_at)) AND
-				DATE_TRUNC('day', article.created_at) <= DATE_TRUNC('day', COALESCE($5, article.created_at)) AND
-				($6::uuid IS NULL OR EXISTS (SELECT 1 FROM proposition_author pa
-					INNER JOIN proposition p ON p.id = pa.proposition_id
-				WHERE pa.deputy_id = $6::uuid AND p.article_id = article.id)) AND
-				($7::uuid IS NULL OR EXISTS (SELECT 1 FROM proposition_author pa
-					INNER JOIN proposition p ON p.id = pa.proposition_id
-				WHERE pa.party_id = $7::uuid AND p.article_id = article.id)) AND
-				($8::uuid IS NULL OR EXISTS (SELECT 1 FROM proposition_author pa
-					INNER JOIN proposition p ON p.id = pa.proposition_id
-				WHERE pa.external_author_id = $8::uuid AND p.article_id = article.id)) AND
-				user_article.user_id = $9 AND user_article.view_later = true`
+	return countUserArticles(getUserPropositionsSource(articlesBookmarkedToViewLater(10)))
 }
 
 func (articleSelectSqlManager) NumberOfVotesBookmarkedToViewLater() string {
-	return `SELECT COUNT(DISTINCT article.id)
-			FROM article
-				INNER JOIN article_type ON article_type.id = article.article_type_id
-				INNER JOIN voting ON voting.article_id = article.id
-				LEFT JOIN user_article ON user_article.article_id = article.id
-			WHERE article.active = true AND article_type.active = true AND voting.active = true AND
-				user_article.active IS NOT false AND article_type.id = COALESCE($1, article_type.id) AND
-				$2::uuid IS NULL AND ('Votação ' || voting.code ILIKE $3 OR voting.result ILIKE $3) AND
-				DATE_TRUNC('day', article.created_at) >= DATE_TRUNC('day', COALESCE($4, article.created_at)) AND
-				DATE_TRUNC('day', article.created_at) <= DATE_TRUNC('day', COALESCE($5, article.created_at)) AND
-				DATE_TRUNC('day', voting.result_announced_at) >= DATE_TRUNC('day',
-				COALESCE($6, voting.result_announced_at)) AND
-				DATE_TRUNC('day', voting.result_announced_at) <= DATE_TRUNC('day',
-				COALESCE($7, voting.result_announced_at)) AND
-			    (CASE WHEN $8 = 'approved' THEN voting.is_approved = true
-			        WHEN $8 = 'rejected' THEN voting.is_approved = false
-    				WHEN $8 = 'undetermined' THEN voting.is_approved IS NULL ELSE TRUE END) AND
-				voting.legislative_body_id = COALESCE($9, voting.legislative_body_id) AND
-				user_article.user_id = $10 AND user_article.view_later = true`
+	return countUserArticles(getUserVotesSource(articlesBookmarkedToViewLater(10)))
 }
 
 func (articleSelectSqlManager) NumberOfEventsBookmarkedToViewLater() string {
-	return `SELECT COUNT(DISTINCT article.id)
-			FROM article
-				INNER JOIN article_type ON article_type.id = article.article_type_id
-				INNER JOIN event ON event.article_id = article.id
-				INNER JOIN event_type ON event_type.id = event.event_type_id
-				INNER JOIN event_situation ON event_situation.id = event.event_situation_id
-				INNER JOIN event_legislative_body ON event_legislative_body.event_id = event.id
-				LEFT JOIN event_agenda_item ON event_agenda_item.event_id = event.id
-				LEFT JOIN user_article ON user_article.article_id = article.id
-			WHERE article.active = true AND article_type.active = true AND event.active = true AND
-				event_type.active = true AND event_situation.active = true AND event_legislative_body.active = true AND
-				event_agenda_item.active IS NOT false AND user_article.active IS NOT false AND
-				article_type.id = COALESCE($1, article_type.id) AND event_type.id = COALESCE($2, event_type.id) AND
-				(event.title ILIKE $3 OR event.description ILIKE $3) AND
-				DATE_TRUNC('day', article.created_at) >= DATE_TRUNC('day', COALESCE($4, article.created_at)) AND
-				DATE_TRUNC('day', article.created_at) <= DATE_TRUNC('day', COALESCE($5, article.created_at)) AND
-				DATE_TRUNC('day', COALESCE(event.ends_at, event.starts_at)) >=
-				DATE_TRUNC('day', COALESCE($6, COALESCE(event.ends_at, event.starts_at))) AND
-				DATE_TRUNC('day', event.starts_at) <= DATE_TRUNC('day', COALESCE($7, event.starts_at)) AND
-				event_situation.id = COALESCE($8, event_situation.id) AND
-				event_legislative_body.legislative_body_id = COALESCE($9, event_legislative_body.legislative_body_id) AND
-				($10::uuid IS NULL OR event_agenda_item.rapporteur_id = COALESCE($10, event_agenda_item.rapporteur_id)) AND
-				user_article.user_id = $11 AND user_article.view_later = true`
+	return countUserArticles(getUserEventsSource(articlesBookmarkedToViewLater(11)))
 }
 
 func (articleSelectSqlManager) ArticlesBookmarkedToViewLater() string {
-	return `SELECT article.id AS article_id, COALESCE(user_article.rating, 0) AS user_article_rating,
+	listing := articlesBookmarkedToViewLater(6)
+	return selectUserArticles(getUserArticlesSource(listing), listing, 7)
+}
+
+func (articleSelectSqlManager) PropositionsBookmarkedToViewLater() string {
+	listing := articlesBookmarkedToViewLater(10)
+	return selectUserArticles(getUserPropositionsSource(listing), listing, 11)
+}
+
+func (articleSelectSqlManager) VotesBookmarkedToViewLater() string {
+	listing := articlesBookmarkedToViewLater(10)
+	return selectUserArticles(getUserVotesSource(listing), listing, 11)
+}
+
+func (articleSelectSqlManager) EventsBookmarkedToViewLater() string {
+	listing := articlesBookmarkedToViewLater(11)
+	return selectUserArticles(getUserEventsSource(listing), listing, 12)
+}
+
+func (articleSelectSqlManager) NumberOfArticlesInHistory() string {
+	return countUserArticles(getUserArticlesSource(articlesInHistory(6)))
+}
+
+func (articleSelectSqlManager) ArticlesInHistory() string {
+	listing := articlesInHistory(6)
+	return selectUserArticles(getUserArticlesSource(listing), listing, 7)
+}
+
+func (articleSelectSqlManager) NumberOfPropositionsInHistory() string {
+	return countUserArticles(getUserPropositionsSource(articlesInHistory(10)))
+}
+
+func (articleSelectSqlManager) PropositionsInHistory() string {
+	listing := articlesInHistory(10)
+	return selectUserArticles(getUserPropositionsSource(listing), listing, 11)
+}
+
+func (articleSelectSqlManager) NumberOfVotesInHistory() string {
+	return countUserArticles(getUserVotesSource(articlesInHistory(10)))
+}
+
+func (articleSelectSqlManager) VotesInHistory() string {
+	listing := articlesInHistory(10)
+	return selectUserArticles(getUserVotesSource(listing), listing, 11)
+}
+
+func (articleSelectSqlManager) NumberOfEventsInHistory() string {
+	return countUserArticles(getUserEventsSource(articlesInHistory(11)))
+}
+
+func (articleSelectSqlManager) EventsInHistory() string {
+	listing := articlesInHistory(11)
+	return selectUserArticles(getUserEventsSource(listing), listing, 12)
+}
+
+// userArticleListing holds what differs between the listings of the articles of a user (the articles bookmarked
+// to view later and the reading history): how the articles are joined to the user and how they are sorted
+type userArticleListing struct {
+	joins           string
+	groupingColumns string
+	ordering        string
+}
+
+func articlesBookmarkedToViewLater(userParameter int) userArticleListing {
+	return userArticleListing{
+		joins: fmt.Sprintf(`INNER JOIN user_article ON user_article.article_id = article.id AND
+					user_article.user_id = $%d AND user_article.active = true AND user_article.view_later = true
+				LEFT JOIN reading_list ON reading_list.user_id = user_article.user_id AND reading_list.active = true AND
+					reading_list.is_default = true
+				LEFT JOIN reading_list_item ON reading_list_item.reading_list_id = reading_list.id AND
+					reading_list_item.article_id = article.id`, userParameter),
+		groupingColumns: "user_article.view_later_set_at, reading_list_item.position",
+		ordering:        "reading_list_item.position NULLS LAST, user_article.view_later_set_at DESC",
+	}
+}
+
+func articlesInHistory(userParameter int) userArticleListing {
+	return userArticleListing{
+		joins: fmt.Sprintf(`INNER JOIN (SELECT article_view.article_id, MAX(article_view.created_at) AS last_viewed_at
+					FROM article_view
+					WHERE article_view.user_id = $%[1]d
+					GROUP BY article_view.article_id) AS article_history ON article_history.article_id = article.id
+				LEFT JOIN user_article ON user_article.article_id = article.id AND user_article.user_id = $%[1]d AND
+					user_article.active = true`, userParameter),
+		groupingColumns: "article_history.last_viewed_at",
+		ordering:        "article_history.last_viewed_at DESC",
+	}
+}
+
+func countUserArticles(source string) string {
+	return fmt.Sprint(`SELECT COUNT(DISTINCT article.id)
+			`, source)
+}
+
+func selectUserArticles(source string, listing userArticleListing, offsetParameter int) string {
+	return fmt.Sprintf(`SELECT article.id AS article_id, COALESCE(user_article.rating, 0) AS user_article_rating,
 				COALESCE(user_article.view_later, false) AS user_article_view_later
-			FROM article
+			%s
+			GROUP BY article.id, user_article.rating, user_article.view_later, %s
+			ORDER BY %s
+			OFFSET $%d LIMIT $%d`, source, listing.groupingColumns, listing.ordering, offsetParameter,
+		offsetParameter+1)
+}
+
+func getUserArticlesSource(listing userArticleListing) string {
+	return fmt.Sprintf(`FROM article
 				INNER JOIN article_type ON article_type.id = article.article_type_id
 				LEFT JOIN proposition ON proposition.article_id = article.id
 				LEFT JOIN proposition_type ON proposition_type.id = proposition.proposition_type_id
@@ -1015,11 +1080,11 @@ func (articleSelectSqlManager) ArticlesBookmarkedToViewLater() string {
 				LEFT JOIN event_type ON event_type.id = event.event_type_id
 				LEFT JOIN event_situation ON event_situation.id = event.event_situation_id
 				LEFT JOIN newsletter ON newsletter.article_id = article.id
-				INNER JOIN user_article ON user_article.article_id = article.id
+				%s
 			WHERE article.active = true AND article_type.active = true AND proposition.active IS NOT false AND
 				proposition_type.active IS NOT false AND voting.active IS NOT false AND
 				event.active IS NOT false AND event_type.active IS NOT false AND event_situation.active IS NOT false AND
-				newsletter.active IS NOT false AND user_article.active = true AND
+				newsletter.active IS NOT false AND
 				article_type.id = COALESCE($1, article_type.id) AND ($2::uuid IS NULL OR proposition_type.id = $2 OR
 				event_type.id = $2) AND ((proposition.title ILIKE $3 OR proposition.content ILIKE $3) OR
 				('Votação ' || voting.code ILIKE $3 OR voting.result ILIKE $3) OR
@@ -1027,17 +1092,12 @@ func (articleSelectSqlManager) ArticlesBookmarkedToViewLater() string {
 				('Boletim do dia ' || TO_CHAR(newsletter.reference_date, 'DD/MM/YYYY') ILIKE $3 OR
 				newsletter.description ILIKE $3)) AND
 				DATE_TRUNC('day', article.created_at) >= DATE_TRUNC('day', COALESCE($4, article.created_at)) AND
-				DATE_TRUNC('day', article.created_at) <= DATE_TRUNC('day', COALESCE($5, article.created_at)) AND
-				user_article.user_id = $6 AND user_article.view_later = true
-			GROUP BY article.id, user_article.rating, user_article.view_later, user_article.view_later_set_at
-			ORDER BY user_article.view_later_set_at DESC
-			OFFSET $7 LIMIT $8`
+				DATE_TRUNC('day', article.created_at) <= DATE_TRUNC('day', COALESCE($5, article.created_at))`,
+		listing.joins)
 }
 
-func (articleSelectSqlManager) PropositionsBookmarkedToViewLater() string {
-	return `SELECT article.id AS article_id, COALESCE(user_article.rating, 0) AS user_article_rating,
-				COALESCE(user_article.view_later, false) AS user_article_view_later
-			FROM article
+func getUserPropositionsSource(listing userArticleListing) string {
+	return fmt.Sprintf(`FROM article
 				INNER JOIN article_type ON article_type.id = article.article_type_id
 				INNER JOIN proposition prop ON prop.article_id = article.id
 				INNER JOIN proposition_type ON proposition_type.id = prop.proposition_type_id
@@ -1045,11 +1105,11 @@ func (articleSelectSqlManager) PropositionsBookmarkedToViewLater() string {
 				LEFT JOIN deputy ON deputy.id = proposition_author.deputy_id
 				LEFT JOIN party previous_party ON previous_party.id = proposition_author.party_id
 				LEFT JOIN external_author ON external_author.id = proposition_author.external_author_id
-				LEFT JOIN user_article ON user_article.article_id = article.id
+				%s
 			WHERE article.active = true AND article_type.active = true AND prop.active = true AND
 				proposition_type.active = true AND proposition_author.active = true AND deputy.active IS NOT false AND
 				previous_party.active IS NOT false AND external_author.active IS NOT false AND
-				user_article.active IS NOT false AND article_type.id = COALESCE($1, article_type.id) AND
+				article_type.id = COALESCE($1, article_type.id) AND
 				proposition_type.id = COALESCE($2, proposition_type.id) AND
 				(prop.title ILIKE $3 OR prop.content ILIKE $3) AND
 				DATE_TRUNC('day', article.created_at) >= DATE_TRUNC('day', COALESCE($4, article.created_at)) AND
@@ -1063,21 +1123,18 @@ func (articleSelectSqlManager) PropositionsBookmarkedToViewLater() string {
 				($8::uuid IS NULL OR EXISTS (SELECT 1 FROM proposition_author pa
 					INNER JOIN proposition p ON p.id = pa.proposition_id
 				WHERE pa.external_author_id = $8::uuid AND p.article_id = article.id)) AND
-				user_article.user_id = $9 AND user_article.view_later = true
-			GROUP BY article.id, user_article.rating, user_article.view_later, user_article.view_later_set_at
-			ORDER BY user_article.view_later_set_at DESC
-			OFFSET $10 LIMIT $11`
+				($9::text[] IS NULL OR EXISTS (SELECT 1 FROM proposition_author pa
+					INNER JOIN proposition p ON p.id = pa.proposition_id
+				WHERE pa.federated_unit = ANY($9::text[]) AND p.article_id = article.id))`, listing.joins)
 }
 
-func (articleSelectSqlManager) VotesBookmarkedToViewLater() string {
-	return `SELECT article.id AS article_id, COALESCE(user_article.rating, 0) AS user_article_rating,
-				COALESCE(user_article.view_later, false) AS user_article_view_later
-			FROM article
+func getUserVotesSource(listing userArticleListing) string {
+	return fmt.Sprintf(`FROM article
 				INNER JOIN article_type ON article_type.id = article.article_type_id
 				INNER JOIN voting ON voting.article_id = article.id
-				LEFT JOIN user_article ON user_article.article_id = article.id
+				%s
 			WHERE article.active = true AND article_type.active = true AND voting.active = true AND
-				user_article.active IS NOT false AND article_type.id = COALESCE($1, article_type.id) AND
+				article_type.id = COALESCE($1, article_type.id) AND
 				$2::uuid IS NULL AND ('Votação ' || voting.code ILIKE $3 OR voting.result ILIKE $3) AND
 				DATE_TRUNC('day', article.created_at) >= DATE_TRUNC('day', COALESCE($4, article.created_at)) AND
 				DATE_TRUNC('day', article.created_at) <= DATE_TRUNC('day', COALESCE($5, article.created_at)) AND
@@ -1088,27 +1145,21 @@ func (articleSelectSqlManager) VotesBookmarkedToViewLater() string {
 			    (CASE WHEN $8 = 'approved' THEN voting.is_approved = true
 			        WHEN $8 = 'rejected' THEN voting.is_approved = false
     				WHEN $8 = 'undetermined' THEN voting.is_approved IS NULL ELSE TRUE END) AND
-				voting.legislative_body_id = COALESCE($9, voting.legislative_body_id) AND
-				user_article.user_id = $10 AND user_article.view_later = true
-			GROUP BY article.id, user_article.rating, user_article.view_later, user_article.view_later_set_at
-			ORDER BY user_article.view_later_set_at DESC
-			OFFSET $11 LIMIT $12`
+				voting.legislative_body_id = COALESCE($9, voting.legislative_body_id)`, listing.joins)
 }
 
-func (articleSelectSqlManager) EventsBookmarkedToViewLater() string {
-	return `SELECT article.id AS article_id, COALESCE(user_article.rating, 0) AS user_article_rating,
-				COALESCE(user_article.view_later, false) AS user_article_view_later
-			FROM article
+func getUserEventsSource(listing userArticleListing) string {
+	return fmt.Sprintf(`FROM article
 				INNER JOIN article_type ON article_type.id = article.article_type_id
 				INNER JOIN event ON event.article_id = article.id
 				INNER JOIN event_type ON event_type.id = event.event_type_id
 				INNER JOIN event_situation ON event_situation.id = event.event_situation_id
 				INNER JOIN event_legislative_body ON event_legislative_body.event_id = event.id
 				LEFT JOIN event_agenda_item ON event_agenda_item.event_id = event.id
-				LEFT JOIN user_article ON user_article.article_id = article.id
+				%s
 			WHERE article.active = true AND article_type.active = true AND event.active = true AND
 				event_type.active = true AND event_situation.active = true AND event_legislative_body.active = true AND
-				event_agenda_item.active IS NOT false AND user_article.active IS NOT false AND
+				event_agenda_item.active IS NOT false AND
 				article_type.id = COALESCE($1, article_type.id) AND event_type.id = COALESCE($2, event_type.id) AND
 				(event.title ILIKE $3 OR event.description ILIKE $3) AND
 				DATE_TRUNC('day', article.created_at) >= DATE_TRUNC('day', COALESCE($4, article.created_at)) AND
@@ -1118,9 +1169,166 @@ func (articleSelectSqlManager) EventsBookmarkedToViewLater() string {
 				DATE_TRUNC('day', event.starts_at) <= DATE_TRUNC('day', COALESCE($7, event.starts_at)) AND
 				event_situation.id = COALESCE($8, event_situation.id) AND
 				event_legislative_body.legislative_body_id = COALESCE($9, event_legislative_body.legislative_body_id) AND
-				($10::uuid IS NULL OR event_agenda_item.rapporteur_id = COALESCE($10, event_agenda_item.rapporteur_id)) AND
-				user_article.user_id = $11 AND user_article.view_later = true
-			GROUP BY article.id, user_article.rating, user_article.view_later, user_article.view_later_set_at
-			ORDER BY user_article.view_later_set_at DESC
-			OFFSET $12 LIMIT $13`
+				($10::uuid IS NULL OR event_agenda_item.rapporteur_id = COALESCE($10, event_agenda_item.rapporteur_id))`,
+		listing.joins)
+}
+
+func trendingArticleViews(usePrecomputedScores bool) string {
+	if usePrecomputedScores {
+		return "COALESCE(article_trending_score.views, 0)"
+	}
+
+	return "COALESCE(MAX(trending_article_view.views), 0)"
+}
+
+func trendingArticleRatings(usePrecomputedScores bool) string {
+	// Without grouping, the ratings are only calculated for the articles of the requested page
+	if usePrecomputedScores {
+		return `COALESCE((SELECT AVG(user_article.rating) FROM user_article
+					WHERE user_article.article_id = article.id AND user_article.active = true), 0)
+					AS article_average_rating,
+				(SELECT COUNT(user_article.rating) FROM user_article
+					WHERE user_article.article_id = article.id AND user_article.active = true)
+					AS article_number_of_ratings`
+	}
+
+	return `COALESCE(AVG(user_article.rating), 0) AS article_average_rating,
+				COUNT(user_article.rating) AS article_number_of_ratings`
+}
+
+// trendingArticleSource keeps the articles without a precomputed score, since only the articles viewed or rated
+// within the window have one, so that they are listed after the scored articles by date
+func trendingArticleSource(usePrecomputedScores bool, windowParameter int) string {
+	if usePrecomputedScores {
+		return fmt.Sprintf(`article
+				LEFT JOIN article_trending_score ON article_trending_score.article_id = article.id AND
+					article_trending_score.trending_window = $%d`, windowParameter)
+	}
+
+	return "article"
+}
+
+func trendingArticleJoins(usePrecomputedScores bool, windowParameter int) string {
+	if usePrecomputedScores {
+		return ""
+	}
+
+	return fmt.Sprintf(`LEFT JOIN user_article ON user_article.article_id = article.id AND user_article.active = true
+				LEFT JOIN (
+					SELECT article_view.article_id, COUNT(article_view.id) AS views,
+						SUM(POWER(0.5, EXTRACT(EPOCH FROM TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) -
+						article_view.created_at) / (EXTRACT(EPOCH FROM $%[1]d::INTERVAL) / 4))) AS decayed_views
+					FROM article_view
+					WHERE article_view.created_at >= TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) - $%[1]d::INTERVAL
+					GROUP BY article_view.article_id
+				) AS trending_article_view ON trending_article_view.article_id = article.id`, windowParameter)
+}
+
+func trendingArticleGrouping(usePrecomputedScores bool, groupingColumns string) string {
+	if usePrecomputedScores {
+		return ""
+	}
+
+	return fmt.Sprint("GROUP BY ", groupingColumns)
+}
+
+func trendingScore(usePrecomputedScores bool, firstWeightParameter int) string {
+	if usePrecomputedScores {
+		return "COALESCE(article_trending_score.score, 0)"
+	}
+
+	return fmt.Sprintf(`($%d * COALESCE(MAX(trending_article_view.decayed_views), 0) +
+				$%d * COALESCE(AVG(user_article.rating), 0) + $%d * LN(1 + COUNT(user_article.rating)))`,
+		firstWeightParameter, firstWeightParameter+1, firstWeightParameter+2)
+}
+
+func getCreationPeriodCondition(createdAfterParameter int) string {
+	return fmt.Sprintf(`($%[1]d::timestamp IS NULL OR article.created_at > $%[1]d::timestamp) AND
+				($%[2]d::timestamp IS NULL OR article.created_at <= $%[2]d::timestamp)`, createdAfterParameter,
+		createdAfterParameter+1)
+}
+
+func getFollowedArticlesCondition(followerParameter int) string {
+	return fmt.Sprintf(`($%[1]d::uuid IS NULL OR EXISTS (SELECT 1 FROM user_follow
+					WHERE user_follow.active = true AND user_follow.user_id = $%[1]d::uuid AND
+					(EXISTS (SELECT 1 FROM proposition followed_proposition
+						INNER JOIN proposition_author followed_proposition_author
+							ON followed_proposition_author.proposition_id = followed_proposition.id
+					WHERE followed_proposition.article_id = article.id AND followed_proposition.active = true AND
+						followed_proposition_author.active = true AND
+						((user_follow.resource_type = 'deputy' AND
+						followed_proposition_author.deputy_id = user_follow.resource_id) OR
+						(user_follow.resource_type = 'party' AND
+						followed_proposition_author.party_id = user_follow.resource_id) OR
+						(user_follow.resource_type = 'external_author' AND
+						followed_proposition_author.external_author_id = user_follow.resource_id))) OR
+					(user_follow.resource_type = 'proposition_type' AND EXISTS (SELECT 1 FROM proposition followed_proposition
+					WHERE followed_proposition.article_id = article.id AND followed_proposition.active = true AND
+						followed_proposition.proposition_type_id = user_follow.resource_id)) OR
+					(user_follow.resource_type = 'legislative_body' AND EXISTS (SELECT 1 FROM voting followed_voting
+					WHERE followed_voting.article_id = article.id AND followed_voting.active = true AND
+						followed_voting.legislative_body_id = user_follow.resource_id)) OR
+					(user_follow.resource_type = 'legislative_body' AND EXISTS (SELECT 1 FROM event followed_event
+						INNER JOIN event_legislative_body followed_event_legislative_body
+							ON followed_event_legislative_body.event_id = followed_event.id
+					WHERE followed_event.article_id = article.id AND followed_event.active = true AND
+						followed_event_legislative_body.active = true AND
+						followed_event_legislative_body.legislative_body_id = user_follow.resource_id)) OR
+					(user_follow.resource_type = 'deputy' AND EXISTS (SELECT 1 FROM event followed_event
+						INNER JOIN event_agenda_item followed_event_agenda_item
+							ON followed_event_agenda_item.event_id = followed_event.id
+					WHERE followed_event.article_id = article.id AND followed_event.active = true AND
+						followed_event_agenda_item.active = true AND
+						followed_event_agenda_item.rapporteur_id = user_follow.resource_id)) OR
+					EXISTS (SELECT 1 FROM voting followed_voting
+						INNER JOIN deputy_vote followed_deputy_vote ON followed_deputy_vote.voting_id = followed_voting.id
+					WHERE followed_voting.article_id = article.id AND followed_voting.active = true AND
+						followed_deputy_vote.active = true AND
+						((user_follow.resource_type = 'deputy' AND
+						followed_deputy_vote.deputy_id = user_follow.resource_id) OR
+						(user_follow.resource_type = 'party' AND
+						followed_deputy_vote.party_id = user_follow.resource_id))) OR
+					EXISTS (SELECT 1 FROM voting followed_voting
+						INNER JOIN proposition followed_voting_proposition
+							ON followed_voting_proposition.active = true AND
+							(followed_voting_proposition.id = followed_voting.main_proposition_id OR
+							EXISTS (SELECT 1 FROM proposition_related_to_voting followed_related_proposition
+							WHERE followed_related_proposition.voting_id = followed_voting.id AND
+								followed_related_proposition.active = true AND
+								followed_related_proposition.proposition_id = followed_voting_proposition.id))
+					WHERE followed_voting.article_id = article.id AND followed_voting.active = true AND
+						((user_follow.resource_type = 'proposition_type' AND
+						followed_voting_proposition.proposition_type_id = user_follow.resource_id) OR
+						EXISTS (SELECT 1 FROM proposition_author followed_voting_proposition_author
+						WHERE followed_voting_proposition_author.proposition_id = followed_voting_proposition.id AND
+							followed_voting_proposition_author.active = true AND
+							((user_follow.resource_type = 'deputy' AND
+							followed_voting_proposition_author.deputy_id = user_follow.resource_id) OR
+							(user_follow.resource_type = 'party' AND
+							followed_voting_proposition_author.party_id = user_follow.resource_id) OR
+							(user_follow.resource_type = 'external_author' AND
+							followed_voting_proposition_author.external_author_id = user_follow.resource_id))))) OR
+					(user_follow.resource_type = 'proposition' AND (article.id = user_follow.resource_id OR
+					EXISTS (SELECT 1 FROM proposition followed_proposition
+					WHERE followed_proposition.article_id = user_follow.resource_id AND followed_proposition.active = true AND
+						(EXISTS (SELECT 1 FROM voting followed_voting
+						WHERE followed_voting.article_id = article.id AND followed_voting.active = true AND
+							(followed_voting.main_proposition_id = followed_proposition.id OR
+							EXISTS (SELECT 1 FROM proposition_related_to_voting followed_related_proposition
+							WHERE followed_related_proposition.voting_id = followed_voting.id AND
+								followed_related_proposition.active = true AND
+								followed_related_proposition.proposition_id = followed_proposition.id))) OR
+						EXISTS (SELECT 1 FROM event followed_event
+							INNER JOIN event_agenda_item followed_event_agenda_item
+								ON followed_event_agenda_item.event_id = followed_event.id
+						WHERE followed_event.article_id = article.id AND followed_event.active = true AND
+							followed_event_agenda_item.active = true AND
+							(followed_event_agenda_item.proposition_id = followed_proposition.id OR
+							followed_event_agenda_item.related_proposition_id = followed_proposition.id)) OR
+						EXISTS (SELECT 1 FROM newsletter followed_newsletter
+							INNER JOIN newsletter_article followed_newsletter_article
+								ON followed_newsletter_article.newsletter_id = followed_newsletter.id
+						WHERE followed_newsletter.article_id = article.id AND followed_newsletter.active = true AND
+							followed_newsletter_article.active = true AND
+							followed_newsletter_article.article_id = user_follow.resource_id))))))))`, followerParameter)
 }
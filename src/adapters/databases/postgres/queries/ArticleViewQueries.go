@@ -7,6 +7,38 @@ func ArticleView() *articleViewSqlManager {
 }
 
 func (articleViewSqlManager) Insert() string {
-	return `INSERT INTO article_view(article_id, user_id)
-			VALUES ($1, $2)`
+	return `INSERT INTO article_view(article_id, user_id, created_at)
+			SELECT buffered_article_view.article_id, buffered_article_view.user_id,
+				TIMEZONE('America/Sao_Paulo'::TEXT, buffered_article_view.viewed_at)
+			FROM UNNEST($1::UUID[], $2::UUID[], $3::TIMESTAMPTZ[])
+				AS buffered_article_view(article_id, user_id, viewed_at)
+				INNER JOIN article ON article.id = buffered_article_view.article_id`
+}
+
+type articleViewSelectSqlManager struct{}
+
+func (articleViewSqlManager) Select() *articleViewSelectSqlManager {
+	return &articleViewSelectSqlManager{}
+}
+
+func (articleViewSelectSqlManager) Article() string {
+	return `SELECT article.id
+			FROM article
+			WHERE article.active = true AND article.id = $1`
+}
+
+type articleViewUpdateSqlManager struct{}
+
+func (articleViewSqlManager) Update() *articleViewUpdateSqlManager {
+	return &articleViewUpdateSqlManager{}
+}
+
+func (articleViewUpdateSqlManager) AnonymizeByUserIdAndArticleId() string {
+	return `UPDATE article_view SET user_id = NULL
+			WHERE user_id = $1 AND article_id = $2`
+}
+
+func (articleViewUpdateSqlManager) AnonymizeByUserId() string {
+	return `UPDATE article_view SET user_id = NULL
+			WHERE user_id = $1`
 }
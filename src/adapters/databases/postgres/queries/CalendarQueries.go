@@ -0,0 +1,104 @@
+package queries
+
+type calendarSqlManager struct{}
+
+func Calendar() *calendarSqlManager {
+	return &calendarSqlManager{}
+}
+
+type calendarInsertSqlManager struct{}
+
+func (calendarSqlManager) Insert() *calendarInsertSqlManager {
+	return &calendarInsertSqlManager{}
+}
+
+func (calendarInsertSqlManager) Token() string {
+	return `INSERT INTO user_calendar(user_id, token)
+			VALUES ($1, $2)`
+}
+
+type calendarSelectSqlManager struct{}
+
+func (calendarSqlManager) Select() *calendarSelectSqlManager {
+	return &calendarSelectSqlManager{}
+}
+
+func (calendarSelectSqlManager) Events() string {
+	return `SELECT article.id AS article_id, article.created_at AS article_created_at,
+				article.updated_at AS article_updated_at,
+				article_type.id AS article_type_id, article_type.description AS article_type_description,
+				article_type.codes AS article_type_codes, article_type.color AS article_type_color,
+				event.id AS event_id, event.code AS event_code, event.title AS event_title,
+				event.description AS event_description, event.starts_at AS event_starts_at,
+				COALESCE(event.ends_at, '0001-01-01 00:00:00') AS event_ends_at, event.location AS event_location,
+				event.is_internal AS event_is_internal, COALESCE(event.video_url, '') AS event_video_url,
+				event.created_at AS event_created_at, event.updated_at AS event_updated_at,
+				event_type.id AS event_type_id, event_type.description AS event_type_description,
+				event_type.color AS event_type_color,
+				event_situation.id AS event_situation_id, event_situation.description AS event_situation_description,
+				event_situation.color AS event_situation_color
+			FROM event
+				INNER JOIN article ON article.id = event.article_id
+				INNER JOIN article_type ON article_type.id = article.article_type_id
+				INNER JOIN event_type ON event_type.id = event.event_type_id
+				INNER JOIN event_situation ON event_situation.id = event.event_situation_id
+			WHERE article.active = true AND article_type.active = true AND event.active = true AND
+				event_type.active = true AND event_situation.active = true AND
+				event_type.id = COALESCE($1, event_type.id) AND event_situation.id = COALESCE($2, event_situation.id) AND
+				($3::uuid IS NULL OR EXISTS (SELECT 1 FROM event_legislative_body
+					WHERE event_legislative_body.event_id = event.id AND event_legislative_body.active = true AND
+						event_legislative_body.legislative_body_id = $3::uuid)) AND
+				($4::uuid IS NULL OR EXISTS (SELECT 1 FROM event_agenda_item
+					WHERE event_agenda_item.event_id = event.id AND event_agenda_item.active = true AND
+						event_agenda_item.rapporteur_id = $4::uuid)) AND
+				DATE_TRUNC('day', COALESCE(event.ends_at, event.starts_at)) >=
+				DATE_TRUNC('day', COALESCE($5, COALESCE(event.ends_at, event.starts_at))) AND
+				DATE_TRUNC('day', event.starts_at) <= DATE_TRUNC('day', COALESCE($6, event.starts_at)) AND
+				($7::uuid IS NULL OR EXISTS (SELECT 1 FROM user_follow
+					INNER JOIN event_legislative_body followed_event_legislative_body
+						ON followed_event_legislative_body.legislative_body_id = user_follow.resource_id
+				WHERE user_follow.active = true AND user_follow.user_id = $7::uuid AND
+					user_follow.resource_type = 'legislative_body' AND
+					followed_event_legislative_body.event_id = event.id AND
+					followed_event_legislative_body.active = true))
+			ORDER BY event.starts_at DESC
+			LIMIT $8`
+}
+
+func (calendarSelectSqlManager) TokenByUserId() string {
+	return `SELECT user_calendar.token
+			FROM user_calendar
+				INNER JOIN "user" ON "user".id = user_calendar.user_id
+			WHERE user_calendar.active = true AND "user".active = true AND user_calendar.user_id = $1`
+}
+
+func (calendarSelectSqlManager) UserIdByToken() string {
+	return `SELECT user_calendar.user_id
+			FROM user_calendar
+				INNER JOIN "user" ON "user".id = user_calendar.user_id
+			WHERE user_calendar.active = true AND "user".active = true AND user_calendar.token = $1`
+}
+
+type calendarUpdateSqlManager struct{}
+
+func (calendarSqlManager) Update() *calendarUpdateSqlManager {
+	return &calendarUpdateSqlManager{}
+}
+
+func (calendarUpdateSqlManager) Token() string {
+	return `UPDATE user_calendar
+			SET token = $1, active = true, updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			WHERE user_id = $2`
+}
+
+type calendarDeleteSqlManager struct{}
+
+func (calendarSqlManager) Delete() *calendarDeleteSqlManager {
+	return &calendarDeleteSqlManager{}
+}
+
+func (calendarDeleteSqlManager) Token() string {
+	return `UPDATE user_calendar
+			SET active = false, updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			WHERE active = true AND user_id = $1`
+}
@@ -1,5 +1,7 @@
 package queries
 
+import "fmt"
+
 type deputySqlManager struct{}
 
 func Deputy() *deputySqlManager {
@@ -22,3 +24,194 @@ func (deputySelectSqlManager) All() string {
     		WHERE deputy.active = true AND party.active = true
     		ORDER BY deputy.electoral_name, party.acronym`
 }
+
+func (deputySelectSqlManager) ById() string {
+	return `SELECT deputy.id AS deputy_id, deputy.name AS deputy_name, deputy.electoral_name AS deputy_electoral_name,
+       			deputy.image_url AS deputy_image_url, deputy.federated_unit AS deputy_federated_unit,
+       			COALESCE(previous_affiliation.federated_unit, '') AS deputy_previous_federated_unit,
+        		party.id AS party_id, party.name AS party_name, party.acronym AS party_acronym,
+        		party.image_url AS party_image_url,
+        		COALESCE(previous_party.id, '00000000-0000-0000-0000-000000000000') AS previous_party_id,
+        		COALESCE(previous_party.name, '') AS previous_party_name,
+        		COALESCE(previous_party.acronym, '') AS previous_party_acronym,
+        		COALESCE(previous_party.image_url, '') AS previous_party_image_url
+    		FROM deputy
+    			INNER JOIN party ON party.id = deputy.party_id
+    			LEFT JOIN LATERAL (
+    				SELECT proposition_author.party_id, proposition_author.federated_unit
+    				FROM proposition_author
+    					INNER JOIN proposition ON proposition.id = proposition_author.proposition_id
+    				WHERE proposition_author.active = true AND proposition.active = true AND
+    					proposition_author.deputy_id = deputy.id AND
+    					(proposition_author.party_id <> deputy.party_id OR
+    					proposition_author.federated_unit <> deputy.federated_unit)
+    				ORDER BY proposition.submitted_at DESC
+    				LIMIT 1) AS previous_affiliation ON true
+    			LEFT JOIN party previous_party ON previous_party.id = previous_affiliation.party_id AND
+    				previous_party.active = true
+    		WHERE deputy.active = true AND party.active = true AND deputy.id = $1`
+}
+
+func (deputySelectSqlManager) PropositionTypes() string {
+	return `SELECT proposition_type.id AS proposition_type_id,
+				proposition_type.description AS proposition_type_description,
+				proposition_type.color AS proposition_type_color,
+				COUNT(DISTINCT proposition.id) AS number_of_propositions
+			FROM proposition_author
+				INNER JOIN proposition ON proposition.id = proposition_author.proposition_id
+				INNER JOIN article ON article.id = proposition.article_id
+				INNER JOIN proposition_type ON proposition_type.id = proposition.proposition_type_id
+			WHERE proposition_author.active = true AND proposition.active = true AND article.active = true AND
+				proposition_type.active = true AND proposition_author.deputy_id = $1
+			GROUP BY proposition_type.id
+			ORDER BY number_of_propositions DESC, proposition_type.description`
+}
+
+func (deputySelectSqlManager) Statistics() string {
+	return fmt.Sprintf(`%s
+			SELECT (SELECT COUNT(*) FROM author_proposition) AS number_of_propositions,
+				(SELECT COUNT(*)
+				 FROM event_agenda_item
+				 	INNER JOIN agenda_item_regime ON agenda_item_regime.id = event_agenda_item.agenda_item_regime_id
+				 	INNER JOIN proposition ON proposition.id = event_agenda_item.proposition_id
+				 	INNER JOIN event ON event.id = event_agenda_item.event_id
+				 	INNER JOIN article ON article.id = event.article_id
+				 WHERE event_agenda_item.active = true AND agenda_item_regime.active = true AND
+				 	proposition.active = true AND event.active = true AND article.active = true AND
+				 	event_agenda_item.rapporteur_id = $1) AS number_of_rapporteurships,
+				(SELECT COUNT(*) FROM author_proposition_voting) AS number_of_voted_propositions,
+				(SELECT COUNT(*) FROM author_proposition_voting
+				 WHERE author_proposition_voting.is_approved = true) AS number_of_approved_propositions`,
+		getAuthorPropositionsCommonTableExpressions("deputy_id"))
+}
+
+func (deputySelectSqlManager) Rapporteurships() string {
+	return `SELECT event_agenda_item.id AS rapporteurship_id, event_agenda_item.title AS rapporteurship_title,
+				COALESCE(event_agenda_item.topic, '') AS rapporteurship_topic,
+				agenda_item_regime.description AS rapporteurship_regime,
+				COALESCE(event_agenda_item.situation, '') AS rapporteurship_situation,
+				proposition.article_id AS rapporteurship_proposition_article_id,
+				proposition.title AS rapporteurship_proposition_title,
+				event.article_id AS rapporteurship_event_article_id, event.title AS rapporteurship_event_title,
+				event.starts_at AS rapporteurship_event_starts_at
+			FROM event_agenda_item
+				INNER JOIN agenda_item_regime ON agenda_item_regime.id = event_agenda_item.agenda_item_regime_id
+				INNER JOIN proposition ON proposition.id = event_agenda_item.proposition_id
+				INNER JOIN event ON event.id = event_agenda_item.event_id
+				INNER JOIN article ON article.id = event.article_id
+			WHERE event_agenda_item.active = true AND agenda_item_regime.active = true AND
+				proposition.active = true AND event.active = true AND article.active = true AND
+				event_agenda_item.rapporteur_id = $1
+			ORDER BY event.starts_at DESC, event_agenda_item.title
+			OFFSET $2 LIMIT $3`
+}
+
+func (deputySelectSqlManager) NumberOfRapporteurships() string {
+	return `SELECT COUNT(*)
+			FROM event_agenda_item
+				INNER JOIN agenda_item_regime ON agenda_item_regime.id = event_agenda_item.agenda_item_regime_id
+				INNER JOIN proposition ON proposition.id = event_agenda_item.proposition_id
+				INNER JOIN event ON event.id = event_agenda_item.event_id
+				INNER JOIN article ON article.id = event.article_id
+			WHERE event_agenda_item.active = true AND agenda_item_regime.active = true AND
+				proposition.active = true AND event.active = true AND article.active = true AND
+				event_agenda_item.rapporteur_id = $1`
+}
+
+func (deputySelectSqlManager) ByPartyId() string {
+	return `SELECT deputy.id AS deputy_id, deputy.name AS deputy_name, deputy.electoral_name AS deputy_electoral_name,
+       			deputy.image_url AS deputy_image_url, deputy.federated_unit AS deputy_federated_unit,
+        		party.id AS party_id, party.name AS party_name, party.acronym AS party_acronym,
+        		party.image_url AS party_image_url
+    		FROM deputy
+    			INNER JOIN party ON party.id = deputy.party_id
+    		WHERE deputy.active = true AND party.active = true AND party.id = $1
+    		ORDER BY deputy.electoral_name`
+}
+
+func (deputySelectSqlManager) VotingRecords() string {
+	return `SELECT deputy_vote.id AS voting_record_id, deputy_vote.vote AS voting_record_vote,
+				COALESCE(party_orientation.orientation, '') AS voting_record_party_orientation,
+				voting.article_id AS voting_record_voting_article_id, voting.id AS voting_id,
+				voting.code AS voting_code, voting.result AS voting_result,
+				voting.result_announced_at AS voting_result_announced_at, voting.is_approved AS voting_is_approved,
+				legislative_body.id AS legislative_body_id, legislative_body.name AS legislative_body_name,
+				legislative_body.acronym AS legislative_body_acronym,
+				legislative_body_type.id AS legislative_body_type_id,
+				legislative_body_type.description AS legislative_body_type_description,
+				party.id AS party_id, COALESCE(party.name, '') AS party_name,
+				COALESCE(party.acronym, '') AS party_acronym, COALESCE(party.image_url, '') AS party_image_url
+			FROM deputy_vote
+				INNER JOIN voting ON voting.id = deputy_vote.voting_id
+				INNER JOIN article ON article.id = voting.article_id
+				INNER JOIN legislative_body ON legislative_body.id = voting.legislative_body_id
+				INNER JOIN legislative_body_type ON legislative_body_type.id = legislative_body.legislative_body_type_id
+				LEFT JOIN party ON party.id = deputy_vote.party_id
+				LEFT JOIN party_orientation ON party_orientation.voting_id = deputy_vote.voting_id AND
+					party_orientation.party_id = deputy_vote.party_id AND party_orientation.active = true
+			WHERE deputy_vote.active = true AND voting.active = true AND article.active = true AND
+				legislative_body.active = true AND legislative_body_type.active = true AND
+				deputy_vote.deputy_id = $1 AND
+				DATE_TRUNC('day', voting.result_announced_at) >= DATE_TRUNC('day',
+				COALESCE($2, voting.result_announced_at)) AND
+				DATE_TRUNC('day', voting.result_announced_at) <= DATE_TRUNC('day',
+				COALESCE($3, voting.result_announced_at)) AND
+			    (CASE WHEN $4 = 'approved' THEN voting.is_approved = true
+			        WHEN $4 = 'rejected' THEN voting.is_approved = false
+    				WHEN $4 = 'undetermined' THEN voting.is_approved IS NULL ELSE TRUE END) AND
+				voting.legislative_body_id = COALESCE($5, voting.legislative_body_id) AND
+				($6 = '' OR deputy_vote.vote = $6)
+			ORDER BY voting.result_announced_at DESC, voting.code
+			OFFSET $7 LIMIT $8`
+}
+
+func (deputySelectSqlManager) NumberOfVotingRecords() string {
+	return `SELECT COUNT(*)
+			FROM deputy_vote
+				INNER JOIN voting ON voting.id = deputy_vote.voting_id
+				INNER JOIN article ON article.id = voting.article_id
+				INNER JOIN legislative_body ON legislative_body.id = voting.legislative_body_id
+				INNER JOIN legislative_body_type ON legislative_body_type.id = legislative_body.legislative_body_type_id
+			WHERE deputy_vote.active = true AND voting.active = true AND article.active = true AND
+				legislative_body.active = true AND legislative_body_type.active = true AND
+				deputy_vote.deputy_id = $1 AND
+				DATE_TRUNC('day', voting.result_announced_at) >= DATE_TRUNC('day',
+				COALESCE($2, voting.result_announced_at)) AND
+				DATE_TRUNC('day', voting.result_announced_at) <= DATE_TRUNC('day',
+				COALESCE($3, voting.result_announced_at)) AND
+			    (CASE WHEN $4 = 'approved' THEN voting.is_approved = true
+			        WHEN $4 = 'rejected' THEN voting.is_approved = false
+    				WHEN $4 = 'undetermined' THEN voting.is_approved IS NULL ELSE TRUE END) AND
+				voting.legislative_body_id = COALESCE($5, voting.legislative_body_id) AND
+				($6 = '' OR deputy_vote.vote = $6)`
+}
+
+func (deputySelectSqlManager) Pairs() string {
+	return `WITH compared_deputy AS (
+				SELECT DISTINCT UNNEST($1::UUID[]) AS id)
+			SELECT first_deputy.id AS deputy_pair_first_deputy_id,
+				second_deputy.id AS deputy_pair_second_deputy_id,
+				(SELECT COUNT(DISTINCT proposition.id)
+				 FROM proposition_author first_author
+				 	INNER JOIN proposition_author second_author ON
+				 		second_author.proposition_id = first_author.proposition_id
+				 	INNER JOIN proposition ON proposition.id = first_author.proposition_id
+				 	INNER JOIN article ON article.id = proposition.article_id
+				 WHERE first_author.active = true AND second_author.active = true AND proposition.active = true AND
+				 	article.active = true AND first_author.deputy_id = first_deputy.id AND
+				 	second_author.deputy_id = second_deputy.id) AS deputy_pair_number_of_co_authored_propositions,
+				common_vote.number_of_common_votes AS deputy_pair_number_of_common_votes,
+				common_vote.number_of_matching_votes AS deputy_pair_number_of_matching_votes
+			FROM compared_deputy first_deputy
+				INNER JOIN compared_deputy second_deputy ON second_deputy.id > first_deputy.id
+				INNER JOIN LATERAL (
+					SELECT COUNT(*) AS number_of_common_votes,
+						COUNT(*) FILTER (WHERE first_vote.vote = second_vote.vote) AS number_of_matching_votes
+					FROM deputy_vote first_vote
+						INNER JOIN deputy_vote second_vote ON second_vote.voting_id = first_vote.voting_id
+						INNER JOIN voting ON voting.id = first_vote.voting_id
+					WHERE first_vote.active = true AND second_vote.active = true AND voting.active = true AND
+						first_vote.deputy_id = first_deputy.id AND second_vote.deputy_id = second_deputy.id AND
+						first_vote.vote <> 'absent' AND second_vote.vote <> 'absent') AS common_vote ON true
+			ORDER BY first_deputy.id, second_deputy.id`
+}
@@ -0,0 +1,113 @@
+package queries
+
+import "fmt"
+
+type emailDigestSqlManager struct{}
+
+func EmailDigest() *emailDigestSqlManager {
+	return &emailDigestSqlManager{}
+}
+
+type emailDigestInsertSqlManager struct{}
+
+func (emailDigestSqlManager) Insert() *emailDigestInsertSqlManager {
+	return &emailDigestInsertSqlManager{}
+}
+
+func (emailDigestInsertSqlManager) EmailDigest() string {
+	return `INSERT INTO email_digest(user_id, frequency, last_sent_at)
+			VALUES ($1, $2, TIMEZONE('America/Sao_Paulo'::TEXT, NOW()))`
+}
+
+type emailDigestSelectSqlManager struct{}
+
+func (emailDigestSqlManager) Select() *emailDigestSelectSqlManager {
+	return &emailDigestSelectSqlManager{}
+}
+
+func (emailDigestSelectSqlManager) ByUserId() string {
+	return `SELECT email_digest.frequency AS email_digest_frequency,
+				email_digest.last_sent_at AS email_digest_last_sent_at,
+				email_digest.created_at AS email_digest_created_at, email_digest.updated_at AS email_digest_updated_at,
+				"user".id AS user_id, "user".first_name AS user_first_name, "user".last_name AS user_last_name,
+				"user".email AS user_email
+			FROM email_digest
+				INNER JOIN "user" ON "user".id = email_digest.user_id
+			WHERE email_digest.active = true AND "user".active = true AND email_digest.user_id = $1`
+}
+
+func (emailDigestSelectSqlManager) Articles() string {
+	return fmt.Sprintf(`SELECT article.id AS article_id, COALESCE(user_article.rating, 0) AS user_article_rating,
+				COALESCE(user_article.view_later, false) AS user_article_view_later
+			FROM article
+				INNER JOIN article_type ON article_type.id = article.article_type_id
+				INNER JOIN email_digest ON email_digest.user_id = $1 AND email_digest.active = true
+				LEFT JOIN user_article ON user_article.article_id = article.id AND user_article.user_id = $1 AND
+					user_article.active = true
+			WHERE article.active = true AND article_type.active = true AND article.created_at > $2 AND
+				article.created_at <= email_digest.last_sent_at AND %s
+			ORDER BY article.reference_date_time DESC
+			LIMIT $3`, getFollowedArticlesCondition(1))
+}
+
+type emailDigestUpdateSqlManager struct{}
+
+func (emailDigestSqlManager) Update() *emailDigestUpdateSqlManager {
+	return &emailDigestUpdateSqlManager{}
+}
+
+func (emailDigestUpdateSqlManager) EmailDigest() string {
+	return `UPDATE email_digest
+			SET frequency = $1, active = true,
+				last_sent_at = CASE WHEN active = false THEN TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+					ELSE last_sent_at END,
+				updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			WHERE user_id = $2`
+}
+
+func (emailDigestUpdateSqlManager) PendingEmailDigests() string {
+	return `WITH pending_email_digest AS (
+				SELECT email_digest.id, email_digest.last_sent_at,
+					CASE WHEN email_digest.frequency = 'daily' THEN INTERVAL '1 day' ELSE INTERVAL '7 days' END
+					AS email_digest_period
+				FROM email_digest
+					INNER JOIN "user" ON "user".id = email_digest.user_id
+				WHERE email_digest.active = true AND "user".active = true AND email_digest.last_sent_at <=
+					TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) -
+					CASE WHEN email_digest.frequency = 'daily' THEN INTERVAL '1 day' ELSE INTERVAL '7 days' END
+				ORDER BY email_digest.last_sent_at
+				LIMIT $1
+				FOR UPDATE OF email_digest SKIP LOCKED
+			)
+			UPDATE email_digest
+			SET last_sent_at = CASE WHEN pending_email_digest.last_sent_at +
+				pending_email_digest.email_digest_period * 2 > TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+				THEN pending_email_digest.last_sent_at + pending_email_digest.email_digest_period
+				ELSE TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) END
+			FROM pending_email_digest, "user"
+			WHERE email_digest.id = pending_email_digest.id AND "user".id = email_digest.user_id
+			RETURNING email_digest.frequency AS email_digest_frequency,
+				pending_email_digest.last_sent_at AS email_digest_last_sent_at,
+				email_digest.last_sent_at AS email_digest_sent_at,
+				email_digest.created_at AS email_digest_created_at, email_digest.updated_at AS email_digest_updated_at,
+				"user".id AS user_id, "user".first_name AS user_first_name, "user".last_name AS user_last_name,
+				"user".email AS user_email`
+}
+
+func (emailDigestUpdateSqlManager) LastSentAt() string {
+	return `UPDATE email_digest
+			SET last_sent_at = $1
+			WHERE active = true AND user_id = $2`
+}
+
+type emailDigestDeleteSqlManager struct{}
+
+func (emailDigestSqlManager) Delete() *emailDigestDeleteSqlManager {
+	return &emailDigestDeleteSqlManager{}
+}
+
+func (emailDigestDeleteSqlManager) EmailDigest() string {
+	return `UPDATE email_digest
+			SET active = false, updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			WHERE active = true AND user_id = $1`
+}
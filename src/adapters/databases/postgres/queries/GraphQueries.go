@@ -0,0 +1,99 @@
+package queries
+
+import "fmt"
+
+type graphSqlManager struct{}
+
+func Graph() *graphSqlManager {
+	return &graphSqlManager{}
+}
+
+type graphSelectSqlManager struct{}
+
+func (graphSqlManager) Select() *graphSelectSqlManager {
+	return &graphSelectSqlManager{}
+}
+
+func (graphSelectSqlManager) CoAuthorshipNodes() string {
+	return fmt.Sprintf(`%s,
+			coauthorship_author_affiliation AS (
+				SELECT DISTINCT ON (coauthorship_author.author_id) coauthorship_author.author_id,
+					coauthorship_author.party_id, coauthorship_author.federated_unit
+				FROM coauthorship_author
+					INNER JOIN coauthorship_node ON coauthorship_node.author_id = coauthorship_author.author_id
+				ORDER BY coauthorship_author.author_id, coauthorship_author.submitted_at DESC)
+			SELECT coauthorship_node.author_id AS coauthorship_node_id,
+				COALESCE(deputy.electoral_name, external_author.name) AS coauthorship_node_label,
+				CASE WHEN deputy.id IS NOT NULL THEN 'deputy' ELSE 'external_author' END AS coauthorship_node_type,
+				COALESCE(party.acronym, '') AS coauthorship_node_party_acronym,
+				COALESCE(coauthorship_author_affiliation.federated_unit, '') AS coauthorship_node_federated_unit,
+				COALESCE(external_author_type.description, '') AS coauthorship_node_external_author_type,
+				coauthorship_node.number_of_propositions AS coauthorship_node_number_of_propositions
+			FROM coauthorship_node
+				INNER JOIN coauthorship_author_affiliation ON
+					coauthorship_author_affiliation.author_id = coauthorship_node.author_id
+				LEFT JOIN deputy ON deputy.id = coauthorship_node.author_id
+				LEFT JOIN party ON party.id = coauthorship_author_affiliation.party_id
+				LEFT JOIN external_author ON external_author.id = coauthorship_node.author_id
+				LEFT JOIN external_author_type ON external_author_type.id = external_author.external_author_type_id
+			ORDER BY coauthorship_node.number_of_propositions DESC, coauthorship_node_label`,
+		getCoAuthorshipCommonTableExpressions())
+}
+
+func (graphSelectSqlManager) CoAuthorshipEdges() string {
+	return fmt.Sprintf(`%s
+			SELECT coauthorship_edge.source_id AS coauthorship_edge_source_id,
+				coauthorship_edge.target_id AS coauthorship_edge_target_id,
+				coauthorship_edge.weight AS coauthorship_edge_weight
+			FROM coauthorship_edge
+				INNER JOIN coauthorship_node source_node ON source_node.author_id = coauthorship_edge.source_id
+				INNER JOIN coauthorship_node target_node ON target_node.author_id = coauthorship_edge.target_id
+			ORDER BY coauthorship_edge.weight DESC, coauthorship_edge.source_id, coauthorship_edge.target_id`,
+		getCoAuthorshipCommonTableExpressions())
+}
+
+// getCoAuthorshipCommonTableExpressions keeps only the collaborations with at least $5 propositions and the $6
+// authors with the most propositions among them, so that the size of the graph is bounded
+func getCoAuthorshipCommonTableExpressions() string {
+	return `WITH coauthorship_proposition AS (
+				SELECT proposition.id, proposition.submitted_at
+				FROM proposition
+					INNER JOIN article ON article.id = proposition.article_id
+				WHERE proposition.active = true AND article.active = true AND
+					proposition.submitted_at >= $1::DATE AND proposition.submitted_at < $2::DATE + 1 AND
+					($3::UUID IS NULL OR EXISTS (SELECT 1 FROM proposition_author
+					WHERE proposition_author.active = true AND proposition_author.party_id = $3::UUID AND
+						proposition_author.proposition_id = proposition.id)) AND
+					proposition.proposition_type_id = COALESCE($4, proposition.proposition_type_id)),
+			coauthorship_author AS (
+				SELECT DISTINCT ON (proposition_author.proposition_id,
+					COALESCE(proposition_author.deputy_id, proposition_author.external_author_id))
+					proposition_author.proposition_id,
+					COALESCE(proposition_author.deputy_id, proposition_author.external_author_id) AS author_id,
+					proposition_author.party_id, proposition_author.federated_unit,
+					coauthorship_proposition.submitted_at
+				FROM proposition_author
+					INNER JOIN coauthorship_proposition ON
+						coauthorship_proposition.id = proposition_author.proposition_id
+					LEFT JOIN deputy ON deputy.id = proposition_author.deputy_id
+					LEFT JOIN external_author ON external_author.id = proposition_author.external_author_id
+				WHERE proposition_author.active = true AND (deputy.active = true OR external_author.active = true)),
+			coauthorship_edge AS (
+				SELECT first_author.author_id AS source_id, second_author.author_id AS target_id,
+					COUNT(*) AS weight
+				FROM coauthorship_author first_author
+					INNER JOIN coauthorship_author second_author ON
+						second_author.proposition_id = first_author.proposition_id AND
+						second_author.author_id > first_author.author_id
+				GROUP BY first_author.author_id, second_author.author_id
+				HAVING COUNT(*) >= $5),
+			coauthorship_node AS (
+				SELECT coauthorship_author.author_id, COUNT(*) AS number_of_propositions
+				FROM coauthorship_author
+				WHERE EXISTS (SELECT 1 FROM coauthorship_edge
+					WHERE coauthorship_edge.source_id = coauthorship_author.author_id OR
+						coauthorship_edge.target_id = coauthorship_author.author_id)
+				GROUP BY coauthorship_author.author_id
+				ORDER BY number_of_propositions DESC, coauthorship_author.author_id
+				LIMIT $6)`
+}
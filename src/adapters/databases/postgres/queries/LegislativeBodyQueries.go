@@ -36,3 +36,47 @@ func (legislativeBodySelectSqlManager) LegislativeBodiesByEventId() string {
 			WHERE legislative_body.active = true AND legislative_body_type.active = true AND
 				event_legislative_body.active = true AND event_legislative_body.event_id = $1`
 }
+
+func (legislativeBodySelectSqlManager) ById() string {
+	return `SELECT legislative_body.id AS legislative_body_id,
+       			legislative_body.name AS legislative_body_name,
+				legislative_body.acronym AS legislative_body_acronym,
+				legislative_body_type.id AS legislative_body_type_id,
+				legislative_body_type.description AS legislative_body_type_description
+			FROM legislative_body
+				INNER JOIN legislative_body_type ON legislative_body_type.id = legislative_body.legislative_body_type_id
+			WHERE legislative_body.active = true AND legislative_body_type.active = true AND legislative_body.id = $1`
+}
+
+func (legislativeBodySelectSqlManager) UpcomingEvents() string {
+	return `SELECT article.id AS article_id
+			FROM article
+				INNER JOIN article_type ON article_type.id = article.article_type_id
+				INNER JOIN event ON event.article_id = article.id
+				INNER JOIN event_legislative_body ON event_legislative_body.event_id = event.id
+			WHERE article.active = true AND article_type.active = true AND event.active = true AND
+				event_legislative_body.active = true AND event_legislative_body.legislative_body_id = $1 AND
+				DATE_TRUNC('day', COALESCE(event.ends_at, event.starts_at)) >= DATE_TRUNC('day', $2::timestamp) AND
+				DATE_TRUNC('day', event.starts_at) <= DATE_TRUNC('day', $3::timestamp)
+			ORDER BY event.starts_at, article.id
+			LIMIT $4`
+}
+
+func (legislativeBodySelectSqlManager) FrequentRapporteurs() string {
+	return `SELECT deputy.id AS deputy_id, deputy.name AS deputy_name, deputy.electoral_name AS deputy_electoral_name,
+       			deputy.image_url AS deputy_image_url, deputy.federated_unit AS deputy_federated_unit,
+        		party.id AS party_id, party.name AS party_name, party.acronym AS party_acronym,
+        		party.image_url AS party_image_url, COUNT(DISTINCT event_agenda_item.id) AS number_of_rapporteurships
+			FROM event_agenda_item
+				INNER JOIN event ON event.id = event_agenda_item.event_id
+				INNER JOIN article ON article.id = event.article_id
+				INNER JOIN event_legislative_body ON event_legislative_body.event_id = event.id
+				INNER JOIN deputy ON deputy.id = event_agenda_item.rapporteur_id
+				INNER JOIN party ON party.id = deputy.party_id
+			WHERE event_agenda_item.active = true AND event.active = true AND article.active = true AND
+				event_legislative_body.active = true AND deputy.active = true AND party.active = true AND
+				event_legislative_body.legislative_body_id = $1
+			GROUP BY deputy.id, party.id
+			ORDER BY number_of_rapporteurships DESC, deputy.electoral_name
+			LIMIT $2`
+}
@@ -0,0 +1,123 @@
+package queries
+
+type notificationSqlManager struct{}
+
+func Notification() *notificationSqlManager {
+	return &notificationSqlManager{}
+}
+
+type notificationInsertSqlManager struct{}
+
+func (notificationSqlManager) Insert() *notificationInsertSqlManager {
+	return &notificationInsertSqlManager{}
+}
+
+func (notificationInsertSqlManager) PropositionUpdates() string {
+	return `INSERT INTO notification(user_id, type, article_id, proposition_article_id)
+			SELECT DISTINCT user_follow.user_id, proposition_update.notification_type, proposition_update.article_id,
+				proposition_update.proposition_article_id
+			FROM (
+				SELECT 'proposition_voting' AS notification_type, article.id AS article_id,
+					proposition.article_id AS proposition_article_id, article.created_at
+				FROM article
+					INNER JOIN voting ON voting.article_id = article.id
+					LEFT JOIN proposition_related_to_voting ON proposition_related_to_voting.voting_id = voting.id AND
+						proposition_related_to_voting.active = true
+					INNER JOIN proposition ON proposition.id = voting.main_proposition_id OR
+						proposition.id = proposition_related_to_voting.proposition_id
+				WHERE article.active = true AND voting.active = true AND proposition.active = true AND
+					article.created_at >= TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) - $1::INTERVAL
+				UNION
+				SELECT 'proposition_event' AS notification_type, article.id AS article_id,
+					proposition.article_id AS proposition_article_id, article.created_at
+				FROM article
+					INNER JOIN event ON event.article_id = article.id
+					INNER JOIN event_agenda_item ON event_agenda_item.event_id = event.id
+					INNER JOIN proposition ON proposition.id = event_agenda_item.proposition_id OR
+						proposition.id = event_agenda_item.related_proposition_id
+				WHERE article.active = true AND event.active = true AND event_agenda_item.active = true AND
+					proposition.active = true AND
+					article.created_at >= TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) - $1::INTERVAL
+				UNION
+				SELECT 'proposition_newsletter' AS notification_type, article.id AS article_id,
+					newsletter_article.article_id AS proposition_article_id, article.created_at
+				FROM article
+					INNER JOIN newsletter ON newsletter.article_id = article.id
+					INNER JOIN newsletter_article ON newsletter_article.newsletter_id = newsletter.id
+					INNER JOIN proposition ON proposition.article_id = newsletter_article.article_id
+				WHERE article.active = true AND newsletter.active = true AND newsletter_article.active = true AND
+					proposition.active = true AND
+					article.created_at >= TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) - $1::INTERVAL
+			) AS proposition_update
+				INNER JOIN user_follow ON user_follow.resource_type = 'proposition' AND
+					user_follow.resource_id = proposition_update.proposition_article_id AND user_follow.active = true AND
+					user_follow.created_at <= proposition_update.created_at
+				LEFT JOIN notification_preference ON notification_preference.user_id = user_follow.user_id AND
+					notification_preference.type = proposition_update.notification_type
+			WHERE notification_preference.enabled IS NOT false
+			ON CONFLICT (user_id, type, article_id, proposition_article_id) DO NOTHING`
+}
+
+func (notificationInsertSqlManager) Preference() string {
+	return `INSERT INTO notification_preference(user_id, type, enabled) VALUES ($1, $2, $3)
+			ON CONFLICT (user_id, type) DO UPDATE
+			SET enabled = EXCLUDED.enabled, updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())`
+}
+
+type notificationSelectSqlManager struct{}
+
+func (notificationSqlManager) Select() *notificationSelectSqlManager {
+	return &notificationSelectSqlManager{}
+}
+
+func (notificationSelectSqlManager) ByUserId() string {
+	return `SELECT notification.id AS notification_id, notification.type AS notification_type,
+				notification.proposition_article_id AS notification_proposition_article_id,
+				proposition.title AS notification_proposition_title, notification.is_read AS notification_is_read,
+				COALESCE(notification.read_at, '0001-01-01 00:00:00') AS notification_read_at,
+				notification.created_at AS notification_created_at, notification.article_id AS article_id,
+				COALESCE(user_article.rating, 0) AS user_article_rating,
+				COALESCE(user_article.view_later, false) AS user_article_view_later
+			FROM notification
+				INNER JOIN article ON article.id = notification.article_id
+				INNER JOIN proposition ON proposition.article_id = notification.proposition_article_id
+				LEFT JOIN user_article ON user_article.article_id = article.id AND user_article.user_id = $1 AND
+					user_article.active = true
+			WHERE notification.active = true AND article.active = true AND proposition.active = true AND
+				notification.user_id = $1 AND ($2 = false OR notification.is_read = false)
+			ORDER BY notification.created_at DESC, notification.id
+			OFFSET $3 LIMIT $4`
+}
+
+func (notificationSelectSqlManager) NumberOfNotificationsByUserId() string {
+	return `SELECT COUNT(*) AS total, COUNT(*) FILTER (WHERE notification.is_read = false) AS unread
+			FROM notification
+				INNER JOIN article ON article.id = notification.article_id
+				INNER JOIN proposition ON proposition.article_id = notification.proposition_article_id
+			WHERE notification.active = true AND article.active = true AND proposition.active = true AND
+				notification.user_id = $1 AND ($2 = false OR notification.is_read = false)`
+}
+
+func (notificationSelectSqlManager) PreferencesByUserId() string {
+	return `SELECT type AS notification_preference_type, enabled AS notification_preference_enabled
+			FROM notification_preference
+			WHERE user_id = $1`
+}
+
+type notificationUpdateSqlManager struct{}
+
+func (notificationSqlManager) Update() *notificationUpdateSqlManager {
+	return &notificationUpdateSqlManager{}
+}
+
+func (notificationUpdateSqlManager) Read() string {
+	return `UPDATE notification
+			SET is_read = true, read_at = COALESCE(read_at, TIMEZONE('America/Sao_Paulo'::TEXT, NOW()))
+			WHERE active = true AND id = $1 AND user_id = $2`
+}
+
+func (notificationUpdateSqlManager) AllRead() string {
+	return `UPDATE notification
+			SET is_read = true, read_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			WHERE active = true AND is_read = false AND user_id = $1`
+}
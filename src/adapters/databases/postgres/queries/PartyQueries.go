@@ -1,5 +1,7 @@
 package queries
 
+import "fmt"
+
 type partySqlManager struct{}
 
 func Party() *partySqlManager {
@@ -18,3 +20,80 @@ func (partySelectSqlManager) All() string {
     		WHERE party.active = true
 			ORDER BY party.acronym, party.name`
 }
+
+func (partySelectSqlManager) ById() string {
+	return `SELECT id AS party_id, name AS party_name, acronym AS party_acronym, image_url AS party_image_url
+    		FROM party
+    		WHERE party.active = true AND party.id = $1`
+}
+
+func (partySelectSqlManager) PropositionTypes() string {
+	return `SELECT proposition_type.id AS proposition_type_id,
+				proposition_type.description AS proposition_type_description,
+				proposition_type.color AS proposition_type_color,
+				COUNT(DISTINCT proposition.id) AS number_of_propositions
+			FROM proposition_author
+				INNER JOIN proposition ON proposition.id = proposition_author.proposition_id
+				INNER JOIN article ON article.id = proposition.article_id
+				INNER JOIN proposition_type ON proposition_type.id = proposition.proposition_type_id
+			WHERE proposition_author.active = true AND proposition.active = true AND article.active = true AND
+				proposition_type.active = true AND proposition_author.party_id = $1
+			GROUP BY proposition_type.id
+			ORDER BY number_of_propositions DESC, proposition_type.description`
+}
+
+func (partySelectSqlManager) Statistics() string {
+	return fmt.Sprintf(`%s
+			SELECT (SELECT COUNT(*) FROM deputy WHERE deputy.active = true AND deputy.party_id = $1)
+					AS number_of_deputies,
+				(SELECT COUNT(*) FROM author_proposition) AS number_of_propositions,
+				(SELECT COUNT(*) FROM author_proposition_voting) AS number_of_voted_propositions,
+				(SELECT COUNT(*) FROM author_proposition_voting
+				 WHERE author_proposition_voting.is_approved = true) AS number_of_approved_propositions,
+				(SELECT COUNT(*) FROM author_proposition_voting
+				 WHERE author_proposition_voting.is_approved = false) AS number_of_rejected_propositions`,
+		getAuthorPropositionsCommonTableExpressions("party_id"))
+}
+
+func (partySelectSqlManager) MonthlyActivity() string {
+	return fmt.Sprintf(`%s,
+			activity_month AS (
+				SELECT GENERATE_SERIES(DATE_TRUNC('month', $2::timestamp),
+					DATE_TRUNC('month', TIMEZONE('America/Sao_Paulo'::TEXT, NOW())), '1 month') AS month)
+			SELECT activity_month.month AS monthly_activity_month,
+				(SELECT COUNT(*) FROM author_proposition
+				 WHERE DATE_TRUNC('month', author_proposition.submitted_at) = activity_month.month)
+				 	AS monthly_activity_number_of_propositions,
+				(SELECT COUNT(*) FROM author_proposition_voting
+				 WHERE DATE_TRUNC('month', author_proposition_voting.result_announced_at) = activity_month.month)
+				 	AS monthly_activity_number_of_voted_propositions,
+				(SELECT COUNT(*) FROM author_proposition_voting
+				 WHERE author_proposition_voting.is_approved = true AND
+				 	DATE_TRUNC('month', author_proposition_voting.result_announced_at) = activity_month.month)
+				 	AS monthly_activity_number_of_approved_propositions,
+				(SELECT COUNT(*) FROM author_proposition_voting
+				 WHERE author_proposition_voting.is_approved = false AND
+				 	DATE_TRUNC('month', author_proposition_voting.result_announced_at) = activity_month.month)
+				 	AS monthly_activity_number_of_rejected_propositions
+			FROM activity_month
+			ORDER BY activity_month.month`, getAuthorPropositionsCommonTableExpressions("party_id"))
+}
+
+// getAuthorPropositionsCommonTableExpressions selects the propositions drafted by the author whose ID is in $1, using
+// the given column of proposition_author (deputy_id or party_id), and the latest voting with a result of each of them
+func getAuthorPropositionsCommonTableExpressions(authorColumn string) string {
+	return fmt.Sprintf(`WITH author_proposition AS (
+				SELECT DISTINCT proposition.id, proposition.submitted_at
+				FROM proposition_author
+					INNER JOIN proposition ON proposition.id = proposition_author.proposition_id
+					INNER JOIN article ON article.id = proposition.article_id
+				WHERE proposition_author.active = true AND proposition.active = true AND article.active = true AND
+					proposition_author.%s = $1),
+			author_proposition_voting AS (
+				SELECT DISTINCT ON (voting.main_proposition_id) voting.main_proposition_id, voting.is_approved,
+					voting.result_announced_at
+				FROM voting
+					INNER JOIN author_proposition ON author_proposition.id = voting.main_proposition_id
+				WHERE voting.active = true AND voting.is_approved IS NOT NULL
+				ORDER BY voting.main_proposition_id, voting.result_announced_at DESC)`, authorColumn)
+}
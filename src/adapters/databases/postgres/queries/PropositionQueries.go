@@ -37,3 +37,89 @@ func (propositionSelectSqlManager) ByArticleId() string {
 				proposition_type.active = true AND user_article.active IS NOT false AND article.id = $1
 			GROUP BY article.id, article_type.id, proposition.id, proposition_type.id`
 }
+
+func (propositionSelectSqlManager) TimelineByArticleId() string {
+	return `WITH timeline_proposition AS (
+				SELECT proposition.id, proposition.article_id, proposition.title, proposition.submitted_at
+				FROM proposition
+					INNER JOIN article ON article.id = proposition.article_id
+				WHERE article.active = true AND proposition.active = true AND article.id = $1),
+			timeline_voting AS (
+				SELECT voting.id
+				FROM voting
+					INNER JOIN timeline_proposition ON timeline_proposition.id = voting.main_proposition_id
+				WHERE voting.active = true
+				UNION
+				SELECT voting_id FROM proposition_related_to_voting
+					INNER JOIN timeline_proposition ON timeline_proposition.id = proposition_related_to_voting.proposition_id
+				WHERE proposition_related_to_voting.active = true
+				UNION
+				SELECT voting_id FROM proposition_affected_by_voting
+					INNER JOIN timeline_proposition ON timeline_proposition.id = proposition_affected_by_voting.proposition_id
+				WHERE proposition_affected_by_voting.active = true)
+			SELECT timeline_item_type, timeline_item_date, timeline_item_title, timeline_item_description,
+				timeline_item_article_id, timeline_item_legislative_body, timeline_item_agenda_item_regime,
+				timeline_item_agenda_item_situation, timeline_item_event_situation, timeline_item_voting_result,
+				timeline_item_voting_is_approved
+			FROM (
+				SELECT 'submission' AS timeline_item_type, 0 AS timeline_item_order,
+					timeline_proposition.submitted_at AS timeline_item_date,
+					timeline_proposition.title AS timeline_item_title, '' AS timeline_item_description,
+					timeline_proposition.article_id AS timeline_item_article_id, '' AS timeline_item_legislative_body,
+					'' AS timeline_item_agenda_item_regime, '' AS timeline_item_agenda_item_situation,
+					'' AS timeline_item_event_situation, '' AS timeline_item_voting_result,
+					NULL::boolean AS timeline_item_voting_is_approved
+				FROM timeline_proposition
+				UNION ALL
+				SELECT 'event_agenda_item' AS timeline_item_type, 1 AS timeline_item_order,
+					event.starts_at AS timeline_item_date, event.title AS timeline_item_title,
+					event_agenda_item.topic AS timeline_item_description,
+					event.article_id AS timeline_item_article_id,
+					COALESCE((SELECT STRING_AGG(legislative_body.acronym, ', ' ORDER BY legislative_body.acronym)
+						FROM event_legislative_body
+							INNER JOIN legislative_body
+								ON legislative_body.id = event_legislative_body.legislative_body_id
+						WHERE event_legislative_body.active = true AND legislative_body.active = true AND
+							event_legislative_body.event_id = event.id), '') AS timeline_item_legislative_body,
+					agenda_item_regime.description AS timeline_item_agenda_item_regime,
+					COALESCE(event_agenda_item.situation, '') AS timeline_item_agenda_item_situation,
+					event_situation.description AS timeline_item_event_situation,
+					'' AS timeline_item_voting_result, NULL::boolean AS timeline_item_voting_is_approved
+				FROM event_agenda_item
+					INNER JOIN timeline_proposition ON timeline_proposition.id = event_agenda_item.proposition_id OR
+						timeline_proposition.id = event_agenda_item.related_proposition_id
+					INNER JOIN event ON event.id = event_agenda_item.event_id
+					INNER JOIN article ON article.id = event.article_id
+					INNER JOIN event_situation ON event_situation.id = event.event_situation_id
+					INNER JOIN agenda_item_regime ON agenda_item_regime.id = event_agenda_item.agenda_item_regime_id
+				WHERE event_agenda_item.active = true AND event.active = true AND article.active = true AND
+					event_situation.active = true AND agenda_item_regime.active = true
+				UNION ALL
+				SELECT 'voting' AS timeline_item_type, 2 AS timeline_item_order,
+					voting.result_announced_at AS timeline_item_date, 'Votação ' || voting.code AS timeline_item_title,
+					COALESCE(voting.description, '') AS timeline_item_description, voting.article_id AS timeline_item_article_id,
+					legislative_body.acronym AS timeline_item_legislative_body, '' AS timeline_item_agenda_item_regime,
+					'' AS timeline_item_agenda_item_situation, '' AS timeline_item_event_situation,
+					COALESCE(voting.result, '') AS timeline_item_voting_result, voting.is_approved AS timeline_item_voting_is_approved
+				FROM voting
+					INNER JOIN timeline_voting ON timeline_voting.id = voting.id
+					INNER JOIN article ON article.id = voting.article_id
+					INNER JOIN legislative_body ON legislative_body.id = voting.legislative_body_id
+				WHERE voting.active = true AND article.active = true AND legislative_body.active = true
+				UNION ALL
+				SELECT 'newsletter' AS timeline_item_type, 3 AS timeline_item_order,
+					newsletter.reference_date AS timeline_item_date,
+					'Boletim do dia ' || TO_CHAR(newsletter.reference_date, 'DD/MM/YYYY') AS timeline_item_title,
+					newsletter.description AS timeline_item_description,
+					newsletter.article_id AS timeline_item_article_id, '' AS timeline_item_legislative_body,
+					'' AS timeline_item_agenda_item_regime, '' AS timeline_item_agenda_item_situation,
+					'' AS timeline_item_event_situation, '' AS timeline_item_voting_result,
+					NULL::boolean AS timeline_item_voting_is_approved
+				FROM newsletter
+					INNER JOIN newsletter_article ON newsletter_article.newsletter_id = newsletter.id
+					INNER JOIN timeline_proposition ON timeline_proposition.article_id = newsletter_article.article_id
+					INNER JOIN article ON article.id = newsletter.article_id
+				WHERE newsletter.active = true AND newsletter_article.active = true AND article.active = true
+			) AS timeline_item
+			ORDER BY timeline_item_date, timeline_item_order`
+}
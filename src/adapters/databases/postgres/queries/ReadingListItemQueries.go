@@ -0,0 +1,104 @@
+package queries
+
+type readingListItemSqlManager struct{}
+
+func ReadingListItem() *readingListItemSqlManager {
+	return &readingListItemSqlManager{}
+}
+
+type readingListItemInsertSqlManager struct{}
+
+func (readingListItemSqlManager) Insert() *readingListItemInsertSqlManager {
+	return &readingListItemInsertSqlManager{}
+}
+
+func (readingListItemInsertSqlManager) ReadingListItem() string {
+	return `INSERT INTO reading_list_item(reading_list_id, article_id, note, position)
+			SELECT $1, $2, $3, COALESCE(MAX(reading_list_item.position), 0) + 1
+			FROM reading_list_item
+			WHERE reading_list_item.reading_list_id = $1
+			ON CONFLICT (reading_list_id, article_id) DO NOTHING`
+}
+
+func (readingListItemInsertSqlManager) ReadingListItems() string {
+	return `INSERT INTO reading_list_item(reading_list_id, article_id, position)
+			SELECT $1, new_reading_list_item.article_id, COALESCE((SELECT MAX(reading_list_item.position)
+				FROM reading_list_item WHERE reading_list_item.reading_list_id = $1), 0) +
+				new_reading_list_item.position
+			FROM UNNEST($2::UUID[]) WITH ORDINALITY AS new_reading_list_item(article_id, position)
+			WHERE NOT EXISTS (SELECT 1 FROM reading_list_item WHERE reading_list_item.reading_list_id = $1 AND
+				reading_list_item.article_id = new_reading_list_item.article_id)
+			ON CONFLICT (reading_list_id, article_id) DO NOTHING`
+}
+
+func (readingListItemInsertSqlManager) ArticlesBookmarkedToViewLater() string {
+	return `INSERT INTO reading_list_item(reading_list_id, article_id, position)
+			SELECT $1, user_article.article_id, ROW_NUMBER() OVER (ORDER BY user_article.view_later_set_at DESC)
+			FROM user_article
+			WHERE user_article.active = true AND user_article.user_id = $2 AND user_article.view_later = true
+			ON CONFLICT (reading_list_id, article_id) DO NOTHING`
+}
+
+type readingListItemSelectSqlManager struct{}
+
+func (readingListItemSqlManager) Select() *readingListItemSelectSqlManager {
+	return &readingListItemSelectSqlManager{}
+}
+
+func (readingListItemSelectSqlManager) ByReadingListId() string {
+	return `SELECT article.id AS article_id, COALESCE(user_article.rating, 0) AS user_article_rating,
+				COALESCE(user_article.view_later, false) AS user_article_view_later,
+				reading_list_item.position AS reading_list_item_position,
+				COALESCE(reading_list_item.note, '') AS reading_list_item_note,
+				reading_list_item.created_at AS reading_list_item_created_at
+			FROM reading_list_item
+				INNER JOIN article ON article.id = reading_list_item.article_id
+				LEFT JOIN user_article ON user_article.article_id = article.id AND user_article.user_id = $2 AND
+					user_article.active = true
+			WHERE article.active = true AND reading_list_item.reading_list_id = $1
+			ORDER BY reading_list_item.position, reading_list_item.created_at DESC
+			OFFSET $3 LIMIT $4`
+}
+
+type readingListItemUpdateSqlManager struct{}
+
+func (readingListItemSqlManager) Update() *readingListItemUpdateSqlManager {
+	return &readingListItemUpdateSqlManager{}
+}
+
+func (readingListItemUpdateSqlManager) Note() string {
+	return `UPDATE reading_list_item
+			SET note = COALESCE($1, note), updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			WHERE reading_list_id = $2 AND article_id = $3`
+}
+
+func (readingListItemUpdateSqlManager) Positions() string {
+	return `UPDATE reading_list_item
+			SET position = sorted_reading_list_item.position,
+				updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			FROM (
+				SELECT reading_list_item.id, ROW_NUMBER() OVER (ORDER BY new_position.position NULLS LAST,
+					reading_list_item.position, reading_list_item.created_at DESC) AS position
+				FROM reading_list_item
+					LEFT JOIN UNNEST($2::UUID[]) WITH ORDINALITY AS new_position(article_id, position)
+						ON new_position.article_id = reading_list_item.article_id
+				WHERE reading_list_item.reading_list_id = $1
+			) AS sorted_reading_list_item
+			WHERE reading_list_item.id = sorted_reading_list_item.id`
+}
+
+type readingListItemDeleteSqlManager struct{}
+
+func (readingListItemSqlManager) Delete() *readingListItemDeleteSqlManager {
+	return &readingListItemDeleteSqlManager{}
+}
+
+func (readingListItemDeleteSqlManager) ReadingListItem() string {
+	return `DELETE FROM reading_list_item
+			WHERE reading_list_id = $1 AND article_id = $2`
+}
+
+func (readingListItemDeleteSqlManager) ReadingListItems() string {
+	return `DELETE FROM reading_list_item
+			WHERE reading_list_id = $1 AND article_id = ANY($2::UUID[])`
+}
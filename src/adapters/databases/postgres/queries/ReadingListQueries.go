@@ -0,0 +1,116 @@
+package queries
+
+type readingListSqlManager struct{}
+
+func ReadingList() *readingListSqlManager {
+	return &readingListSqlManager{}
+}
+
+type readingListInsertSqlManager struct{}
+
+func (readingListSqlManager) Insert() *readingListInsertSqlManager {
+	return &readingListInsertSqlManager{}
+}
+
+func (readingListInsertSqlManager) ReadingList() string {
+	return `INSERT INTO reading_list(user_id, name, description, is_public, share_token)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING id`
+}
+
+func (readingListInsertSqlManager) DefaultReadingList() string {
+	return `INSERT INTO reading_list(user_id, name, is_default)
+			SELECT $1, $2, true
+			WHERE NOT EXISTS (SELECT 1 FROM reading_list
+				WHERE reading_list.active = true AND reading_list.user_id = $1 AND reading_list.is_default = true)
+			ON CONFLICT (user_id) WHERE active = true AND is_default = true DO NOTHING
+			RETURNING id`
+}
+
+type readingListSelectSqlManager struct{}
+
+func (readingListSqlManager) Select() *readingListSelectSqlManager {
+	return &readingListSelectSqlManager{}
+}
+
+func (readingListSelectSqlManager) ByUserId() string {
+	return `SELECT reading_list.id AS reading_list_id, reading_list.name AS reading_list_name,
+				COALESCE(reading_list.description, '') AS reading_list_description,
+				reading_list.is_default AS reading_list_is_default, reading_list.is_public AS reading_list_is_public,
+				reading_list.share_token AS reading_list_share_token,
+				COUNT(article.id) AS reading_list_number_of_articles,
+				reading_list.created_at AS reading_list_created_at, reading_list.updated_at AS reading_list_updated_at
+			FROM reading_list
+				LEFT JOIN reading_list_item ON reading_list_item.reading_list_id = reading_list.id
+				LEFT JOIN article ON article.id = reading_list_item.article_id AND article.active = true
+			WHERE reading_list.active = true AND reading_list.user_id = $1
+			GROUP BY reading_list.id
+			ORDER BY reading_list.is_default DESC, reading_list.created_at`
+}
+
+func (readingListSelectSqlManager) ById() string {
+	return `SELECT reading_list.id AS reading_list_id, reading_list.name AS reading_list_name,
+				COALESCE(reading_list.description, '') AS reading_list_description,
+				reading_list.is_default AS reading_list_is_default, reading_list.is_public AS reading_list_is_public,
+				reading_list.share_token AS reading_list_share_token,
+				COUNT(article.id) AS reading_list_number_of_articles,
+				reading_list.created_at AS reading_list_created_at, reading_list.updated_at AS reading_list_updated_at
+			FROM reading_list
+				LEFT JOIN reading_list_item ON reading_list_item.reading_list_id = reading_list.id
+				LEFT JOIN article ON article.id = reading_list_item.article_id AND article.active = true
+			WHERE reading_list.active = true AND reading_list.id = $1 AND reading_list.user_id = $2
+			GROUP BY reading_list.id`
+}
+
+func (readingListSelectSqlManager) ByShareToken() string {
+	return `SELECT reading_list.id AS reading_list_id, reading_list.name AS reading_list_name,
+				COALESCE(reading_list.description, '') AS reading_list_description,
+				reading_list.is_default AS reading_list_is_default, reading_list.is_public AS reading_list_is_public,
+				reading_list.share_token AS reading_list_share_token,
+				COUNT(article.id) AS reading_list_number_of_articles,
+				reading_list.created_at AS reading_list_created_at, reading_list.updated_at AS reading_list_updated_at
+			FROM reading_list
+				LEFT JOIN reading_list_item ON reading_list_item.reading_list_id = reading_list.id
+				LEFT JOIN article ON article.id = reading_list_item.article_id AND article.active = true
+			WHERE reading_list.active = true AND reading_list.is_public = true AND reading_list.share_token = $1
+			GROUP BY reading_list.id`
+}
+
+func (readingListSelectSqlManager) LockById() string {
+	return `SELECT reading_list.id
+			FROM reading_list
+			WHERE reading_list.active = true AND reading_list.id = $1
+			FOR UPDATE`
+}
+
+func (readingListSelectSqlManager) DefaultReadingListIdByUserId() string {
+	return `SELECT reading_list.id
+			FROM reading_list
+			WHERE reading_list.active = true AND reading_list.user_id = $1 AND reading_list.is_default = true`
+}
+
+type readingListUpdateSqlManager struct{}
+
+func (readingListSqlManager) Update() *readingListUpdateSqlManager {
+	return &readingListUpdateSqlManager{}
+}
+
+func (readingListUpdateSqlManager) ReadingList() string {
+	return `UPDATE reading_list
+			SET name = $1, description = $2, is_public = $3,
+				share_token = CASE WHEN $3 = false THEN NULL ELSE COALESCE(share_token, $4) END,
+				updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			WHERE active = true AND id = $5 AND user_id = $6`
+}
+
+type readingListDeleteSqlManager struct{}
+
+func (readingListSqlManager) Delete() *readingListDeleteSqlManager {
+	return &readingListDeleteSqlManager{}
+}
+
+func (readingListDeleteSqlManager) ReadingList() string {
+	return `UPDATE reading_list
+			SET active = false, share_token = NULL, updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			WHERE active = true AND id = $1 AND user_id = $2 AND is_default = false`
+}
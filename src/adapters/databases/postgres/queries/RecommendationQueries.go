@@ -0,0 +1,164 @@
+package queries
+
+type recommendationSqlManager struct{}
+
+func Recommendation() *recommendationSqlManager {
+	return &recommendationSqlManager{}
+}
+
+type recommendationSelectSqlManager struct{}
+
+func (recommendationSqlManager) Select() *recommendationSelectSqlManager {
+	return &recommendationSelectSqlManager{}
+}
+
+func (recommendationSelectSqlManager) ByUserId() string {
+	return `WITH user_signal AS (
+				SELECT article_view.article_id, 1::FLOAT AS signal_weight, MAX(article_view.created_at) AS signal_date
+				FROM article_view
+				WHERE article_view.user_id = $1 AND
+					article_view.created_at >= TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) - $2::INTERVAL
+				GROUP BY article_view.article_id
+				UNION ALL
+				SELECT user_article.article_id, (user_article.rating - 3)::FLOAT AS signal_weight,
+					COALESCE(user_article.updated_at, user_article.created_at) AS signal_date
+				FROM user_article
+				WHERE user_article.active = true AND user_article.user_id = $1 AND user_article.rating IS NOT NULL AND
+					COALESCE(user_article.updated_at, user_article.created_at) >=
+					TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) - $2::INTERVAL
+			),
+			seed_article AS (
+				SELECT user_signal.article_id, SUM(user_signal.signal_weight) AS seed_weight
+				FROM user_signal
+					INNER JOIN article ON article.id = user_signal.article_id
+				WHERE article.active = true
+				GROUP BY user_signal.article_id
+				HAVING SUM(user_signal.signal_weight) > 0
+				ORDER BY MAX(user_signal.signal_date) DESC
+				LIMIT $3
+			),
+			read_article AS (
+				SELECT article_view.article_id
+				FROM article_view
+				WHERE article_view.user_id = $1
+				UNION
+				SELECT user_article.article_id
+				FROM user_article
+				WHERE user_article.active = true AND user_article.user_id = $1 AND user_article.rating IS NOT NULL
+			),
+			candidate_article AS (
+				SELECT article.id AS article_id
+				FROM article
+					INNER JOIN article_type ON article_type.id = article.article_type_id
+				WHERE article.active = true AND article_type.active = true AND
+					article.created_at >= TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) - $4::INTERVAL AND
+					NOT EXISTS (SELECT 1 FROM read_article WHERE read_article.article_id = article.id)
+			),
+			relevant_article AS (
+				SELECT seed_article.article_id FROM seed_article
+				UNION
+				SELECT candidate_article.article_id FROM candidate_article
+			),
+			article_feature AS (
+				SELECT proposition.article_id,
+					'author:' || COALESCE(proposition_author.deputy_id, proposition_author.external_author_id)::TEXT
+					AS feature, 1::FLOAT AS feature_weight
+				FROM proposition
+					INNER JOIN relevant_article ON relevant_article.article_id = proposition.article_id
+					INNER JOIN proposition_author ON proposition_author.proposition_id = proposition.id
+				WHERE proposition.active = true AND proposition_author.active = true AND
+					COALESCE(proposition_author.deputy_id, proposition_author.external_author_id) IS NOT NULL
+				UNION
+				SELECT proposition.article_id, 'proposition_type:' || proposition.proposition_type_id::TEXT AS feature,
+					0.25::FLOAT AS feature_weight
+				FROM proposition
+					INNER JOIN relevant_article ON relevant_article.article_id = proposition.article_id
+				WHERE proposition.active = true
+				UNION
+				SELECT voting.article_id, 'legislative_body:' || voting.legislative_body_id::TEXT AS feature,
+					0.5::FLOAT AS feature_weight
+				FROM voting
+					INNER JOIN relevant_article ON relevant_article.article_id = voting.article_id
+				WHERE voting.active = true AND voting.legislative_body_id IS NOT NULL
+				UNION
+				SELECT event.article_id, 'legislative_body:' || event_legislative_body.legislative_body_id::TEXT AS feature,
+					0.5::FLOAT AS feature_weight
+				FROM event
+					INNER JOIN relevant_article ON relevant_article.article_id = event.article_id
+					INNER JOIN event_legislative_body ON event_legislative_body.event_id = event.id
+				WHERE event.active = true AND event_legislative_body.active = true
+			),
+			similar_reader AS (
+				SELECT DISTINCT seed_article.article_id AS seed_article_id, seed_view.user_id
+				FROM seed_article
+					INNER JOIN article_view seed_view ON seed_view.article_id = seed_article.article_id
+				WHERE seed_view.user_id IS NOT NULL AND seed_view.user_id <> $1 AND
+					seed_view.created_at >= TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) - $2::INTERVAL
+			),
+			similar_reader_view AS (
+				SELECT recent_view.user_id, recent_view.article_id
+				FROM (
+					SELECT article_view.user_id, article_view.article_id,
+						ROW_NUMBER() OVER (PARTITION BY article_view.user_id
+							ORDER BY MAX(article_view.created_at) DESC) AS view_position
+					FROM article_view
+						INNER JOIN candidate_article ON candidate_article.article_id = article_view.article_id
+					WHERE article_view.created_at >= TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) - $4::INTERVAL AND
+						article_view.user_id IN (SELECT similar_reader.user_id FROM similar_reader)
+					GROUP BY article_view.user_id, article_view.article_id
+				) AS recent_view
+				WHERE recent_view.view_position <= $8
+			),
+			similar_readers_candidate AS (
+				SELECT similar_reader_view.article_id, seed_article.article_id AS seed_article_id,
+					'similar_readers' AS recommendation_reason,
+					$5 * seed_article.seed_weight * LN(1 + COUNT(DISTINCT similar_reader.user_id)) AS recommendation_score
+				FROM seed_article
+					INNER JOIN similar_reader ON similar_reader.seed_article_id = seed_article.article_id
+					INNER JOIN similar_reader_view ON similar_reader_view.user_id = similar_reader.user_id
+				GROUP BY similar_reader_view.article_id, seed_article.article_id, seed_article.seed_weight
+			),
+			similar_content_candidate AS (
+				SELECT candidate_article.article_id, seed_article.article_id AS seed_article_id,
+					'similar_content' AS recommendation_reason,
+					$6 * seed_article.seed_weight * SUM(candidate_feature.feature_weight) AS recommendation_score
+				FROM seed_article
+					INNER JOIN article_feature seed_feature ON seed_feature.article_id = seed_article.article_id
+					INNER JOIN article_feature candidate_feature ON candidate_feature.feature = seed_feature.feature
+					INNER JOIN candidate_article ON candidate_article.article_id = candidate_feature.article_id
+				GROUP BY candidate_article.article_id, seed_article.article_id, seed_article.seed_weight
+			),
+			ranked_candidate AS (
+				SELECT recommendation_candidate.article_id, recommendation_candidate.seed_article_id,
+					recommendation_candidate.recommendation_reason,
+					SUM(recommendation_candidate.recommendation_score) OVER (PARTITION BY recommendation_candidate.article_id)
+					AS recommendation_score,
+					ROW_NUMBER() OVER (PARTITION BY recommendation_candidate.article_id
+						ORDER BY recommendation_candidate.recommendation_score DESC) AS recommendation_position
+				FROM (
+					SELECT * FROM similar_readers_candidate
+					UNION ALL
+					SELECT * FROM similar_content_candidate
+				) AS recommendation_candidate
+				WHERE recommendation_candidate.recommendation_score > 0
+			)
+			SELECT ranked_candidate.article_id, COALESCE(user_article.rating, 0) AS user_article_rating,
+				COALESCE(user_article.view_later, false) AS user_article_view_later,
+				ranked_candidate.recommendation_reason,
+				ranked_candidate.seed_article_id AS recommendation_reason_article_id,
+				COALESCE(proposition.title, event.title, 'Votação ' || voting.code,
+					'Boletim do dia ' || TO_CHAR(newsletter.reference_date, 'DD/MM/YYYY'))
+					AS recommendation_reason_article_title,
+				ranked_candidate.recommendation_score
+			FROM ranked_candidate
+				INNER JOIN article ON article.id = ranked_candidate.article_id
+				LEFT JOIN proposition ON proposition.article_id = ranked_candidate.seed_article_id
+				LEFT JOIN voting ON voting.article_id = ranked_candidate.seed_article_id
+				LEFT JOIN event ON event.article_id = ranked_candidate.seed_article_id
+				LEFT JOIN newsletter ON newsletter.article_id = ranked_candidate.seed_article_id
+				LEFT JOIN user_article ON user_article.article_id = ranked_candidate.article_id AND
+					user_article.user_id = $1 AND user_article.active = true
+			WHERE ranked_candidate.recommendation_position = 1
+			ORDER BY ranked_candidate.recommendation_score DESC, article.reference_date_time DESC
+			LIMIT $7`
+}
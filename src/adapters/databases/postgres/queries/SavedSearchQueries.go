@@ -0,0 +1,140 @@
+package queries
+
+type savedSearchSqlManager struct{}
+
+func SavedSearch() *savedSearchSqlManager {
+	return &savedSearchSqlManager{}
+}
+
+type savedSearchInsertSqlManager struct{}
+
+func (savedSearchSqlManager) Insert() *savedSearchInsertSqlManager {
+	return &savedSearchInsertSqlManager{}
+}
+
+func (savedSearchInsertSqlManager) SavedSearch() string {
+	return `INSERT INTO saved_search(user_id, name, filter, notify_by_email, last_opened_at, last_checked_at)
+			VALUES ($1, $2, $3, $4, TIMEZONE('America/Sao_Paulo'::TEXT, NOW()),
+				TIMEZONE('America/Sao_Paulo'::TEXT, NOW()))
+			RETURNING id`
+}
+
+type savedSearchSelectSqlManager struct{}
+
+func (savedSearchSqlManager) Select() *savedSearchSelectSqlManager {
+	return &savedSearchSelectSqlManager{}
+}
+
+func (savedSearchSelectSqlManager) ByUserId() string {
+	return `SELECT saved_search.id AS saved_search_id, saved_search.name AS saved_search_name,
+				saved_search.filter AS saved_search_filter, saved_search.notify_by_email AS saved_search_notify_by_email,
+				saved_search.number_of_new_articles AS saved_search_number_of_new_articles,
+				saved_search.last_opened_at AS saved_search_last_opened_at,
+				saved_search.last_checked_at AS saved_search_last_checked_at,
+				saved_search.created_at AS saved_search_created_at, saved_search.updated_at AS saved_search_updated_at,
+				"user".id AS user_id, "user".first_name AS user_first_name, "user".last_name AS user_last_name,
+				"user".email AS user_email
+			FROM saved_search
+				INNER JOIN "user" ON "user".id = saved_search.user_id
+			WHERE saved_search.active = true AND "user".active = true AND saved_search.user_id = $1
+			ORDER BY saved_search.created_at`
+}
+
+func (savedSearchSelectSqlManager) ById() string {
+	return `SELECT saved_search.id AS saved_search_id, saved_search.name AS saved_search_name,
+				saved_search.filter AS saved_search_filter, saved_search.notify_by_email AS saved_search_notify_by_email,
+				saved_search.number_of_new_articles AS saved_search_number_of_new_articles,
+				saved_search.last_opened_at AS saved_search_last_opened_at,
+				saved_search.last_checked_at AS saved_search_last_checked_at,
+				saved_search.created_at AS saved_search_created_at, saved_search.updated_at AS saved_search_updated_at,
+				"user".id AS user_id, "user".first_name AS user_first_name, "user".last_name AS user_last_name,
+				"user".email AS user_email
+			FROM saved_search
+				INNER JOIN "user" ON "user".id = saved_search.user_id
+			WHERE saved_search.active = true AND "user".active = true AND saved_search.id = $1 AND
+				saved_search.user_id = $2`
+}
+
+func (savedSearchSelectSqlManager) NumberOfSavedSearchesByUserId() string {
+	return `SELECT COUNT(*)
+			FROM saved_search
+			WHERE saved_search.active = true AND saved_search.user_id = $1`
+}
+
+type savedSearchUpdateSqlManager struct{}
+
+func (savedSearchSqlManager) Update() *savedSearchUpdateSqlManager {
+	return &savedSearchUpdateSqlManager{}
+}
+
+func (savedSearchUpdateSqlManager) SavedSearch() string {
+	return `UPDATE saved_search
+			SET name = $1, notify_by_email = $3,
+				number_of_new_articles = CASE WHEN filter = $2::jsonb THEN number_of_new_articles ELSE 0 END,
+				last_opened_at = CASE WHEN filter = $2::jsonb THEN last_opened_at
+					ELSE TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) END,
+				filter = $2, updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			WHERE active = true AND id = $4 AND user_id = $5`
+}
+
+func (savedSearchUpdateSqlManager) LastOpenedAt() string {
+	return `UPDATE saved_search
+			SET last_opened_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW()), number_of_new_articles = 0
+			WHERE active = true AND id = $1 AND user_id = $2`
+}
+
+func (savedSearchUpdateSqlManager) PendingSavedSearches() string {
+	return `WITH pending_saved_search AS (
+				SELECT saved_search.id, saved_search.last_checked_at
+				FROM saved_search
+					INNER JOIN "user" ON "user".id = saved_search.user_id
+				WHERE saved_search.active = true AND "user".active = true AND saved_search.last_checked_at <=
+					TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) - $2::interval
+				ORDER BY saved_search.last_checked_at
+				LIMIT $1
+				FOR UPDATE OF saved_search SKIP LOCKED
+			)
+			UPDATE saved_search
+			SET last_checked_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			FROM pending_saved_search, "user"
+			WHERE saved_search.id = pending_saved_search.id AND "user".id = saved_search.user_id
+			RETURNING saved_search.id AS saved_search_id, saved_search.name AS saved_search_name,
+				saved_search.filter AS saved_search_filter, saved_search.notify_by_email AS saved_search_notify_by_email,
+				saved_search.number_of_new_articles AS saved_search_number_of_new_articles,
+				saved_search.last_opened_at AS saved_search_last_opened_at,
+				pending_saved_search.last_checked_at AS saved_search_last_checked_at,
+				saved_search.last_checked_at AS saved_search_checked_at,
+				saved_search.created_at AS saved_search_created_at, saved_search.updated_at AS saved_search_updated_at,
+				"user".id AS user_id, "user".first_name AS user_first_name, "user".last_name AS user_last_name,
+				"user".email AS user_email`
+}
+
+func (savedSearchUpdateSqlManager) NumberOfNewArticles() string {
+	return `UPDATE saved_search
+			SET number_of_new_articles = $1
+			WHERE active = true AND id = $2 AND last_opened_at = $3`
+}
+
+func (savedSearchUpdateSqlManager) DisableEmailNotifications() string {
+	return `UPDATE saved_search
+			SET notify_by_email = false, updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			WHERE active = true AND notify_by_email = true AND id = $1`
+}
+
+func (savedSearchUpdateSqlManager) LastCheckedAt() string {
+	return `UPDATE saved_search
+			SET last_checked_at = $1
+			WHERE active = true AND id = $2`
+}
+
+type savedSearchDeleteSqlManager struct{}
+
+func (savedSearchSqlManager) Delete() *savedSearchDeleteSqlManager {
+	return &savedSearchDeleteSqlManager{}
+}
+
+func (savedSearchDeleteSqlManager) SavedSearch() string {
+	return `UPDATE saved_search
+			SET active = false, updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			WHERE active = true AND id = $1 AND user_id = $2`
+}
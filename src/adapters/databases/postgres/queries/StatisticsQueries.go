@@ -0,0 +1,64 @@
+package queries
+
+type statisticsSqlManager struct{}
+
+func Statistics() *statisticsSqlManager {
+	return &statisticsSqlManager{}
+}
+
+type statisticsSelectSqlManager struct{}
+
+func (statisticsSqlManager) Select() *statisticsSelectSqlManager {
+	return &statisticsSelectSqlManager{}
+}
+
+func (statisticsSelectSqlManager) VotingOutcomes() string {
+	return `SELECT legislative_body.id AS legislative_body_id, legislative_body.name AS legislative_body_name,
+				legislative_body.acronym AS legislative_body_acronym,
+				legislative_body_type.id AS legislative_body_type_id,
+				legislative_body_type.description AS legislative_body_type_description,
+				COALESCE(proposition_type.id, '00000000-0000-0000-0000-000000000000') AS proposition_type_id,
+				COALESCE(proposition_type.description, '') AS proposition_type_description,
+				COALESCE(proposition_type.color, '') AS proposition_type_color,
+				DATE_TRUNC('month', voting.result_announced_at) AS voting_outcome_month,
+				COUNT(*) FILTER (WHERE voting.is_approved = true) AS voting_outcome_number_of_approved_votes,
+				COUNT(*) FILTER (WHERE voting.is_approved = false) AS voting_outcome_number_of_rejected_votes,
+				COUNT(*) FILTER (WHERE voting.is_approved IS NULL) AS voting_outcome_number_of_undetermined_votes
+			FROM voting
+				INNER JOIN article ON article.id = voting.article_id
+				INNER JOIN legislative_body ON legislative_body.id = voting.legislative_body_id
+				INNER JOIN legislative_body_type ON legislative_body_type.id = legislative_body.legislative_body_type_id
+				LEFT JOIN proposition ON proposition.id = voting.main_proposition_id AND proposition.active = true
+				LEFT JOIN proposition_type ON proposition_type.id = proposition.proposition_type_id AND
+					proposition_type.active = true
+			WHERE voting.active = true AND article.active = true AND legislative_body.active = true AND
+				legislative_body_type.active = true AND voting.result_announced_at IS NOT NULL AND
+				DATE_TRUNC('day', voting.result_announced_at) >= DATE_TRUNC('day',
+				COALESCE($1, voting.result_announced_at)) AND
+				DATE_TRUNC('day', voting.result_announced_at) <= DATE_TRUNC('day',
+				COALESCE($2, voting.result_announced_at)) AND
+			    (CASE WHEN $3 = 'approved' THEN voting.is_approved = true
+			        WHEN $3 = 'rejected' THEN voting.is_approved = false
+    				WHEN $3 = 'undetermined' THEN voting.is_approved IS NULL ELSE TRUE END) AND
+				voting.legislative_body_id = COALESCE($4, voting.legislative_body_id)
+			GROUP BY legislative_body.id, legislative_body_type.id, proposition_type.id, voting_outcome_month
+			ORDER BY voting_outcome_month DESC, legislative_body.name, proposition_type.description NULLS LAST`
+}
+
+func (statisticsSelectSqlManager) FederatedUnitActivities() string {
+	return `SELECT proposition_author.federated_unit AS federated_unit_activity_federated_unit,
+				COUNT(DISTINCT proposition.id) AS federated_unit_activity_number_of_propositions,
+				COUNT(DISTINCT proposition_author.deputy_id) AS federated_unit_activity_number_of_deputies
+			FROM proposition_author
+				INNER JOIN proposition ON proposition.id = proposition_author.proposition_id
+				INNER JOIN article ON article.id = proposition.article_id
+			WHERE proposition_author.active = true AND proposition.active = true AND article.active = true AND
+				proposition_author.deputy_id IS NOT NULL AND proposition_author.federated_unit IS NOT NULL AND
+				DATE_TRUNC('day', proposition.submitted_at) >= DATE_TRUNC('day',
+				COALESCE($1, proposition.submitted_at)) AND
+				DATE_TRUNC('day', proposition.submitted_at) <= DATE_TRUNC('day',
+				COALESCE($2, proposition.submitted_at)) AND
+				proposition.proposition_type_id = COALESCE($3, proposition.proposition_type_id)
+			GROUP BY proposition_author.federated_unit
+			ORDER BY federated_unit_activity_number_of_propositions DESC, proposition_author.federated_unit`
+}
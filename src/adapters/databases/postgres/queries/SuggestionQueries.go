@@ -0,0 +1,139 @@
+package queries
+
+type suggestionSqlManager struct{}
+
+func Suggestion() *suggestionSqlManager {
+	return &suggestionSqlManager{}
+}
+
+type suggestionSelectSqlManager struct{}
+
+func (suggestionSqlManager) Select() *suggestionSelectSqlManager {
+	return &suggestionSelectSqlManager{}
+}
+
+func (suggestionSelectSqlManager) ByText() string {
+	return `SELECT suggestion_id, suggestion_type, suggestion_label, suggestion_description, suggestion_score
+			FROM (
+				(SELECT deputy.id AS suggestion_id, 'deputy' AS suggestion_type,
+					deputy.electoral_name AS suggestion_label,
+					party.acronym || '-' || deputy.federated_unit AS suggestion_description,
+					(CASE WHEN UNACCENT(deputy.electoral_name) ILIKE UNACCENT($3) || '%' THEN 1 ELSE 0 END) +
+					SIMILARITY(UNACCENT(deputy.electoral_name), UNACCENT($1)) AS suggestion_score
+				FROM deputy
+					INNER JOIN party ON party.id = deputy.party_id
+				WHERE deputy.active = true AND party.active = true AND
+					(UNACCENT(deputy.electoral_name) ILIKE UNACCENT($3) || '%' OR
+					UNACCENT(deputy.electoral_name) ILIKE '% ' || UNACCENT($3) || '%' OR
+					UNACCENT(deputy.electoral_name) % UNACCENT($1))
+				ORDER BY suggestion_score DESC, deputy.electoral_name
+				LIMIT $2)
+				UNION ALL
+				(SELECT party.id AS suggestion_id, 'party' AS suggestion_type, party.acronym AS suggestion_label,
+					party.name AS suggestion_description,
+					(CASE WHEN UNACCENT(party.acronym) ILIKE UNACCENT($3) || '%' THEN 1 ELSE 0 END) +
+					GREATEST(SIMILARITY(UNACCENT(party.acronym), UNACCENT($1)),
+					SIMILARITY(UNACCENT(party.name), UNACCENT($1))) AS suggestion_score
+				FROM party
+				WHERE party.active = true AND
+					(UNACCENT(party.acronym) ILIKE UNACCENT($3) || '%' OR
+					UNACCENT(party.acronym) % UNACCENT($1) OR UNACCENT(party.name) % UNACCENT($1))
+				ORDER BY suggestion_score DESC, party.acronym
+				LIMIT $2)
+				UNION ALL
+				(SELECT legislative_body.id AS suggestion_id, 'legislative_body' AS suggestion_type,
+					legislative_body.acronym AS suggestion_label, legislative_body.name AS suggestion_description,
+					(CASE WHEN UNACCENT(legislative_body.acronym) ILIKE UNACCENT($3) || '%' OR
+						UNACCENT(legislative_body.name) ILIKE UNACCENT($3) || '%' THEN 1 ELSE 0 END) +
+					GREATEST(SIMILARITY(UNACCENT(legislative_body.acronym), UNACCENT($1)),
+					SIMILARITY(UNACCENT(legislative_body.name), UNACCENT($1))) AS suggestion_score
+				FROM legislative_body
+				WHERE legislative_body.active = true AND
+					(UNACCENT(legislative_body.acronym) ILIKE UNACCENT($3) || '%' OR
+					UNACCENT(legislative_body.name) ILIKE UNACCENT($3) || '%' OR
+					UNACCENT(legislative_body.name) ILIKE '% ' || UNACCENT($3) || '%' OR
+					UNACCENT(legislative_body.acronym) % UNACCENT($1) OR
+					UNACCENT(legislative_body.name) % UNACCENT($1))
+				ORDER BY suggestion_score DESC, legislative_body.acronym
+				LIMIT $2)
+				UNION ALL
+				(SELECT article.id AS suggestion_id, 'proposition' AS suggestion_type,
+					proposition_code.label AS suggestion_label, proposition.title AS suggestion_description,
+					(CASE WHEN REPLACE(proposition_code.label, ' ', '') ILIKE REPLACE($3, ' ', '') || '%' OR
+						proposition_code.number LIKE REPLACE($3, ' ', '') || '%' THEN 1 ELSE 0 END) +
+					SIMILARITY(proposition_code.label, $1) AS suggestion_score
+				FROM article
+					INNER JOIN proposition ON proposition.article_id = article.id
+					INNER JOIN proposition_type ON proposition_type.id = proposition.proposition_type_id
+					CROSS JOIN LATERAL (
+						SELECT COALESCE(TRIM(proposition_type_code.acronym), proposition_type.description) || ' ' ||
+								proposition.code || '/' || TO_CHAR(proposition.submitted_at, 'YYYY') AS label,
+							proposition.code || '/' || TO_CHAR(proposition.submitted_at, 'YYYY') AS number
+						FROM (SELECT NULL::TEXT AS acronym
+							UNION ALL
+							SELECT UNNEST(STRING_TO_ARRAY(proposition_type.codes, ','))) AS proposition_type_code
+						ORDER BY (REPLACE(TRIM(proposition_type_code.acronym), ' ', '') || proposition.code
+							ILIKE REPLACE($3, ' ', '') || '%') IS NOT TRUE, proposition_type_code.acronym NULLS LAST
+						LIMIT 1
+					) AS proposition_code
+				WHERE article.active = true AND proposition.active = true AND proposition_type.active = true AND
+					(REPLACE(proposition_code.label, ' ', '') ILIKE REPLACE($3, ' ', '') || '%' OR
+					proposition_code.number LIKE REPLACE($3, ' ', '') || '%')
+				ORDER BY suggestion_score DESC, proposition.submitted_at DESC
+				LIMIT $2)
+				UNION ALL
+				(SELECT article.id AS suggestion_id, 'article' AS suggestion_type,
+					COALESCE(proposition.title, event.title, 'Votação ' || voting.code) AS suggestion_label,
+					article_type.codes AS suggestion_description,
+					(CASE WHEN UNACCENT(COALESCE(proposition.title, event.title, 'Votação ' || voting.code))
+						ILIKE UNACCENT($3) || '%' THEN 1 ELSE 0 END) +
+					WORD_SIMILARITY(UNACCENT($1),
+					UNACCENT(COALESCE(proposition.title, event.title, 'Votação ' || voting.code))) AS suggestion_score
+				FROM article
+					INNER JOIN article_type ON article_type.id = article.article_type_id
+					LEFT JOIN proposition ON proposition.article_id = article.id
+					LEFT JOIN voting ON voting.article_id = article.id
+					LEFT JOIN event ON event.article_id = article.id
+				WHERE article.active = true AND article_type.active = true AND proposition.active IS NOT false AND
+					voting.active IS NOT false AND event.active IS NOT false AND
+					COALESCE(proposition.title, event.title, 'Votação ' || voting.code) IS NOT NULL AND
+					(UNACCENT(COALESCE(proposition.title, event.title, 'Votação ' || voting.code))
+						ILIKE '%' || UNACCENT($3) || '%' OR
+					UNACCENT($1) <% UNACCENT(COALESCE(proposition.title, event.title, 'Votação ' || voting.code)))
+				ORDER BY suggestion_score DESC, article.reference_date_time DESC
+				LIMIT $2)
+			) AS suggestion
+			ORDER BY suggestion_score DESC, suggestion_label`
+}
+
+func (suggestionSelectSqlManager) SpellingCorrectionsByWords() string {
+	return `WITH searched_word AS (
+				SELECT DISTINCT LOWER(UNNEST($1::TEXT[])) AS word
+			)
+			SELECT searched_word.word AS spelling_correction_word, vocabulary.term AS spelling_correction_term,
+				vocabulary.similarity AS spelling_correction_similarity
+			FROM searched_word
+				CROSS JOIN LATERAL (
+					SELECT search_vocabulary.term,
+						SIMILARITY(search_vocabulary.unaccented_term, UNACCENT(searched_word.word)) AS similarity
+					FROM search_vocabulary
+					WHERE search_vocabulary.unaccented_term % UNACCENT(searched_word.word)
+					ORDER BY similarity DESC, search_vocabulary.term
+					LIMIT $2
+				) AS vocabulary
+			ORDER BY spelling_correction_word, spelling_correction_similarity DESC, spelling_correction_term`
+}
+
+func (suggestionSelectSqlManager) VocabularyRefreshLock() string {
+	return `SELECT pg_try_advisory_xact_lock(HASHTEXT('search_vocabulary'))`
+}
+
+type suggestionUpdateSqlManager struct{}
+
+func (suggestionSqlManager) Update() *suggestionUpdateSqlManager {
+	return &suggestionUpdateSqlManager{}
+}
+
+func (suggestionUpdateSqlManager) Vocabulary() string {
+	return `REFRESH MATERIALIZED VIEW CONCURRENTLY search_vocabulary`
+}
@@ -0,0 +1,61 @@
+package queries
+
+import "fmt"
+
+type trendingScoreSqlManager struct{}
+
+func TrendingScore() *trendingScoreSqlManager {
+	return &trendingScoreSqlManager{}
+}
+
+type trendingScoreSelectSqlManager struct{}
+
+func (trendingScoreSqlManager) Select() *trendingScoreSelectSqlManager {
+	return &trendingScoreSelectSqlManager{}
+}
+
+func (trendingScoreSelectSqlManager) AgeInSecondsByWindow() string {
+	return `SELECT EXTRACT(EPOCH FROM TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) -
+				MAX(article_trending_score.updated_at))
+			FROM article_trending_score
+			WHERE article_trending_score.trending_window = $1`
+}
+
+func (trendingScoreSelectSqlManager) RefreshLockByWindow() string {
+	return `SELECT pg_try_advisory_xact_lock(HASHTEXT('article_trending_score'), HASHTEXT($1))`
+}
+
+type trendingScoreInsertSqlManager struct{}
+
+func (trendingScoreSqlManager) Insert() *trendingScoreInsertSqlManager {
+	return &trendingScoreInsertSqlManager{}
+}
+
+// ByWindow only registers the scores of the articles viewed or rated within the window, the other articles are
+// listed after them by date
+func (trendingScoreInsertSqlManager) ByWindow() string {
+	return fmt.Sprintf(`INSERT INTO article_trending_score (article_id, trending_window, views, score, updated_at)
+			SELECT article.id, $1, %s, %s, TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			FROM article
+				%s
+			WHERE article.active = true AND (trending_article_view.article_id IS NOT NULL OR
+				EXISTS (SELECT 1 FROM user_article rated_user_article
+					WHERE rated_user_article.article_id = article.id AND rated_user_article.active = true AND
+						rated_user_article.rating IS NOT NULL AND
+						rated_user_article.updated_at >= TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) - $2::INTERVAL))
+			GROUP BY article.id
+			ON CONFLICT (article_id, trending_window) DO UPDATE SET views = EXCLUDED.views, score = EXCLUDED.score,
+				updated_at = EXCLUDED.updated_at`, trendingArticleViews(false), trendingScore(false, 3),
+		trendingArticleJoins(false, 2))
+}
+
+type trendingScoreDeleteSqlManager struct{}
+
+func (trendingScoreSqlManager) Delete() *trendingScoreDeleteSqlManager {
+	return &trendingScoreDeleteSqlManager{}
+}
+
+func (trendingScoreDeleteSqlManager) OutdatedByWindow() string {
+	return `DELETE FROM article_trending_score
+			WHERE trending_window = $1 AND updated_at < TIMEZONE('America/Sao_Paulo'::TEXT, NOW())`
+}
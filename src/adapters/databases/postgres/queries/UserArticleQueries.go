@@ -18,7 +18,37 @@ func (userArticleInsertSqlManager) Rating() string {
 
 func (userArticleInsertSqlManager) ViewLater() string {
 	return `INSERT INTO user_article (user_id, article_id, view_later, view_later_set_at)
-			VALUES ($1, $2, $3, CASE WHEN $3 = false THEN NULL ELSE TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) END)`
+			VALUES ($1, $2, $3, CASE WHEN $3 = false THEN NULL ELSE TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) END)
+			ON CONFLICT (user_id, article_id) DO UPDATE
+			SET view_later = EXCLUDED.view_later, view_later_set_at = EXCLUDED.view_later_set_at,
+				updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			WHERE user_article.active = true`
+}
+
+func (userArticleInsertSqlManager) Ratings() string {
+	return `INSERT INTO user_article (user_id, article_id, rating)
+			SELECT $1, article.id, new_rating.rating
+			FROM UNNEST($2::UUID[], $3::INT[]) AS new_rating(article_id, rating)
+				INNER JOIN article ON article.id = new_rating.article_id
+			WHERE article.active = true
+			ON CONFLICT (user_id, article_id) DO UPDATE
+			SET rating = EXCLUDED.rating, updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			WHERE user_article.active = true
+			RETURNING article_id`
+}
+
+func (userArticleInsertSqlManager) ViewLaterBookmarks() string {
+	return `INSERT INTO user_article (user_id, article_id, view_later, view_later_set_at)
+			SELECT $1, article.id, new_view_later.view_later, CASE WHEN new_view_later.view_later = false THEN NULL
+				ELSE TIMEZONE('America/Sao_Paulo'::TEXT, NOW()) END
+			FROM UNNEST($2::UUID[], $3::BOOLEAN[]) AS new_view_later(article_id, view_later)
+				INNER JOIN article ON article.id = new_view_later.article_id
+			WHERE article.active = true
+			ON CONFLICT (user_id, article_id) DO UPDATE
+			SET view_later = EXCLUDED.view_later, view_later_set_at = EXCLUDED.view_later_set_at,
+				updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			WHERE user_article.active = true
+			RETURNING article_id`
 }
 
 type userArticleUpdateSqlManager struct{}
@@ -0,0 +1,79 @@
+package queries
+
+type userFollowSqlManager struct{}
+
+func UserFollow() *userFollowSqlManager {
+	return &userFollowSqlManager{}
+}
+
+type userFollowInsertSqlManager struct{}
+
+func (userFollowSqlManager) Insert() *userFollowInsertSqlManager {
+	return &userFollowInsertSqlManager{}
+}
+
+func (userFollowInsertSqlManager) Follow() string {
+	return `INSERT INTO user_follow(user_id, resource_type, resource_id)
+			SELECT $1, $2, $3
+			WHERE (CASE $2
+				WHEN 'deputy' THEN EXISTS (SELECT 1 FROM deputy WHERE deputy.id = $3 AND deputy.active = true)
+				WHEN 'party' THEN EXISTS (SELECT 1 FROM party WHERE party.id = $3 AND party.active = true)
+				WHEN 'external_author' THEN EXISTS (SELECT 1 FROM external_author
+					WHERE external_author.id = $3 AND external_author.active = true)
+				WHEN 'proposition_type' THEN EXISTS (SELECT 1 FROM proposition_type
+					WHERE proposition_type.id = $3 AND proposition_type.active = true)
+				WHEN 'legislative_body' THEN EXISTS (SELECT 1 FROM legislative_body
+					WHERE legislative_body.id = $3 AND legislative_body.active = true)
+				WHEN 'proposition' THEN EXISTS (SELECT 1 FROM article
+					INNER JOIN proposition ON proposition.article_id = article.id
+					WHERE article.id = $3 AND article.active = true AND proposition.active = true)
+				ELSE false END)
+			ON CONFLICT (user_id, resource_type, resource_id) DO UPDATE
+			SET active = true, updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())`
+}
+
+type userFollowSelectSqlManager struct{}
+
+func (userFollowSqlManager) Select() *userFollowSelectSqlManager {
+	return &userFollowSelectSqlManager{}
+}
+
+func (userFollowSelectSqlManager) ByUserId() string {
+	return `SELECT user_follow_resource_type, user_follow_resource_id, user_follow_resource_name,
+				user_follow_created_at
+			FROM (
+				SELECT user_follow.resource_type AS user_follow_resource_type,
+					user_follow.resource_id AS user_follow_resource_id,
+					COALESCE(deputy.electoral_name, party.acronym, external_author.name, proposition_type.description,
+					legislative_body.acronym, proposition.title) AS user_follow_resource_name,
+					user_follow.created_at AS user_follow_created_at
+				FROM user_follow
+					LEFT JOIN deputy ON user_follow.resource_type = 'deputy' AND
+						deputy.id = user_follow.resource_id AND deputy.active = true
+					LEFT JOIN party ON user_follow.resource_type = 'party' AND
+						party.id = user_follow.resource_id AND party.active = true
+					LEFT JOIN external_author ON user_follow.resource_type = 'external_author' AND
+						external_author.id = user_follow.resource_id AND external_author.active = true
+					LEFT JOIN proposition_type ON user_follow.resource_type = 'proposition_type' AND
+						proposition_type.id = user_follow.resource_id AND proposition_type.active = true
+					LEFT JOIN legislative_body ON user_follow.resource_type = 'legislative_body' AND
+						legislative_body.id = user_follow.resource_id AND legislative_body.active = true
+					LEFT JOIN proposition ON user_follow.resource_type = 'proposition' AND
+						proposition.article_id = user_follow.resource_id AND proposition.active = true
+				WHERE user_follow.active = true AND user_follow.user_id = $1
+			) AS followed_resource
+			WHERE user_follow_resource_name IS NOT NULL
+			ORDER BY user_follow_resource_type, user_follow_created_at DESC`
+}
+
+type userFollowDeleteSqlManager struct{}
+
+func (userFollowSqlManager) Delete() *userFollowDeleteSqlManager {
+	return &userFollowDeleteSqlManager{}
+}
+
+func (userFollowDeleteSqlManager) Follow() string {
+	return `UPDATE user_follow
+			SET active = false, updated_at = TIMEZONE('America/Sao_Paulo'::TEXT, NOW())
+			WHERE active = true AND user_id = $1 AND resource_type = $2 AND resource_id = $3`
+}
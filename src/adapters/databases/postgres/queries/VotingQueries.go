@@ -38,3 +38,27 @@ func (votingSelectSqlManager) ByArticleId() string {
 				user_article.active IS NOT false AND article.id = $1
 			GROUP BY article.id, article_type.id, voting.id, legislative_body.id, legislative_body_type.id`
 }
+
+func (votingSelectSqlManager) DeputyVotes() string {
+	return `SELECT deputy_vote.id AS deputy_vote_id, deputy_vote.vote AS deputy_vote_vote,
+				deputy.id AS deputy_id, COALESCE(deputy.name, '') AS deputy_name,
+				COALESCE(deputy.electoral_name, '') AS deputy_electoral_name,
+				COALESCE(deputy.image_url, '') AS deputy_image_url, deputy_vote.federated_unit AS deputy_federated_unit,
+				party.id AS party_id, COALESCE(party.name, '') AS party_name,
+				COALESCE(party.acronym, '') AS party_acronym, COALESCE(party.image_url, '') AS party_image_url
+			FROM deputy_vote
+				LEFT JOIN deputy ON deputy.id = deputy_vote.deputy_id
+				LEFT JOIN party ON party.id = deputy_vote.party_id
+			WHERE deputy_vote.active = true AND deputy_vote.voting_id = $1
+			ORDER BY deputy.electoral_name, party.acronym`
+}
+
+func (votingSelectSqlManager) PartyOrientations() string {
+	return `SELECT party_orientation.orientation AS party_orientation_orientation,
+				party.id AS party_id, party.name AS party_name, party.acronym AS party_acronym,
+				party.image_url AS party_image_url
+			FROM party_orientation
+				INNER JOIN party ON party.id = party_orientation.party_id
+			WHERE party_orientation.active = true AND party.active = true AND party_orientation.voting_id = $1
+			ORDER BY party.acronym`
+}
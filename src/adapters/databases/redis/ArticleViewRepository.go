@@ -0,0 +1,37 @@
+package redis
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"time"
+)
+
+type ArticleView struct {
+	connectionManager connectionManagerInterface
+}
+
+func NewArticleViewRepository(connectionManager connectionManagerInterface) *ArticleView {
+	return &ArticleView{
+		connectionManager: connectionManager,
+	}
+}
+
+func (instance ArticleView) RegisterArticleViewer(articleId uuid.UUID, viewer string,
+	deduplicationWindow time.Duration) (bool, error) {
+	redisConnection, err := instance.connectionManager.createConnection()
+	if err != nil {
+		log.Error("Error creating a connection to the Redis database: ", err.Error())
+		return false, err
+	}
+	defer instance.connectionManager.closeConnection(redisConnection)
+
+	viewerRegistered, err := redisConnection.SetNX(fmt.Sprintf("view:%s:%s", articleId, viewer), time.Now().Unix(),
+		deduplicationWindow).Result()
+	if err != nil {
+		log.Errorf("Error registering viewer %s for article %s: %s", viewer, articleId, err.Error())
+		return false, err
+	}
+
+	return viewerRegistered, nil
+}
@@ -0,0 +1,28 @@
+package workers
+
+import (
+	"context"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/config/dicontainer"
+)
+
+func startArticleViewWorker(ctx context.Context) {
+	articleViewService := dicontainer.GetArticleViewService()
+
+	ticker := time.NewTicker(articleViewService.GetFlushInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			_ = articleViewService.DrainArticleViews()
+			return
+		case <-ticker.C:
+			err := articleViewService.FlushArticleViews()
+			if err != nil {
+				log.Error("Error flushing the buffered article views: ", err.Error())
+			}
+		}
+	}
+}
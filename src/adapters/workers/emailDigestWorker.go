@@ -0,0 +1,28 @@
+package workers
+
+import (
+	"context"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/config/dicontainer"
+)
+
+func startEmailDigestWorker(ctx context.Context) {
+	emailDigestService := dicontainer.GetEmailDigestService()
+
+	ticker := time.NewTicker(emailDigestService.GetCheckInterval())
+	defer ticker.Stop()
+
+	for {
+		err := emailDigestService.SendPendingEmailDigests()
+		if err != nil {
+			log.Error("Error sending the pending email digests: ", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package workers
+
+import (
+	"context"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/config/dicontainer"
+)
+
+func startNotificationWorker(ctx context.Context) {
+	notificationService := dicontainer.GetNotificationService()
+
+	ticker := time.NewTicker(notificationService.GetGenerationInterval())
+	defer ticker.Stop()
+
+	for {
+		err := notificationService.GenerateNotifications()
+		if err != nil {
+			log.Error("Error generating the notifications: ", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
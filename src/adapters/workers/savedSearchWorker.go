@@ -0,0 +1,28 @@
+package workers
+
+import (
+	"context"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/config/dicontainer"
+)
+
+func startSavedSearchWorker(ctx context.Context) {
+	savedSearchService := dicontainer.GetSavedSearchService()
+
+	ticker := time.NewTicker(savedSearchService.GetCheckInterval())
+	defer ticker.Stop()
+
+	for {
+		err := savedSearchService.CheckPendingSavedSearches()
+		if err != nil {
+			log.Error("Error checking the saved searches for new articles: ", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package workers
+
+import (
+	"context"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/config/dicontainer"
+)
+
+func startSearchVocabularyWorker(ctx context.Context) {
+	searchService := dicontainer.GetSearchService()
+
+	ticker := time.NewTicker(searchService.GetVocabularyRefreshInterval())
+	defer ticker.Stop()
+
+	for {
+		err := searchService.RefreshVocabulary()
+		if err != nil {
+			log.Error("Error refreshing the search vocabulary: ", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package workers
+
+import (
+	"context"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/config/dicontainer"
+)
+
+func startTrendingScoreWorker(ctx context.Context) {
+	trendingScoreService := dicontainer.GetTrendingScoreService()
+
+	ticker := time.NewTicker(trendingScoreService.GetRefreshInterval())
+	defer ticker.Stop()
+
+	for {
+		err := trendingScoreService.RefreshTrendingScores()
+		if err != nil {
+			log.Error("Error refreshing the trending scores: ", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
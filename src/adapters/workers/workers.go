@@ -0,0 +1,40 @@
+package workers
+
+import (
+	"context"
+	"sync"
+)
+
+type Workers interface {
+	Start(context.Context)
+	Wait()
+}
+
+type workers struct {
+	waitGroup sync.WaitGroup
+}
+
+func New() Workers {
+	return &workers{}
+}
+
+func (instance *workers) Start(ctx context.Context) {
+	instance.run(ctx, startTrendingScoreWorker)
+	instance.run(ctx, startArticleViewWorker)
+	instance.run(ctx, startEmailDigestWorker)
+	instance.run(ctx, startNotificationWorker)
+	instance.run(ctx, startSavedSearchWorker)
+	instance.run(ctx, startSearchVocabularyWorker)
+}
+
+func (instance *workers) Wait() {
+	instance.waitGroup.Wait()
+}
+
+func (instance *workers) run(ctx context.Context, worker func(context.Context)) {
+	instance.waitGroup.Add(1)
+	go func() {
+		defer instance.waitGroup.Done()
+		worker(ctx)
+	}()
+}
@@ -12,11 +12,64 @@ func GetUserHandler() *handlers.User {
 	return handlers.NewUserHandler(GetUserService())
 }
 
+func GetFollowHandler() *handlers.Follow {
+	return handlers.NewFollowHandler(GetFollowService())
+}
+
+func GetEmailDigestHandler() *handlers.EmailDigest {
+	return handlers.NewEmailDigestHandler(GetEmailDigestService())
+}
+
+func GetNotificationHandler() *handlers.Notification {
+	return handlers.NewNotificationHandler(GetNotificationService())
+}
+
 func GetResourcesHandler() *handlers.Resources {
 	return handlers.NewResourcesHandler(GetResourcesService())
 }
 
+func GetSearchHandler() *handlers.Search {
+	return handlers.NewSearchHandler(GetSearchService())
+}
+
 func GetArticleHandler() *handlers.Article {
 	return handlers.NewArticleHandler(GetArticleService(), GetResourcesService(), GetPropositionService(),
-		GetVotingService(), GetEventService(), GetNewsletterService())
+		GetVotingService(), GetEventService(), GetNewsletterService(), GetSearchService(),
+		GetArticleViewService())
+}
+
+func GetFeedHandler() *handlers.Feed {
+	return handlers.NewFeedHandler(GetArticleService(), GetResourcesService())
+}
+
+func GetReadingListHandler() *handlers.ReadingList {
+	return handlers.NewReadingListHandler(GetReadingListService())
+}
+
+func GetSavedSearchHandler() *handlers.SavedSearch {
+	return handlers.NewSavedSearchHandler(GetSavedSearchService())
+}
+
+func GetCalendarHandler() *handlers.Calendar {
+	return handlers.NewCalendarHandler(GetCalendarService())
+}
+
+func GetDeputyHandler() *handlers.Deputy {
+	return handlers.NewDeputyHandler(GetDeputyService())
+}
+
+func GetPartyHandler() *handlers.Party {
+	return handlers.NewPartyHandler(GetPartyService())
+}
+
+func GetLegislativeBodyHandler() *handlers.LegislativeBody {
+	return handlers.NewLegislativeBodyHandler(GetLegislativeBodyService())
+}
+
+func GetStatisticsHandler() *handlers.Statistics {
+	return handlers.NewStatisticsHandler(GetStatisticsService())
+}
+
+func GetGraphHandler() *handlers.Graph {
+	return handlers.NewGraphHandler(GetGraphService())
 }
@@ -17,10 +17,22 @@ func GetResourcesPostgresRepository() interfaces.Resources {
 	return postgres.NewResourcesRepository(GetPostgresDatabaseManager())
 }
 
+func GetSearchPostgresRepository() interfaces.Search {
+	return postgres.NewSearchRepository(GetPostgresDatabaseManager())
+}
+
 func GetArticlePostgresRepository() interfaces.Article {
 	return postgres.NewArticleRepository(GetPostgresDatabaseManager())
 }
 
+func GetArticleViewPostgresRepository() interfaces.ArticleView {
+	return postgres.NewArticleViewRepository(GetPostgresDatabaseManager())
+}
+
+func GetTrendingScorePostgresRepository() interfaces.TrendingScore {
+	return postgres.NewTrendingScoreRepository(GetPostgresDatabaseManager())
+}
+
 func GetPropositionPostgresRepository() interfaces.Proposition {
 	return postgres.NewPropositionRepository(GetPostgresDatabaseManager())
 }
@@ -36,3 +48,47 @@ func GetEventPostgresRepository() interfaces.Event {
 func GetNewsletterPostgresRepository() interfaces.Newsletter {
 	return postgres.NewNewsletterRepository(GetPostgresDatabaseManager())
 }
+
+func GetReadingListPostgresRepository() interfaces.ReadingList {
+	return postgres.NewReadingListRepository(GetPostgresDatabaseManager())
+}
+
+func GetFollowPostgresRepository() interfaces.Follow {
+	return postgres.NewFollowRepository(GetPostgresDatabaseManager())
+}
+
+func GetEmailDigestPostgresRepository() interfaces.EmailDigest {
+	return postgres.NewEmailDigestRepository(GetPostgresDatabaseManager())
+}
+
+func GetNotificationPostgresRepository() interfaces.Notification {
+	return postgres.NewNotificationRepository(GetPostgresDatabaseManager())
+}
+
+func GetSavedSearchPostgresRepository() interfaces.SavedSearch {
+	return postgres.NewSavedSearchRepository(GetPostgresDatabaseManager())
+}
+
+func GetCalendarPostgresRepository() interfaces.Calendar {
+	return postgres.NewCalendarRepository(GetPostgresDatabaseManager())
+}
+
+func GetDeputyPostgresRepository() interfaces.Deputy {
+	return postgres.NewDeputyRepository(GetPostgresDatabaseManager())
+}
+
+func GetPartyPostgresRepository() interfaces.Party {
+	return postgres.NewPartyRepository(GetPostgresDatabaseManager())
+}
+
+func GetLegislativeBodyPostgresRepository() interfaces.LegislativeBody {
+	return postgres.NewLegislativeBodyRepository(GetPostgresDatabaseManager())
+}
+
+func GetStatisticsPostgresRepository() interfaces.Statistics {
+	return postgres.NewStatisticsRepository(GetPostgresDatabaseManager())
+}
+
+func GetGraphPostgresRepository() interfaces.Graph {
+	return postgres.NewGraphRepository(GetPostgresDatabaseManager())
+}
@@ -12,3 +12,7 @@ func GetRedisDatabaseManager() *redis.ConnectionManager {
 func GetSessionRedisRepository() interfaces.Session {
 	return redis.NewSessionRepository(GetRedisDatabaseManager())
 }
+
+func GetArticleViewRedisRepository() interfaces.ArticleView {
+	return redis.NewArticleViewRepository(GetRedisDatabaseManager())
+}
@@ -1,6 +1,7 @@
 package dicontainer
 
 import (
+	"sync"
 	interfaces "vnc-api/core/interfaces/services"
 	"vnc-api/core/services"
 )
@@ -17,8 +18,31 @@ func GetResourcesService() interfaces.Resources {
 	return services.NewResourcesService(GetResourcesPostgresRepository())
 }
 
+func GetSearchService() interfaces.Search {
+	return services.NewSearchService(GetSearchPostgresRepository())
+}
+
 func GetArticleService() interfaces.Article {
-	return services.NewArticleService(GetArticlePostgresRepository())
+	return services.NewArticleService(GetArticlePostgresRepository(), GetTrendingScorePostgresRepository())
+}
+
+var (
+	articleViewService     interfaces.ArticleView
+	articleViewServiceOnce sync.Once
+)
+
+// GetArticleViewService always returns the same instance, since the views buffered by the handlers are flushed by
+// the worker
+func GetArticleViewService() interfaces.ArticleView {
+	articleViewServiceOnce.Do(func() {
+		articleViewService = services.NewArticleViewService(GetArticleViewPostgresRepository(),
+			GetArticleViewRedisRepository())
+	})
+	return articleViewService
+}
+
+func GetTrendingScoreService() interfaces.TrendingScore {
+	return services.NewTrendingScoreService(GetTrendingScorePostgresRepository())
 }
 
 func GetPropositionService() interfaces.Proposition {
@@ -37,6 +61,52 @@ func GetNewsletterService() interfaces.Newsletter {
 	return services.NewNewsletterService(GetNewsletterPostgresRepository())
 }
 
+func GetReadingListService() interfaces.ReadingList {
+	return services.NewReadingListService(GetReadingListPostgresRepository())
+}
+
+func GetFollowService() interfaces.Follow {
+	return services.NewFollowService(GetFollowPostgresRepository())
+}
+
+func GetEmailDigestService() interfaces.EmailDigest {
+	return services.NewEmailDigestService(GetEmailDigestPostgresRepository(), GetSavedSearchPostgresRepository(),
+		GetArticlePostgresRepository(), GetEmailService())
+}
+
+func GetNotificationService() interfaces.Notification {
+	return services.NewNotificationService(GetNotificationPostgresRepository())
+}
+
+func GetSavedSearchService() interfaces.SavedSearch {
+	return services.NewSavedSearchService(GetSavedSearchPostgresRepository(), GetArticlePostgresRepository(),
+		GetEmailService())
+}
+
+func GetCalendarService() interfaces.Calendar {
+	return services.NewCalendarService(GetCalendarPostgresRepository())
+}
+
 func GetEmailService() interfaces.Email {
 	return services.NewEmailService()
 }
+
+func GetDeputyService() interfaces.Deputy {
+	return services.NewDeputyService(GetDeputyPostgresRepository(), GetArticlePostgresRepository())
+}
+
+func GetPartyService() interfaces.Party {
+	return services.NewPartyService(GetPartyPostgresRepository(), GetArticlePostgresRepository())
+}
+
+func GetLegislativeBodyService() interfaces.LegislativeBody {
+	return services.NewLegislativeBodyService(GetLegislativeBodyPostgresRepository(), GetArticlePostgresRepository())
+}
+
+func GetStatisticsService() interfaces.Statistics {
+	return services.NewStatisticsService(GetStatisticsPostgresRepository())
+}
+
+func GetGraphService() interfaces.Graph {
+	return services.NewGraphService(GetGraphPostgresRepository())
+}
@@ -0,0 +1,44 @@
+package articleoperation
+
+import (
+	"github.com/google/uuid"
+	"reflect"
+)
+
+const (
+	SucceededStatus = "succeeded"
+	NotFoundStatus  = "not_found"
+	ForbiddenStatus = "forbidden"
+	InvalidStatus   = "invalid"
+)
+
+type ArticleOperation struct {
+	articleId uuid.UUID
+	rating    *int
+	viewLater bool
+	status    string
+}
+
+func (instance *ArticleOperation) NewUpdater() *builder {
+	return &builder{articleOperation: instance}
+}
+
+func (instance *ArticleOperation) ArticleId() uuid.UUID {
+	return instance.articleId
+}
+
+func (instance *ArticleOperation) Rating() *int {
+	return instance.rating
+}
+
+func (instance *ArticleOperation) ViewLater() bool {
+	return instance.viewLater
+}
+
+func (instance *ArticleOperation) Status() string {
+	return instance.status
+}
+
+func (instance *ArticleOperation) IsZero() bool {
+	return reflect.DeepEqual(instance, &ArticleOperation{})
+}
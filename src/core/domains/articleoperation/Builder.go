@@ -0,0 +1,57 @@
+package articleoperation
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/utils"
+	"github.com/google/uuid"
+	"strings"
+)
+
+type builder struct {
+	articleOperation *ArticleOperation
+	invalidFields    []string
+}
+
+func NewBuilder() *builder {
+	return &builder{articleOperation: &ArticleOperation{}}
+}
+
+func (instance *builder) ArticleId(articleId uuid.UUID) *builder {
+	if !utils.IsUuidValid(articleId) {
+		instance.invalidFields = append(instance.invalidFields, "The article ID is invalid")
+		return instance
+	}
+	instance.articleOperation.articleId = articleId
+	return instance
+}
+
+func (instance *builder) Rating(rating *int) *builder {
+	if rating != nil && (*rating < 1 || *rating > 5) {
+		instance.invalidFields = append(instance.invalidFields, "The article rating value is invalid")
+		return instance
+	}
+	instance.articleOperation.rating = rating
+	return instance
+}
+
+func (instance *builder) ViewLater(viewLater bool) *builder {
+	instance.articleOperation.viewLater = viewLater
+	return instance
+}
+
+func (instance *builder) Status(status string) *builder {
+	if status != SucceededStatus && status != NotFoundStatus && status != ForbiddenStatus &&
+		status != InvalidStatus {
+		instance.invalidFields = append(instance.invalidFields, "The article operation status is invalid")
+		return instance
+	}
+	instance.articleOperation.status = status
+	return instance
+}
+
+func (instance *builder) Build() (*ArticleOperation, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.articleOperation, nil
+}
@@ -0,0 +1,33 @@
+package articleview
+
+import (
+	"github.com/google/uuid"
+	"reflect"
+	"time"
+)
+
+type ArticleView struct {
+	articleId uuid.UUID
+	userId    uuid.UUID
+	createdAt time.Time
+}
+
+func (instance *ArticleView) NewUpdater() *builder {
+	return &builder{articleView: instance}
+}
+
+func (instance *ArticleView) ArticleId() uuid.UUID {
+	return instance.articleId
+}
+
+func (instance *ArticleView) UserId() uuid.UUID {
+	return instance.userId
+}
+
+func (instance *ArticleView) CreatedAt() time.Time {
+	return instance.createdAt
+}
+
+func (instance *ArticleView) IsZero() bool {
+	return reflect.DeepEqual(instance, &ArticleView{})
+}
@@ -0,0 +1,52 @@
+package articleview
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/utils"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+type builder struct {
+	articleView   *ArticleView
+	invalidFields []string
+}
+
+func NewBuilder() *builder {
+	return &builder{articleView: &ArticleView{}}
+}
+
+func (instance *builder) ArticleId(articleId uuid.UUID) *builder {
+	if !utils.IsUuidValid(articleId) {
+		instance.invalidFields = append(instance.invalidFields, "The article ID of the view is invalid")
+		return instance
+	}
+	instance.articleView.articleId = articleId
+	return instance
+}
+
+func (instance *builder) UserId(userId uuid.UUID) *builder {
+	if userId != uuid.Nil && !utils.IsUuidValid(userId) {
+		instance.invalidFields = append(instance.invalidFields, "The user ID of the view is invalid")
+		return instance
+	}
+	instance.articleView.userId = userId
+	return instance
+}
+
+func (instance *builder) CreatedAt(createdAt time.Time) *builder {
+	if createdAt.IsZero() || createdAt.After(time.Now()) {
+		instance.invalidFields = append(instance.invalidFields, "The creation date of the view is invalid")
+		return instance
+	}
+	instance.articleView.createdAt = createdAt
+	return instance
+}
+
+func (instance *builder) Build() (*ArticleView, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.articleView, nil
+}
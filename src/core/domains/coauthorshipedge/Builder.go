@@ -0,0 +1,58 @@
+package coauthorshipedge
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/utils"
+	"github.com/google/uuid"
+	"strings"
+)
+
+type builder struct {
+	coAuthorshipEdge *CoAuthorshipEdge
+	invalidFields    []string
+}
+
+func NewBuilder() *builder {
+	return &builder{coAuthorshipEdge: &CoAuthorshipEdge{}}
+}
+
+func (instance *builder) SourceId(sourceId uuid.UUID) *builder {
+	if !utils.IsUuidValid(sourceId) {
+		instance.invalidFields = append(instance.invalidFields, "The source node ID of the co-authorship edge is "+
+			"invalid")
+		return instance
+	}
+	instance.coAuthorshipEdge.sourceId = sourceId
+	return instance
+}
+
+func (instance *builder) TargetId(targetId uuid.UUID) *builder {
+	if !utils.IsUuidValid(targetId) {
+		instance.invalidFields = append(instance.invalidFields, "The target node ID of the co-authorship edge is "+
+			"invalid")
+		return instance
+	}
+	instance.coAuthorshipEdge.targetId = targetId
+	return instance
+}
+
+func (instance *builder) Weight(weight int) *builder {
+	if weight < 1 {
+		instance.invalidFields = append(instance.invalidFields, "The weight of the co-authorship edge is invalid")
+		return instance
+	}
+	instance.coAuthorshipEdge.weight = weight
+	return instance
+}
+
+func (instance *builder) Build() (*CoAuthorshipEdge, error) {
+	if instance.coAuthorshipEdge.sourceId == instance.coAuthorshipEdge.targetId {
+		instance.invalidFields = append(instance.invalidFields, "The source and target nodes of the co-authorship "+
+			"edge cannot be the same")
+	}
+
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.coAuthorshipEdge, nil
+}
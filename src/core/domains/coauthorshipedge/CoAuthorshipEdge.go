@@ -0,0 +1,32 @@
+package coauthorshipedge
+
+import (
+	"github.com/google/uuid"
+	"reflect"
+)
+
+type CoAuthorshipEdge struct {
+	sourceId uuid.UUID
+	targetId uuid.UUID
+	weight   int
+}
+
+func (instance *CoAuthorshipEdge) NewUpdater() *builder {
+	return &builder{coAuthorshipEdge: instance}
+}
+
+func (instance *CoAuthorshipEdge) SourceId() uuid.UUID {
+	return instance.sourceId
+}
+
+func (instance *CoAuthorshipEdge) TargetId() uuid.UUID {
+	return instance.targetId
+}
+
+func (instance *CoAuthorshipEdge) Weight() int {
+	return instance.weight
+}
+
+func (instance *CoAuthorshipEdge) IsZero() bool {
+	return reflect.DeepEqual(instance, &CoAuthorshipEdge{})
+}
@@ -0,0 +1,78 @@
+package coauthorshipnode
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/utils"
+	"github.com/google/uuid"
+	"strings"
+)
+
+type builder struct {
+	coAuthorshipNode *CoAuthorshipNode
+	invalidFields    []string
+}
+
+func NewBuilder() *builder {
+	return &builder{coAuthorshipNode: &CoAuthorshipNode{}}
+}
+
+func (instance *builder) Id(id uuid.UUID) *builder {
+	if !utils.IsUuidValid(id) {
+		instance.invalidFields = append(instance.invalidFields, "The co-authorship node ID is invalid")
+		return instance
+	}
+	instance.coAuthorshipNode.id = id
+	return instance
+}
+
+func (instance *builder) Label(label string) *builder {
+	label = strings.TrimSpace(label)
+	if len(label) == 0 {
+		instance.invalidFields = append(instance.invalidFields, "The co-authorship node label is invalid")
+		return instance
+	}
+	instance.coAuthorshipNode.label = label
+	return instance
+}
+
+func (instance *builder) Type(nodeType string) *builder {
+	nodeType = strings.TrimSpace(nodeType)
+	if nodeType != "deputy" && nodeType != "external_author" {
+		instance.invalidFields = append(instance.invalidFields, "The co-authorship node type is invalid")
+		return instance
+	}
+	instance.coAuthorshipNode.nodeType = nodeType
+	return instance
+}
+
+func (instance *builder) PartyAcronym(partyAcronym string) *builder {
+	instance.coAuthorshipNode.partyAcronym = strings.TrimSpace(partyAcronym)
+	return instance
+}
+
+func (instance *builder) FederatedUnit(federatedUnit string) *builder {
+	instance.coAuthorshipNode.federatedUnit = strings.TrimSpace(federatedUnit)
+	return instance
+}
+
+func (instance *builder) ExternalAuthorType(externalAuthorType string) *builder {
+	instance.coAuthorshipNode.externalAuthorType = strings.TrimSpace(externalAuthorType)
+	return instance
+}
+
+func (instance *builder) NumberOfPropositions(numberOfPropositions int) *builder {
+	if numberOfPropositions < 1 {
+		instance.invalidFields = append(instance.invalidFields, "The number of propositions of the co-authorship "+
+			"node is invalid")
+		return instance
+	}
+	instance.coAuthorshipNode.numberOfPropositions = numberOfPropositions
+	return instance
+}
+
+func (instance *builder) Build() (*CoAuthorshipNode, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.coAuthorshipNode, nil
+}
@@ -0,0 +1,52 @@
+package coauthorshipnode
+
+import (
+	"github.com/google/uuid"
+	"reflect"
+)
+
+type CoAuthorshipNode struct {
+	id                   uuid.UUID
+	label                string
+	nodeType             string
+	partyAcronym         string
+	federatedUnit        string
+	externalAuthorType   string
+	numberOfPropositions int
+}
+
+func (instance *CoAuthorshipNode) NewUpdater() *builder {
+	return &builder{coAuthorshipNode: instance}
+}
+
+func (instance *CoAuthorshipNode) Id() uuid.UUID {
+	return instance.id
+}
+
+func (instance *CoAuthorshipNode) Label() string {
+	return instance.label
+}
+
+func (instance *CoAuthorshipNode) Type() string {
+	return instance.nodeType
+}
+
+func (instance *CoAuthorshipNode) PartyAcronym() string {
+	return instance.partyAcronym
+}
+
+func (instance *CoAuthorshipNode) FederatedUnit() string {
+	return instance.federatedUnit
+}
+
+func (instance *CoAuthorshipNode) ExternalAuthorType() string {
+	return instance.externalAuthorType
+}
+
+func (instance *CoAuthorshipNode) NumberOfPropositions() int {
+	return instance.numberOfPropositions
+}
+
+func (instance *CoAuthorshipNode) IsZero() bool {
+	return reflect.DeepEqual(instance, &CoAuthorshipNode{})
+}
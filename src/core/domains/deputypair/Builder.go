@@ -0,0 +1,78 @@
+package deputypair
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/utils"
+	"github.com/google/uuid"
+	"strings"
+)
+
+type builder struct {
+	deputyPair    *DeputyPair
+	invalidFields []string
+}
+
+func NewBuilder() *builder {
+	return &builder{deputyPair: &DeputyPair{}}
+}
+
+func (instance *builder) FirstDeputyId(firstDeputyId uuid.UUID) *builder {
+	if !utils.IsUuidValid(firstDeputyId) {
+		instance.invalidFields = append(instance.invalidFields, "The ID of the first deputy of the pair is invalid")
+		return instance
+	}
+	instance.deputyPair.firstDeputyId = firstDeputyId
+	return instance
+}
+
+func (instance *builder) SecondDeputyId(secondDeputyId uuid.UUID) *builder {
+	if !utils.IsUuidValid(secondDeputyId) {
+		instance.invalidFields = append(instance.invalidFields, "The ID of the second deputy of the pair is "+
+			"invalid")
+		return instance
+	}
+	instance.deputyPair.secondDeputyId = secondDeputyId
+	return instance
+}
+
+func (instance *builder) NumberOfCoAuthoredPropositions(numberOfCoAuthoredPropositions int) *builder {
+	if numberOfCoAuthoredPropositions < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of propositions co-authored by the "+
+			"pair of deputies is invalid")
+		return instance
+	}
+	instance.deputyPair.numberOfCoAuthoredPropositions = numberOfCoAuthoredPropositions
+	return instance
+}
+
+func (instance *builder) NumberOfCommonVotes(numberOfCommonVotes int) *builder {
+	if numberOfCommonVotes < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of votings in which both deputies of "+
+			"the pair voted is invalid")
+		return instance
+	}
+	instance.deputyPair.numberOfCommonVotes = numberOfCommonVotes
+	return instance
+}
+
+func (instance *builder) NumberOfMatchingVotes(numberOfMatchingVotes int) *builder {
+	if numberOfMatchingVotes < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of votings in which both deputies of "+
+			"the pair voted the same way is invalid")
+		return instance
+	}
+	instance.deputyPair.numberOfMatchingVotes = numberOfMatchingVotes
+	return instance
+}
+
+func (instance *builder) Build() (*DeputyPair, error) {
+	if instance.deputyPair.numberOfMatchingVotes > instance.deputyPair.numberOfCommonVotes {
+		instance.invalidFields = append(instance.invalidFields, "The number of matching votes of the pair of "+
+			"deputies cannot be greater than the number of common votes")
+	}
+
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.deputyPair, nil
+}
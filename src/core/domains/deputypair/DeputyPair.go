@@ -0,0 +1,49 @@
+package deputypair
+
+import (
+	"github.com/google/uuid"
+	"reflect"
+)
+
+type DeputyPair struct {
+	firstDeputyId                  uuid.UUID
+	secondDeputyId                 uuid.UUID
+	numberOfCoAuthoredPropositions int
+	numberOfCommonVotes            int
+	numberOfMatchingVotes          int
+}
+
+func (instance *DeputyPair) NewUpdater() *builder {
+	return &builder{deputyPair: instance}
+}
+
+func (instance *DeputyPair) FirstDeputyId() uuid.UUID {
+	return instance.firstDeputyId
+}
+
+func (instance *DeputyPair) SecondDeputyId() uuid.UUID {
+	return instance.secondDeputyId
+}
+
+func (instance *DeputyPair) NumberOfCoAuthoredPropositions() int {
+	return instance.numberOfCoAuthoredPropositions
+}
+
+func (instance *DeputyPair) NumberOfCommonVotes() int {
+	return instance.numberOfCommonVotes
+}
+
+func (instance *DeputyPair) NumberOfMatchingVotes() int {
+	return instance.numberOfMatchingVotes
+}
+
+func (instance *DeputyPair) VoteAgreementRate() float64 {
+	if instance.numberOfCommonVotes == 0 {
+		return 0
+	}
+	return float64(instance.numberOfMatchingVotes) / float64(instance.numberOfCommonVotes)
+}
+
+func (instance *DeputyPair) IsZero() bool {
+	return reflect.DeepEqual(instance, &DeputyPair{})
+}
@@ -0,0 +1,72 @@
+package deputystatistics
+
+import (
+	"errors"
+	"strings"
+	"vnc-api/core/domains/propositiontypecount"
+)
+
+type builder struct {
+	deputyStatistics *DeputyStatistics
+	invalidFields    []string
+}
+
+func NewBuilder() *builder {
+	return &builder{deputyStatistics: &DeputyStatistics{}}
+}
+
+func (instance *builder) NumberOfPropositions(numberOfPropositions int) *builder {
+	if numberOfPropositions < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of propositions of the deputy is invalid")
+		return instance
+	}
+	instance.deputyStatistics.numberOfPropositions = numberOfPropositions
+	return instance
+}
+
+func (instance *builder) PropositionTypes(propositionTypes []propositiontypecount.PropositionTypeCount) *builder {
+	instance.deputyStatistics.propositionTypes = propositionTypes
+	return instance
+}
+
+func (instance *builder) NumberOfRapporteurships(numberOfRapporteurships int) *builder {
+	if numberOfRapporteurships < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of rapporteurships of the deputy is "+
+			"invalid")
+		return instance
+	}
+	instance.deputyStatistics.numberOfRapporteurships = numberOfRapporteurships
+	return instance
+}
+
+func (instance *builder) NumberOfVotedPropositions(numberOfVotedPropositions int) *builder {
+	if numberOfVotedPropositions < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of voted propositions of the deputy is "+
+			"invalid")
+		return instance
+	}
+	instance.deputyStatistics.numberOfVotedPropositions = numberOfVotedPropositions
+	return instance
+}
+
+func (instance *builder) NumberOfApprovedPropositions(numberOfApprovedPropositions int) *builder {
+	if numberOfApprovedPropositions < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of approved propositions of the "+
+			"deputy is invalid")
+		return instance
+	}
+	instance.deputyStatistics.numberOfApprovedPropositions = numberOfApprovedPropositions
+	return instance
+}
+
+func (instance *builder) Build() (*DeputyStatistics, error) {
+	if instance.deputyStatistics.numberOfApprovedPropositions > instance.deputyStatistics.numberOfVotedPropositions {
+		instance.invalidFields = append(instance.invalidFields, "The number of approved propositions of the deputy "+
+			"cannot be greater than the number of voted propositions")
+	}
+
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.deputyStatistics, nil
+}
@@ -0,0 +1,49 @@
+package deputystatistics
+
+import (
+	"reflect"
+	"vnc-api/core/domains/propositiontypecount"
+)
+
+type DeputyStatistics struct {
+	numberOfPropositions         int
+	propositionTypes             []propositiontypecount.PropositionTypeCount
+	numberOfRapporteurships      int
+	numberOfVotedPropositions    int
+	numberOfApprovedPropositions int
+}
+
+func (instance *DeputyStatistics) NewUpdater() *builder {
+	return &builder{deputyStatistics: instance}
+}
+
+func (instance *DeputyStatistics) NumberOfPropositions() int {
+	return instance.numberOfPropositions
+}
+
+func (instance *DeputyStatistics) PropositionTypes() []propositiontypecount.PropositionTypeCount {
+	return instance.propositionTypes
+}
+
+func (instance *DeputyStatistics) NumberOfRapporteurships() int {
+	return instance.numberOfRapporteurships
+}
+
+func (instance *DeputyStatistics) NumberOfVotedPropositions() int {
+	return instance.numberOfVotedPropositions
+}
+
+func (instance *DeputyStatistics) NumberOfApprovedPropositions() int {
+	return instance.numberOfApprovedPropositions
+}
+
+func (instance *DeputyStatistics) ApprovalRate() float64 {
+	if instance.numberOfVotedPropositions == 0 {
+		return 0
+	}
+	return float64(instance.numberOfApprovedPropositions) / float64(instance.numberOfVotedPropositions)
+}
+
+func (instance *DeputyStatistics) IsZero() bool {
+	return reflect.DeepEqual(instance, &DeputyStatistics{})
+}
@@ -0,0 +1,61 @@
+package deputyvote
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/domains/deputy"
+	"github.com/devlucassantos/vnc-domains/src/utils"
+	"github.com/google/uuid"
+	"strings"
+)
+
+type builder struct {
+	deputyVote    *DeputyVote
+	invalidFields []string
+}
+
+func NewBuilder() *builder {
+	return &builder{deputyVote: &DeputyVote{}}
+}
+
+func (instance *builder) Id(id uuid.UUID) *builder {
+	if !utils.IsUuidValid(id) {
+		instance.invalidFields = append(instance.invalidFields, "The deputy vote ID is invalid")
+		return instance
+	}
+	instance.deputyVote.id = id
+	return instance
+}
+
+func (instance *builder) Deputy(deputy deputy.Deputy) *builder {
+	if deputy.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The deputy of the vote is invalid")
+		return instance
+	}
+	instance.deputyVote.deputy = deputy
+	return instance
+}
+
+func (instance *builder) Vote(vote string) *builder {
+	vote = strings.TrimSpace(vote)
+	if !IsVoteValid(vote) {
+		instance.invalidFields = append(instance.invalidFields, "The vote of the deputy is invalid")
+		return instance
+	}
+	instance.deputyVote.vote = vote
+	return instance
+}
+
+func (instance *builder) Build() (*DeputyVote, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.deputyVote, nil
+}
+
+func IsVoteValid(vote string) bool {
+	switch vote {
+	case "yes", "no", "abstention", "obstruction", "absent":
+		return true
+	}
+	return false
+}
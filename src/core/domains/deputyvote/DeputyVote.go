@@ -0,0 +1,33 @@
+package deputyvote
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/deputy"
+	"github.com/google/uuid"
+	"reflect"
+)
+
+type DeputyVote struct {
+	id     uuid.UUID
+	deputy deputy.Deputy
+	vote   string
+}
+
+func (instance *DeputyVote) NewUpdater() *builder {
+	return &builder{deputyVote: instance}
+}
+
+func (instance *DeputyVote) Id() uuid.UUID {
+	return instance.id
+}
+
+func (instance *DeputyVote) Deputy() deputy.Deputy {
+	return instance.deputy
+}
+
+func (instance *DeputyVote) Vote() string {
+	return instance.vote
+}
+
+func (instance *DeputyVote) IsZero() bool {
+	return reflect.DeepEqual(instance, &DeputyVote{})
+}
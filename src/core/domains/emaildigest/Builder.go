@@ -0,0 +1,78 @@
+package emaildigest
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/domains/user"
+	"strings"
+	"time"
+)
+
+type builder struct {
+	emailDigest   *EmailDigest
+	invalidFields []string
+}
+
+func NewBuilder() *builder {
+	return &builder{emailDigest: &EmailDigest{}}
+}
+
+func (instance *builder) User(user user.User) *builder {
+	if user.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The user of the email digest is invalid")
+		return instance
+	}
+	instance.emailDigest.user = user
+	return instance
+}
+
+func (instance *builder) Frequency(frequency string) *builder {
+	if !IsFrequencyValid(frequency) {
+		instance.invalidFields = append(instance.invalidFields, "The frequency of the email digest is invalid")
+		return instance
+	}
+	instance.emailDigest.frequency = frequency
+	return instance
+}
+
+func (instance *builder) LastSentAt(lastSentAt time.Time) *builder {
+	if lastSentAt.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The date the email digest was last sent is invalid")
+		return instance
+	}
+	instance.emailDigest.lastSentAt = lastSentAt
+	return instance
+}
+
+func (instance *builder) SentAt(sentAt time.Time) *builder {
+	if !sentAt.IsZero() && sentAt.Before(instance.emailDigest.lastSentAt) {
+		instance.invalidFields = append(instance.invalidFields, "The date the email digest is being sent is invalid")
+		return instance
+	}
+	instance.emailDigest.sentAt = sentAt
+	return instance
+}
+
+func (instance *builder) CreatedAt(createdAt time.Time) *builder {
+	if createdAt.IsZero() || createdAt.After(time.Now()) {
+		instance.invalidFields = append(instance.invalidFields, "The creation date of the email digest is invalid")
+		return instance
+	}
+	instance.emailDigest.createdAt = createdAt
+	return instance
+}
+
+func (instance *builder) UpdatedAt(updatedAt time.Time) *builder {
+	if updatedAt.IsZero() || updatedAt.After(time.Now()) {
+		instance.invalidFields = append(instance.invalidFields, "The update date of the email digest is invalid")
+		return instance
+	}
+	instance.emailDigest.updatedAt = updatedAt
+	return instance
+}
+
+func (instance *builder) Build() (*EmailDigest, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.emailDigest, nil
+}
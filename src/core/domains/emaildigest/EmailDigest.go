@@ -0,0 +1,57 @@
+package emaildigest
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/user"
+	"reflect"
+	"time"
+)
+
+const (
+	DailyFrequency  = "daily"
+	WeeklyFrequency = "weekly"
+)
+
+type EmailDigest struct {
+	user       user.User
+	frequency  string
+	lastSentAt time.Time
+	sentAt     time.Time
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+func (instance *EmailDigest) NewUpdater() *builder {
+	return &builder{emailDigest: instance}
+}
+
+func (instance *EmailDigest) User() user.User {
+	return instance.user
+}
+
+func (instance *EmailDigest) Frequency() string {
+	return instance.frequency
+}
+
+func (instance *EmailDigest) LastSentAt() time.Time {
+	return instance.lastSentAt
+}
+
+func (instance *EmailDigest) SentAt() time.Time {
+	return instance.sentAt
+}
+
+func (instance *EmailDigest) CreatedAt() time.Time {
+	return instance.createdAt
+}
+
+func (instance *EmailDigest) UpdatedAt() time.Time {
+	return instance.updatedAt
+}
+
+func (instance *EmailDigest) IsZero() bool {
+	return reflect.DeepEqual(instance, &EmailDigest{})
+}
+
+func IsFrequencyValid(frequency string) bool {
+	return frequency == DailyFrequency || frequency == WeeklyFrequency
+}
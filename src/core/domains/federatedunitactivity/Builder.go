@@ -0,0 +1,53 @@
+package federatedunitactivity
+
+import (
+	"errors"
+	"strings"
+	"vnc-api/core/domains/utils"
+)
+
+type builder struct {
+	federatedUnitActivity *FederatedUnitActivity
+	invalidFields         []string
+}
+
+func NewBuilder() *builder {
+	return &builder{federatedUnitActivity: &FederatedUnitActivity{}}
+}
+
+func (instance *builder) FederatedUnit(federatedUnit string) *builder {
+	federatedUnit = strings.TrimSpace(federatedUnit)
+	if !utils.IsFederatedUnitValid(federatedUnit) {
+		instance.invalidFields = append(instance.invalidFields, "The federated unit is invalid")
+		return instance
+	}
+	instance.federatedUnitActivity.federatedUnit = federatedUnit
+	return instance
+}
+
+func (instance *builder) NumberOfPropositions(numberOfPropositions int) *builder {
+	if numberOfPropositions < 0 {
+		instance.invalidFields = append(instance.invalidFields,
+			"The number of propositions of the federated unit is invalid")
+		return instance
+	}
+	instance.federatedUnitActivity.numberOfPropositions = numberOfPropositions
+	return instance
+}
+
+func (instance *builder) NumberOfDeputies(numberOfDeputies int) *builder {
+	if numberOfDeputies < 0 {
+		instance.invalidFields = append(instance.invalidFields,
+			"The number of deputies of the federated unit is invalid")
+		return instance
+	}
+	instance.federatedUnitActivity.numberOfDeputies = numberOfDeputies
+	return instance
+}
+
+func (instance *builder) Build() (*FederatedUnitActivity, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.federatedUnitActivity, nil
+}
@@ -0,0 +1,29 @@
+package federatedunitactivity
+
+import "reflect"
+
+type FederatedUnitActivity struct {
+	federatedUnit        string
+	numberOfPropositions int
+	numberOfDeputies     int
+}
+
+func (instance *FederatedUnitActivity) NewUpdater() *builder {
+	return &builder{federatedUnitActivity: instance}
+}
+
+func (instance *FederatedUnitActivity) FederatedUnit() string {
+	return instance.federatedUnit
+}
+
+func (instance *FederatedUnitActivity) NumberOfPropositions() int {
+	return instance.numberOfPropositions
+}
+
+func (instance *FederatedUnitActivity) NumberOfDeputies() int {
+	return instance.numberOfDeputies
+}
+
+func (instance *FederatedUnitActivity) IsZero() bool {
+	return reflect.DeepEqual(instance, &FederatedUnitActivity{})
+}
@@ -0,0 +1,62 @@
+package follow
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/utils"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+type builder struct {
+	follow        *Follow
+	invalidFields []string
+}
+
+func NewBuilder() *builder {
+	return &builder{follow: &Follow{}}
+}
+
+func (instance *builder) ResourceType(resourceType string) *builder {
+	if !IsResourceTypeValid(resourceType) {
+		instance.invalidFields = append(instance.invalidFields, "The type of the followed resource is invalid")
+		return instance
+	}
+	instance.follow.resourceType = resourceType
+	return instance
+}
+
+func (instance *builder) ResourceId(resourceId uuid.UUID) *builder {
+	if !utils.IsUuidValid(resourceId) {
+		instance.invalidFields = append(instance.invalidFields, "The ID of the followed resource is invalid")
+		return instance
+	}
+	instance.follow.resourceId = resourceId
+	return instance
+}
+
+func (instance *builder) ResourceName(resourceName string) *builder {
+	resourceName = strings.TrimSpace(resourceName)
+	if len(resourceName) == 0 {
+		instance.invalidFields = append(instance.invalidFields, "The name of the followed resource is invalid")
+		return instance
+	}
+	instance.follow.resourceName = resourceName
+	return instance
+}
+
+func (instance *builder) CreatedAt(createdAt time.Time) *builder {
+	if createdAt.IsZero() || createdAt.After(time.Now()) {
+		instance.invalidFields = append(instance.invalidFields, "The creation date of the follow is invalid")
+		return instance
+	}
+	instance.follow.createdAt = createdAt
+	return instance
+}
+
+func (instance *builder) Build() (*Follow, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.follow, nil
+}
@@ -0,0 +1,53 @@
+package follow
+
+import (
+	"github.com/google/uuid"
+	"reflect"
+	"time"
+)
+
+const (
+	DeputyResourceType          = "deputy"
+	PartyResourceType           = "party"
+	ExternalAuthorResourceType  = "external_author"
+	PropositionTypeResourceType = "proposition_type"
+	LegislativeBodyResourceType = "legislative_body"
+	PropositionResourceType     = "proposition"
+)
+
+type Follow struct {
+	resourceType string
+	resourceId   uuid.UUID
+	resourceName string
+	createdAt    time.Time
+}
+
+func (instance *Follow) NewUpdater() *builder {
+	return &builder{follow: instance}
+}
+
+func (instance *Follow) ResourceType() string {
+	return instance.resourceType
+}
+
+func (instance *Follow) ResourceId() uuid.UUID {
+	return instance.resourceId
+}
+
+func (instance *Follow) ResourceName() string {
+	return instance.resourceName
+}
+
+func (instance *Follow) CreatedAt() time.Time {
+	return instance.createdAt
+}
+
+func (instance *Follow) IsZero() bool {
+	return reflect.DeepEqual(instance, &Follow{})
+}
+
+func IsResourceTypeValid(resourceType string) bool {
+	return resourceType == DeputyResourceType || resourceType == PartyResourceType ||
+		resourceType == ExternalAuthorResourceType || resourceType == PropositionTypeResourceType ||
+		resourceType == LegislativeBodyResourceType || resourceType == PropositionResourceType
+}
@@ -0,0 +1,42 @@
+package frequentrapporteur
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/domains/deputy"
+	"strings"
+)
+
+type builder struct {
+	frequentRapporteur *FrequentRapporteur
+	invalidFields      []string
+}
+
+func NewBuilder() *builder {
+	return &builder{frequentRapporteur: &FrequentRapporteur{}}
+}
+
+func (instance *builder) Deputy(deputy deputy.Deputy) *builder {
+	if deputy.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The rapporteur deputy is invalid")
+		return instance
+	}
+	instance.frequentRapporteur.deputy = deputy
+	return instance
+}
+
+func (instance *builder) NumberOfRapporteurships(numberOfRapporteurships int) *builder {
+	if numberOfRapporteurships < 1 {
+		instance.invalidFields = append(instance.invalidFields, "The number of rapporteurships of the rapporteur is "+
+			"invalid")
+		return instance
+	}
+	instance.frequentRapporteur.numberOfRapporteurships = numberOfRapporteurships
+	return instance
+}
+
+func (instance *builder) Build() (*FrequentRapporteur, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.frequentRapporteur, nil
+}
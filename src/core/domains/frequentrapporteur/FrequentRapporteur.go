@@ -0,0 +1,27 @@
+package frequentrapporteur
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/deputy"
+	"reflect"
+)
+
+type FrequentRapporteur struct {
+	deputy                  deputy.Deputy
+	numberOfRapporteurships int
+}
+
+func (instance *FrequentRapporteur) NewUpdater() *builder {
+	return &builder{frequentRapporteur: instance}
+}
+
+func (instance *FrequentRapporteur) Deputy() deputy.Deputy {
+	return instance.deputy
+}
+
+func (instance *FrequentRapporteur) NumberOfRapporteurships() int {
+	return instance.numberOfRapporteurships
+}
+
+func (instance *FrequentRapporteur) IsZero() bool {
+	return reflect.DeepEqual(instance, &FrequentRapporteur{})
+}
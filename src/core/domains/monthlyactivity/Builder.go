@@ -0,0 +1,71 @@
+package monthlyactivity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+type builder struct {
+	monthlyActivity *MonthlyActivity
+	invalidFields   []string
+}
+
+func NewBuilder() *builder {
+	return &builder{monthlyActivity: &MonthlyActivity{}}
+}
+
+func (instance *builder) Month(month time.Time) *builder {
+	if month.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The month of the activity is invalid")
+		return instance
+	}
+	instance.monthlyActivity.month = month
+	return instance
+}
+
+func (instance *builder) NumberOfPropositions(numberOfPropositions int) *builder {
+	if numberOfPropositions < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of propositions of the month is invalid")
+		return instance
+	}
+	instance.monthlyActivity.numberOfPropositions = numberOfPropositions
+	return instance
+}
+
+func (instance *builder) NumberOfVotedPropositions(numberOfVotedPropositions int) *builder {
+	if numberOfVotedPropositions < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of voted propositions of the month is "+
+			"invalid")
+		return instance
+	}
+	instance.monthlyActivity.numberOfVotedPropositions = numberOfVotedPropositions
+	return instance
+}
+
+func (instance *builder) NumberOfApprovedPropositions(numberOfApprovedPropositions int) *builder {
+	if numberOfApprovedPropositions < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of approved propositions of the month "+
+			"is invalid")
+		return instance
+	}
+	instance.monthlyActivity.numberOfApprovedPropositions = numberOfApprovedPropositions
+	return instance
+}
+
+func (instance *builder) NumberOfRejectedPropositions(numberOfRejectedPropositions int) *builder {
+	if numberOfRejectedPropositions < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of rejected propositions of the month "+
+			"is invalid")
+		return instance
+	}
+	instance.monthlyActivity.numberOfRejectedPropositions = numberOfRejectedPropositions
+	return instance
+}
+
+func (instance *builder) Build() (*MonthlyActivity, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.monthlyActivity, nil
+}
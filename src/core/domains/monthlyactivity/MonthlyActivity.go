@@ -0,0 +1,42 @@
+package monthlyactivity
+
+import (
+	"reflect"
+	"time"
+)
+
+type MonthlyActivity struct {
+	month                        time.Time
+	numberOfPropositions         int
+	numberOfVotedPropositions    int
+	numberOfApprovedPropositions int
+	numberOfRejectedPropositions int
+}
+
+func (instance *MonthlyActivity) NewUpdater() *builder {
+	return &builder{monthlyActivity: instance}
+}
+
+func (instance *MonthlyActivity) Month() time.Time {
+	return instance.month
+}
+
+func (instance *MonthlyActivity) NumberOfPropositions() int {
+	return instance.numberOfPropositions
+}
+
+func (instance *MonthlyActivity) NumberOfVotedPropositions() int {
+	return instance.numberOfVotedPropositions
+}
+
+func (instance *MonthlyActivity) NumberOfApprovedPropositions() int {
+	return instance.numberOfApprovedPropositions
+}
+
+func (instance *MonthlyActivity) NumberOfRejectedPropositions() int {
+	return instance.numberOfRejectedPropositions
+}
+
+func (instance *MonthlyActivity) IsZero() bool {
+	return reflect.DeepEqual(instance, &MonthlyActivity{})
+}
@@ -0,0 +1,96 @@
+package notification
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/devlucassantos/vnc-domains/src/utils"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+type builder struct {
+	notification  *Notification
+	invalidFields []string
+}
+
+func NewBuilder() *builder {
+	return &builder{notification: &Notification{}}
+}
+
+func (instance *builder) Id(id uuid.UUID) *builder {
+	if !utils.IsUuidValid(id) {
+		instance.invalidFields = append(instance.invalidFields, "The notification ID is invalid")
+		return instance
+	}
+	instance.notification.id = id
+	return instance
+}
+
+func (instance *builder) Type(notificationType string) *builder {
+	if !IsTypeValid(notificationType) {
+		instance.invalidFields = append(instance.invalidFields, "The notification type is invalid")
+		return instance
+	}
+	instance.notification.notificationType = notificationType
+	return instance
+}
+
+func (instance *builder) Article(article article.Article) *builder {
+	if article.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The article of the notification is invalid")
+		return instance
+	}
+	instance.notification.article = article
+	return instance
+}
+
+func (instance *builder) PropositionArticleId(propositionArticleId uuid.UUID) *builder {
+	if !utils.IsUuidValid(propositionArticleId) {
+		instance.invalidFields = append(instance.invalidFields, "The ID of the article of the tracked proposition "+
+			"is invalid")
+		return instance
+	}
+	instance.notification.propositionArticleId = propositionArticleId
+	return instance
+}
+
+func (instance *builder) PropositionTitle(propositionTitle string) *builder {
+	propositionTitle = strings.TrimSpace(propositionTitle)
+	if len(propositionTitle) == 0 {
+		instance.invalidFields = append(instance.invalidFields, "The title of the tracked proposition is invalid")
+		return instance
+	}
+	instance.notification.propositionTitle = propositionTitle
+	return instance
+}
+
+func (instance *builder) IsRead(isRead bool) *builder {
+	instance.notification.isRead = isRead
+	return instance
+}
+
+func (instance *builder) ReadAt(readAt time.Time) *builder {
+	if readAt.IsZero() || readAt.After(time.Now()) {
+		instance.invalidFields = append(instance.invalidFields, "The date the notification was read is invalid")
+		return instance
+	}
+	instance.notification.readAt = readAt
+	return instance
+}
+
+func (instance *builder) CreatedAt(createdAt time.Time) *builder {
+	if createdAt.IsZero() || createdAt.After(time.Now()) {
+		instance.invalidFields = append(instance.invalidFields, "The creation date of the notification is invalid")
+		return instance
+	}
+	instance.notification.createdAt = createdAt
+	return instance
+}
+
+func (instance *builder) Build() (*Notification, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.notification, nil
+}
@@ -0,0 +1,74 @@
+package notification
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/google/uuid"
+	"reflect"
+	"time"
+)
+
+const (
+	PropositionVotingType     = "proposition_voting"
+	PropositionEventType      = "proposition_event"
+	PropositionNewsletterType = "proposition_newsletter"
+)
+
+type Notification struct {
+	id                   uuid.UUID
+	notificationType     string
+	article              article.Article
+	propositionArticleId uuid.UUID
+	propositionTitle     string
+	isRead               bool
+	readAt               time.Time
+	createdAt            time.Time
+}
+
+func (instance *Notification) NewUpdater() *builder {
+	return &builder{notification: instance}
+}
+
+func (instance *Notification) Id() uuid.UUID {
+	return instance.id
+}
+
+func (instance *Notification) Type() string {
+	return instance.notificationType
+}
+
+func (instance *Notification) Article() article.Article {
+	return instance.article
+}
+
+func (instance *Notification) PropositionArticleId() uuid.UUID {
+	return instance.propositionArticleId
+}
+
+func (instance *Notification) PropositionTitle() string {
+	return instance.propositionTitle
+}
+
+func (instance *Notification) IsRead() bool {
+	return instance.isRead
+}
+
+func (instance *Notification) ReadAt() time.Time {
+	return instance.readAt
+}
+
+func (instance *Notification) CreatedAt() time.Time {
+	return instance.createdAt
+}
+
+func (instance *Notification) IsZero() bool {
+	return reflect.DeepEqual(instance, &Notification{})
+}
+
+func IsTypeValid(notificationType string) bool {
+	return notificationType == PropositionVotingType || notificationType == PropositionEventType ||
+		notificationType == PropositionNewsletterType
+}
+
+func GetTypes() []string {
+	return []string{PropositionVotingType, PropositionEventType, PropositionNewsletterType}
+}
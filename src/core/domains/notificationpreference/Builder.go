@@ -0,0 +1,37 @@
+package notificationpreference
+
+import (
+	"errors"
+	"strings"
+	"vnc-api/core/domains/notification"
+)
+
+type builder struct {
+	notificationPreference *NotificationPreference
+	invalidFields          []string
+}
+
+func NewBuilder() *builder {
+	return &builder{notificationPreference: &NotificationPreference{}}
+}
+
+func (instance *builder) Type(notificationType string) *builder {
+	if !notification.IsTypeValid(notificationType) {
+		instance.invalidFields = append(instance.invalidFields, "The notification type of the preference is invalid")
+		return instance
+	}
+	instance.notificationPreference.notificationType = notificationType
+	return instance
+}
+
+func (instance *builder) Enabled(enabled bool) *builder {
+	instance.notificationPreference.enabled = enabled
+	return instance
+}
+
+func (instance *builder) Build() (*NotificationPreference, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.notificationPreference, nil
+}
@@ -0,0 +1,24 @@
+package notificationpreference
+
+import "reflect"
+
+type NotificationPreference struct {
+	notificationType string
+	enabled          bool
+}
+
+func (instance *NotificationPreference) NewUpdater() *builder {
+	return &builder{notificationPreference: instance}
+}
+
+func (instance *NotificationPreference) Type() string {
+	return instance.notificationType
+}
+
+func (instance *NotificationPreference) Enabled() bool {
+	return instance.enabled
+}
+
+func (instance *NotificationPreference) IsZero() bool {
+	return reflect.DeepEqual(instance, &NotificationPreference{})
+}
@@ -0,0 +1,50 @@
+package partyorientation
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/domains/party"
+	"strings"
+)
+
+type builder struct {
+	partyOrientation *PartyOrientation
+	invalidFields    []string
+}
+
+func NewBuilder() *builder {
+	return &builder{partyOrientation: &PartyOrientation{}}
+}
+
+func (instance *builder) Party(party party.Party) *builder {
+	if party.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The party of the orientation is invalid")
+		return instance
+	}
+	instance.partyOrientation.party = party
+	return instance
+}
+
+func (instance *builder) Orientation(orientation string) *builder {
+	orientation = strings.TrimSpace(orientation)
+	if !IsOrientationValid(orientation) {
+		instance.invalidFields = append(instance.invalidFields, "The orientation of the party is invalid")
+		return instance
+	}
+	instance.partyOrientation.orientation = orientation
+	return instance
+}
+
+func (instance *builder) Build() (*PartyOrientation, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.partyOrientation, nil
+}
+
+func IsOrientationValid(orientation string) bool {
+	switch orientation {
+	case "yes", "no", "abstention", "obstruction", "free":
+		return true
+	}
+	return false
+}
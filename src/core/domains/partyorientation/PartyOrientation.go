@@ -0,0 +1,27 @@
+package partyorientation
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/party"
+	"reflect"
+)
+
+type PartyOrientation struct {
+	party       party.Party
+	orientation string
+}
+
+func (instance *PartyOrientation) NewUpdater() *builder {
+	return &builder{partyOrientation: instance}
+}
+
+func (instance *PartyOrientation) Party() party.Party {
+	return instance.party
+}
+
+func (instance *PartyOrientation) Orientation() string {
+	return instance.orientation
+}
+
+func (instance *PartyOrientation) IsZero() bool {
+	return reflect.DeepEqual(instance, &PartyOrientation{})
+}
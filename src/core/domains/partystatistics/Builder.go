@@ -0,0 +1,88 @@
+package partystatistics
+
+import (
+	"errors"
+	"strings"
+	"vnc-api/core/domains/monthlyactivity"
+	"vnc-api/core/domains/propositiontypecount"
+)
+
+type builder struct {
+	partyStatistics *PartyStatistics
+	invalidFields   []string
+}
+
+func NewBuilder() *builder {
+	return &builder{partyStatistics: &PartyStatistics{}}
+}
+
+func (instance *builder) NumberOfDeputies(numberOfDeputies int) *builder {
+	if numberOfDeputies < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of deputies of the party is invalid")
+		return instance
+	}
+	instance.partyStatistics.numberOfDeputies = numberOfDeputies
+	return instance
+}
+
+func (instance *builder) NumberOfPropositions(numberOfPropositions int) *builder {
+	if numberOfPropositions < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of propositions of the party is invalid")
+		return instance
+	}
+	instance.partyStatistics.numberOfPropositions = numberOfPropositions
+	return instance
+}
+
+func (instance *builder) PropositionTypes(propositionTypes []propositiontypecount.PropositionTypeCount) *builder {
+	instance.partyStatistics.propositionTypes = propositionTypes
+	return instance
+}
+
+func (instance *builder) NumberOfVotedPropositions(numberOfVotedPropositions int) *builder {
+	if numberOfVotedPropositions < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of voted propositions of the party is "+
+			"invalid")
+		return instance
+	}
+	instance.partyStatistics.numberOfVotedPropositions = numberOfVotedPropositions
+	return instance
+}
+
+func (instance *builder) NumberOfApprovedPropositions(numberOfApprovedPropositions int) *builder {
+	if numberOfApprovedPropositions < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of approved propositions of the party "+
+			"is invalid")
+		return instance
+	}
+	instance.partyStatistics.numberOfApprovedPropositions = numberOfApprovedPropositions
+	return instance
+}
+
+func (instance *builder) NumberOfRejectedPropositions(numberOfRejectedPropositions int) *builder {
+	if numberOfRejectedPropositions < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of rejected propositions of the party "+
+			"is invalid")
+		return instance
+	}
+	instance.partyStatistics.numberOfRejectedPropositions = numberOfRejectedPropositions
+	return instance
+}
+
+func (instance *builder) MonthlyActivity(monthlyActivity []monthlyactivity.MonthlyActivity) *builder {
+	instance.partyStatistics.monthlyActivity = monthlyActivity
+	return instance
+}
+
+func (instance *builder) Build() (*PartyStatistics, error) {
+	if instance.partyStatistics.numberOfApprovedPropositions+instance.partyStatistics.numberOfRejectedPropositions >
+		instance.partyStatistics.numberOfVotedPropositions {
+		instance.invalidFields = append(instance.invalidFields, "The number of approved and rejected propositions "+
+			"of the party cannot be greater than the number of voted propositions")
+	}
+
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.partyStatistics, nil
+}
@@ -0,0 +1,67 @@
+package partystatistics
+
+import (
+	"reflect"
+	"vnc-api/core/domains/monthlyactivity"
+	"vnc-api/core/domains/propositiontypecount"
+)
+
+type PartyStatistics struct {
+	numberOfDeputies             int
+	numberOfPropositions         int
+	propositionTypes             []propositiontypecount.PropositionTypeCount
+	numberOfVotedPropositions    int
+	numberOfApprovedPropositions int
+	numberOfRejectedPropositions int
+	monthlyActivity              []monthlyactivity.MonthlyActivity
+}
+
+func (instance *PartyStatistics) NewUpdater() *builder {
+	return &builder{partyStatistics: instance}
+}
+
+func (instance *PartyStatistics) NumberOfDeputies() int {
+	return instance.numberOfDeputies
+}
+
+func (instance *PartyStatistics) NumberOfPropositions() int {
+	return instance.numberOfPropositions
+}
+
+func (instance *PartyStatistics) PropositionTypes() []propositiontypecount.PropositionTypeCount {
+	return instance.propositionTypes
+}
+
+func (instance *PartyStatistics) NumberOfVotedPropositions() int {
+	return instance.numberOfVotedPropositions
+}
+
+func (instance *PartyStatistics) NumberOfApprovedPropositions() int {
+	return instance.numberOfApprovedPropositions
+}
+
+func (instance *PartyStatistics) NumberOfRejectedPropositions() int {
+	return instance.numberOfRejectedPropositions
+}
+
+func (instance *PartyStatistics) MonthlyActivity() []monthlyactivity.MonthlyActivity {
+	return instance.monthlyActivity
+}
+
+func (instance *PartyStatistics) ApprovalRate() float64 {
+	if instance.numberOfVotedPropositions == 0 {
+		return 0
+	}
+	return float64(instance.numberOfApprovedPropositions) / float64(instance.numberOfVotedPropositions)
+}
+
+func (instance *PartyStatistics) RejectionRate() float64 {
+	if instance.numberOfVotedPropositions == 0 {
+		return 0
+	}
+	return float64(instance.numberOfRejectedPropositions) / float64(instance.numberOfVotedPropositions)
+}
+
+func (instance *PartyStatistics) IsZero() bool {
+	return reflect.DeepEqual(instance, &PartyStatistics{})
+}
@@ -0,0 +1,41 @@
+package propositiontypecount
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/domains/propositiontype"
+	"strings"
+)
+
+type builder struct {
+	propositionTypeCount *PropositionTypeCount
+	invalidFields        []string
+}
+
+func NewBuilder() *builder {
+	return &builder{propositionTypeCount: &PropositionTypeCount{}}
+}
+
+func (instance *builder) PropositionType(propositionType propositiontype.PropositionType) *builder {
+	if propositionType.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The proposition type is invalid")
+		return instance
+	}
+	instance.propositionTypeCount.propositionType = propositionType
+	return instance
+}
+
+func (instance *builder) NumberOfPropositions(numberOfPropositions int) *builder {
+	if numberOfPropositions < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of propositions of the type is invalid")
+		return instance
+	}
+	instance.propositionTypeCount.numberOfPropositions = numberOfPropositions
+	return instance
+}
+
+func (instance *builder) Build() (*PropositionTypeCount, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.propositionTypeCount, nil
+}
@@ -0,0 +1,27 @@
+package propositiontypecount
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/propositiontype"
+	"reflect"
+)
+
+type PropositionTypeCount struct {
+	propositionType      propositiontype.PropositionType
+	numberOfPropositions int
+}
+
+func (instance *PropositionTypeCount) NewUpdater() *builder {
+	return &builder{propositionTypeCount: instance}
+}
+
+func (instance *PropositionTypeCount) PropositionType() propositiontype.PropositionType {
+	return instance.propositionType
+}
+
+func (instance *PropositionTypeCount) NumberOfPropositions() int {
+	return instance.numberOfPropositions
+}
+
+func (instance *PropositionTypeCount) IsZero() bool {
+	return reflect.DeepEqual(instance, &PropositionTypeCount{})
+}
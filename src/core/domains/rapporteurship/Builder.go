@@ -0,0 +1,112 @@
+package rapporteurship
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/utils"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+type builder struct {
+	rapporteurship *Rapporteurship
+	invalidFields  []string
+}
+
+func NewBuilder() *builder {
+	return &builder{rapporteurship: &Rapporteurship{}}
+}
+
+func (instance *builder) Id(id uuid.UUID) *builder {
+	if !utils.IsUuidValid(id) {
+		instance.invalidFields = append(instance.invalidFields, "The rapporteurship ID is invalid")
+		return instance
+	}
+	instance.rapporteurship.id = id
+	return instance
+}
+
+func (instance *builder) Title(title string) *builder {
+	title = strings.TrimSpace(title)
+	if len(title) == 0 {
+		instance.invalidFields = append(instance.invalidFields, "The title of the agenda item of the "+
+			"rapporteurship is invalid")
+		return instance
+	}
+	instance.rapporteurship.title = title
+	return instance
+}
+
+func (instance *builder) Topic(topic string) *builder {
+	instance.rapporteurship.topic = strings.TrimSpace(topic)
+	return instance
+}
+
+func (instance *builder) Regime(regime string) *builder {
+	instance.rapporteurship.regime = strings.TrimSpace(regime)
+	return instance
+}
+
+func (instance *builder) Situation(situation string) *builder {
+	instance.rapporteurship.situation = strings.TrimSpace(situation)
+	return instance
+}
+
+func (instance *builder) PropositionArticleId(propositionArticleId uuid.UUID) *builder {
+	if !utils.IsUuidValid(propositionArticleId) {
+		instance.invalidFields = append(instance.invalidFields, "The ID of the article of the proposition of the "+
+			"rapporteurship is invalid")
+		return instance
+	}
+	instance.rapporteurship.propositionArticleId = propositionArticleId
+	return instance
+}
+
+func (instance *builder) PropositionTitle(propositionTitle string) *builder {
+	propositionTitle = strings.TrimSpace(propositionTitle)
+	if len(propositionTitle) == 0 {
+		instance.invalidFields = append(instance.invalidFields, "The title of the proposition of the "+
+			"rapporteurship is invalid")
+		return instance
+	}
+	instance.rapporteurship.propositionTitle = propositionTitle
+	return instance
+}
+
+func (instance *builder) EventArticleId(eventArticleId uuid.UUID) *builder {
+	if !utils.IsUuidValid(eventArticleId) {
+		instance.invalidFields = append(instance.invalidFields, "The ID of the article of the event of the "+
+			"rapporteurship is invalid")
+		return instance
+	}
+	instance.rapporteurship.eventArticleId = eventArticleId
+	return instance
+}
+
+func (instance *builder) EventTitle(eventTitle string) *builder {
+	eventTitle = strings.TrimSpace(eventTitle)
+	if len(eventTitle) == 0 {
+		instance.invalidFields = append(instance.invalidFields, "The title of the event of the rapporteurship is "+
+			"invalid")
+		return instance
+	}
+	instance.rapporteurship.eventTitle = eventTitle
+	return instance
+}
+
+func (instance *builder) EventStartsAt(eventStartsAt time.Time) *builder {
+	if eventStartsAt.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The start date and time of the event of the "+
+			"rapporteurship is invalid")
+		return instance
+	}
+	instance.rapporteurship.eventStartsAt = eventStartsAt
+	return instance
+}
+
+func (instance *builder) Build() (*Rapporteurship, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.rapporteurship, nil
+}
@@ -0,0 +1,68 @@
+package rapporteurship
+
+import (
+	"github.com/google/uuid"
+	"reflect"
+	"time"
+)
+
+type Rapporteurship struct {
+	id                   uuid.UUID
+	title                string
+	topic                string
+	regime               string
+	situation            string
+	propositionArticleId uuid.UUID
+	propositionTitle     string
+	eventArticleId       uuid.UUID
+	eventTitle           string
+	eventStartsAt        time.Time
+}
+
+func (instance *Rapporteurship) NewUpdater() *builder {
+	return &builder{rapporteurship: instance}
+}
+
+func (instance *Rapporteurship) Id() uuid.UUID {
+	return instance.id
+}
+
+func (instance *Rapporteurship) Title() string {
+	return instance.title
+}
+
+func (instance *Rapporteurship) Topic() string {
+	return instance.topic
+}
+
+func (instance *Rapporteurship) Regime() string {
+	return instance.regime
+}
+
+func (instance *Rapporteurship) Situation() string {
+	return instance.situation
+}
+
+func (instance *Rapporteurship) PropositionArticleId() uuid.UUID {
+	return instance.propositionArticleId
+}
+
+func (instance *Rapporteurship) PropositionTitle() string {
+	return instance.propositionTitle
+}
+
+func (instance *Rapporteurship) EventArticleId() uuid.UUID {
+	return instance.eventArticleId
+}
+
+func (instance *Rapporteurship) EventTitle() string {
+	return instance.eventTitle
+}
+
+func (instance *Rapporteurship) EventStartsAt() time.Time {
+	return instance.eventStartsAt
+}
+
+func (instance *Rapporteurship) IsZero() bool {
+	return reflect.DeepEqual(instance, &Rapporteurship{})
+}
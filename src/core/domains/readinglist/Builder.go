@@ -0,0 +1,100 @@
+package readinglist
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/utils"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type builder struct {
+	readingList   *ReadingList
+	invalidFields []string
+}
+
+func NewBuilder() *builder {
+	return &builder{readingList: &ReadingList{}}
+}
+
+func (instance *builder) Id(id uuid.UUID) *builder {
+	if !utils.IsUuidValid(id) {
+		instance.invalidFields = append(instance.invalidFields, "The reading list ID is invalid")
+		return instance
+	}
+	instance.readingList.id = id
+	return instance
+}
+
+func (instance *builder) Name(name string) *builder {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 || utf8.RuneCountInString(name) > 100 {
+		instance.invalidFields = append(instance.invalidFields, "The reading list name must be between 1 and "+
+			"100 characters long")
+		return instance
+	}
+	instance.readingList.name = name
+	return instance
+}
+
+func (instance *builder) Description(description string) *builder {
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > 500 {
+		instance.invalidFields = append(instance.invalidFields, "The reading list description must be at most "+
+			"500 characters long")
+		return instance
+	}
+	instance.readingList.description = description
+	return instance
+}
+
+func (instance *builder) IsDefault(isDefault bool) *builder {
+	instance.readingList.isDefault = isDefault
+	return instance
+}
+
+func (instance *builder) IsPublic(isPublic bool) *builder {
+	instance.readingList.isPublic = isPublic
+	return instance
+}
+
+func (instance *builder) ShareToken(shareToken string) *builder {
+	instance.readingList.shareToken = strings.TrimSpace(shareToken)
+	return instance
+}
+
+func (instance *builder) NumberOfArticles(numberOfArticles int) *builder {
+	if numberOfArticles < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of articles in the reading list is "+
+			"invalid")
+		return instance
+	}
+	instance.readingList.numberOfArticles = numberOfArticles
+	return instance
+}
+
+func (instance *builder) CreatedAt(createdAt time.Time) *builder {
+	if createdAt.IsZero() || createdAt.After(time.Now()) {
+		instance.invalidFields = append(instance.invalidFields, "The creation date of the reading list is invalid")
+		return instance
+	}
+	instance.readingList.createdAt = createdAt
+	return instance
+}
+
+func (instance *builder) UpdatedAt(updatedAt time.Time) *builder {
+	if updatedAt.IsZero() || updatedAt.After(time.Now()) {
+		instance.invalidFields = append(instance.invalidFields, "The update date of the reading list is invalid")
+		return instance
+	}
+	instance.readingList.updatedAt = updatedAt
+	return instance
+}
+
+func (instance *builder) Build() (*ReadingList, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.readingList, nil
+}
@@ -0,0 +1,65 @@
+package readinglist
+
+import (
+	"github.com/google/uuid"
+	"reflect"
+	"time"
+)
+
+const DefaultReadingListName = "Ver mais tarde"
+
+type ReadingList struct {
+	id               uuid.UUID
+	name             string
+	description      string
+	isDefault        bool
+	isPublic         bool
+	shareToken       string
+	numberOfArticles int
+	createdAt        time.Time
+	updatedAt        time.Time
+}
+
+func (instance *ReadingList) NewUpdater() *builder {
+	return &builder{readingList: instance}
+}
+
+func (instance *ReadingList) Id() uuid.UUID {
+	return instance.id
+}
+
+func (instance *ReadingList) Name() string {
+	return instance.name
+}
+
+func (instance *ReadingList) Description() string {
+	return instance.description
+}
+
+func (instance *ReadingList) IsDefault() bool {
+	return instance.isDefault
+}
+
+func (instance *ReadingList) IsPublic() bool {
+	return instance.isPublic
+}
+
+func (instance *ReadingList) ShareToken() string {
+	return instance.shareToken
+}
+
+func (instance *ReadingList) NumberOfArticles() int {
+	return instance.numberOfArticles
+}
+
+func (instance *ReadingList) CreatedAt() time.Time {
+	return instance.createdAt
+}
+
+func (instance *ReadingList) UpdatedAt() time.Time {
+	return instance.updatedAt
+}
+
+func (instance *ReadingList) IsZero() bool {
+	return reflect.DeepEqual(instance, &ReadingList{})
+}
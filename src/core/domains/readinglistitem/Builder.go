@@ -0,0 +1,64 @@
+package readinglistitem
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type builder struct {
+	readingListItem *ReadingListItem
+	invalidFields   []string
+}
+
+func NewBuilder() *builder {
+	return &builder{readingListItem: &ReadingListItem{}}
+}
+
+func (instance *builder) Article(article article.Article) *builder {
+	if article.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The article of the reading list item is invalid")
+		return instance
+	}
+	instance.readingListItem.article = article
+	return instance
+}
+
+func (instance *builder) Position(position int) *builder {
+	if position < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The position of the reading list item is invalid")
+		return instance
+	}
+	instance.readingListItem.position = position
+	return instance
+}
+
+func (instance *builder) Note(note string) *builder {
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > 1000 {
+		instance.invalidFields = append(instance.invalidFields, "The note of the reading list item must be at "+
+			"most 1000 characters long")
+		return instance
+	}
+	instance.readingListItem.note = note
+	return instance
+}
+
+func (instance *builder) CreatedAt(createdAt time.Time) *builder {
+	if createdAt.IsZero() || createdAt.After(time.Now()) {
+		instance.invalidFields = append(instance.invalidFields, "The creation date of the reading list item is "+
+			"invalid")
+		return instance
+	}
+	instance.readingListItem.createdAt = createdAt
+	return instance
+}
+
+func (instance *builder) Build() (*ReadingListItem, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.readingListItem, nil
+}
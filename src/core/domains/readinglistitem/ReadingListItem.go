@@ -0,0 +1,38 @@
+package readinglistitem
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"reflect"
+	"time"
+)
+
+type ReadingListItem struct {
+	article   article.Article
+	position  int
+	note      string
+	createdAt time.Time
+}
+
+func (instance *ReadingListItem) NewUpdater() *builder {
+	return &builder{readingListItem: instance}
+}
+
+func (instance *ReadingListItem) Article() article.Article {
+	return instance.article
+}
+
+func (instance *ReadingListItem) Position() int {
+	return instance.position
+}
+
+func (instance *ReadingListItem) Note() string {
+	return instance.note
+}
+
+func (instance *ReadingListItem) CreatedAt() time.Time {
+	return instance.createdAt
+}
+
+func (instance *ReadingListItem) IsZero() bool {
+	return reflect.DeepEqual(instance, &ReadingListItem{})
+}
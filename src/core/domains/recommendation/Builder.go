@@ -0,0 +1,73 @@
+package recommendation
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/devlucassantos/vnc-domains/src/utils"
+	"github.com/google/uuid"
+	"strings"
+)
+
+type builder struct {
+	recommendation *Recommendation
+	invalidFields  []string
+}
+
+func NewBuilder() *builder {
+	return &builder{recommendation: &Recommendation{}}
+}
+
+func (instance *builder) Article(article article.Article) *builder {
+	if article.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The recommended article is invalid")
+		return instance
+	}
+	instance.recommendation.article = article
+	return instance
+}
+
+func (instance *builder) Reason(reason string) *builder {
+	if !IsReasonValid(reason) {
+		instance.invalidFields = append(instance.invalidFields, "The reason of the recommendation is invalid")
+		return instance
+	}
+	instance.recommendation.reason = reason
+	return instance
+}
+
+func (instance *builder) ReasonArticleId(reasonArticleId uuid.UUID) *builder {
+	if !utils.IsUuidValid(reasonArticleId) {
+		instance.invalidFields = append(instance.invalidFields, "The ID of the article that motivated the "+
+			"recommendation is invalid")
+		return instance
+	}
+	instance.recommendation.reasonArticleId = reasonArticleId
+	return instance
+}
+
+func (instance *builder) ReasonArticleTitle(reasonArticleTitle string) *builder {
+	reasonArticleTitle = strings.TrimSpace(reasonArticleTitle)
+	if len(reasonArticleTitle) == 0 {
+		instance.invalidFields = append(instance.invalidFields, "The title of the article that motivated the "+
+			"recommendation is invalid")
+		return instance
+	}
+	instance.recommendation.reasonArticleTitle = reasonArticleTitle
+	return instance
+}
+
+func (instance *builder) Score(score float64) *builder {
+	if score < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The score of the recommendation is invalid")
+		return instance
+	}
+	instance.recommendation.score = score
+	return instance
+}
+
+func (instance *builder) Build() (*Recommendation, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.recommendation, nil
+}
@@ -0,0 +1,53 @@
+package recommendation
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/google/uuid"
+	"reflect"
+)
+
+const (
+	SimilarReadersReason = "similar_readers"
+	SimilarContentReason = "similar_content"
+	TrendingReason       = "trending"
+)
+
+type Recommendation struct {
+	article            article.Article
+	reason             string
+	reasonArticleId    uuid.UUID
+	reasonArticleTitle string
+	score              float64
+}
+
+func (instance *Recommendation) NewUpdater() *builder {
+	return &builder{recommendation: instance}
+}
+
+func (instance *Recommendation) Article() article.Article {
+	return instance.article
+}
+
+func (instance *Recommendation) Reason() string {
+	return instance.reason
+}
+
+func (instance *Recommendation) ReasonArticleId() uuid.UUID {
+	return instance.reasonArticleId
+}
+
+func (instance *Recommendation) ReasonArticleTitle() string {
+	return instance.reasonArticleTitle
+}
+
+func (instance *Recommendation) Score() float64 {
+	return instance.score
+}
+
+func (instance *Recommendation) IsZero() bool {
+	return reflect.DeepEqual(instance, &Recommendation{})
+}
+
+func IsReasonValid(reason string) bool {
+	return reason == SimilarReadersReason || reason == SimilarContentReason || reason == TrendingReason
+}
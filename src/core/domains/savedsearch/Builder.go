@@ -0,0 +1,138 @@
+package savedsearch
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/domains/user"
+	"github.com/devlucassantos/vnc-domains/src/utils"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+	"vnc-api/core/filters"
+)
+
+type builder struct {
+	savedSearch   *SavedSearch
+	invalidFields []string
+}
+
+func NewBuilder() *builder {
+	return &builder{savedSearch: &SavedSearch{}}
+}
+
+func (instance *builder) Id(id uuid.UUID) *builder {
+	if !utils.IsUuidValid(id) {
+		instance.invalidFields = append(instance.invalidFields, "The saved search ID is invalid")
+		return instance
+	}
+	instance.savedSearch.id = id
+	return instance
+}
+
+func (instance *builder) User(user user.User) *builder {
+	if user.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The user of the saved search is invalid")
+		return instance
+	}
+	instance.savedSearch.user = user
+	return instance
+}
+
+func (instance *builder) Name(name string) *builder {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 || utf8.RuneCountInString(name) > 100 {
+		instance.invalidFields = append(instance.invalidFields, "The saved search name must be between 1 and "+
+			"100 characters long")
+		return instance
+	} else if strings.IndexFunc(name, unicode.IsControl) >= 0 {
+		instance.invalidFields = append(instance.invalidFields, "The saved search name must not contain control "+
+			"characters")
+		return instance
+	}
+	instance.savedSearch.name = name
+	return instance
+}
+
+func (instance *builder) Filter(filter filters.Article) *builder {
+	if err := filter.HasConflict(); err != nil {
+		instance.invalidFields = append(instance.invalidFields, "The saved search filter is invalid: "+
+			err.Error())
+		return instance
+	}
+	// The pagination and the creation date belong to each execution of the search, not to the search itself
+	filter.Pagination = filters.Pagination{}
+	filter.CreatedAfter = nil
+	instance.savedSearch.filter = filter
+	return instance
+}
+
+func (instance *builder) NotifyByEmail(notifyByEmail bool) *builder {
+	instance.savedSearch.notifyByEmail = notifyByEmail
+	return instance
+}
+
+func (instance *builder) NumberOfNewArticles(numberOfNewArticles int) *builder {
+	if numberOfNewArticles < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of new articles of the saved search "+
+			"is invalid")
+		return instance
+	}
+	instance.savedSearch.numberOfNewArticles = numberOfNewArticles
+	return instance
+}
+
+func (instance *builder) LastOpenedAt(lastOpenedAt time.Time) *builder {
+	if lastOpenedAt.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The date the saved search was last opened is "+
+			"invalid")
+		return instance
+	}
+	instance.savedSearch.lastOpenedAt = lastOpenedAt
+	return instance
+}
+
+func (instance *builder) LastCheckedAt(lastCheckedAt time.Time) *builder {
+	if lastCheckedAt.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The date the saved search was last checked for "+
+			"new articles is invalid")
+		return instance
+	}
+	instance.savedSearch.lastCheckedAt = lastCheckedAt
+	return instance
+}
+
+func (instance *builder) CheckedAt(checkedAt time.Time) *builder {
+	if !checkedAt.IsZero() && checkedAt.Before(instance.savedSearch.lastCheckedAt) {
+		instance.invalidFields = append(instance.invalidFields, "The date the saved search is being checked for "+
+			"new articles is invalid")
+		return instance
+	}
+	instance.savedSearch.checkedAt = checkedAt
+	return instance
+}
+
+func (instance *builder) CreatedAt(createdAt time.Time) *builder {
+	if createdAt.IsZero() || createdAt.After(time.Now()) {
+		instance.invalidFields = append(instance.invalidFields, "The creation date of the saved search is invalid")
+		return instance
+	}
+	instance.savedSearch.createdAt = createdAt
+	return instance
+}
+
+func (instance *builder) UpdatedAt(updatedAt time.Time) *builder {
+	if updatedAt.IsZero() || updatedAt.After(time.Now()) {
+		instance.invalidFields = append(instance.invalidFields, "The update date of the saved search is invalid")
+		return instance
+	}
+	instance.savedSearch.updatedAt = updatedAt
+	return instance
+}
+
+func (instance *builder) Build() (*SavedSearch, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.savedSearch, nil
+}
@@ -0,0 +1,75 @@
+package savedsearch
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/user"
+	"github.com/google/uuid"
+	"reflect"
+	"time"
+	"vnc-api/core/filters"
+)
+
+type SavedSearch struct {
+	id                  uuid.UUID
+	user                user.User
+	name                string
+	filter              filters.Article
+	notifyByEmail       bool
+	numberOfNewArticles int
+	lastOpenedAt        time.Time
+	lastCheckedAt       time.Time
+	checkedAt           time.Time
+	createdAt           time.Time
+	updatedAt           time.Time
+}
+
+func (instance *SavedSearch) NewUpdater() *builder {
+	return &builder{savedSearch: instance}
+}
+
+func (instance *SavedSearch) Id() uuid.UUID {
+	return instance.id
+}
+
+func (instance *SavedSearch) User() user.User {
+	return instance.user
+}
+
+func (instance *SavedSearch) Name() string {
+	return instance.name
+}
+
+func (instance *SavedSearch) Filter() filters.Article {
+	return instance.filter
+}
+
+func (instance *SavedSearch) NotifyByEmail() bool {
+	return instance.notifyByEmail
+}
+
+func (instance *SavedSearch) NumberOfNewArticles() int {
+	return instance.numberOfNewArticles
+}
+
+func (instance *SavedSearch) LastOpenedAt() time.Time {
+	return instance.lastOpenedAt
+}
+
+func (instance *SavedSearch) LastCheckedAt() time.Time {
+	return instance.lastCheckedAt
+}
+
+func (instance *SavedSearch) CheckedAt() time.Time {
+	return instance.checkedAt
+}
+
+func (instance *SavedSearch) CreatedAt() time.Time {
+	return instance.createdAt
+}
+
+func (instance *SavedSearch) UpdatedAt() time.Time {
+	return instance.updatedAt
+}
+
+func (instance *SavedSearch) IsZero() bool {
+	return reflect.DeepEqual(instance, &SavedSearch{})
+}
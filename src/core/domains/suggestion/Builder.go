@@ -0,0 +1,67 @@
+package suggestion
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/utils"
+	"github.com/google/uuid"
+	"strings"
+)
+
+type builder struct {
+	suggestion    *Suggestion
+	invalidFields []string
+}
+
+func NewBuilder() *builder {
+	return &builder{suggestion: &Suggestion{}}
+}
+
+func (instance *builder) Id(id uuid.UUID) *builder {
+	if !utils.IsUuidValid(id) {
+		instance.invalidFields = append(instance.invalidFields, "The suggestion ID is invalid")
+		return instance
+	}
+	instance.suggestion.id = id
+	return instance
+}
+
+func (instance *builder) Type(_type string) *builder {
+	if _type != DeputyType && _type != PartyType && _type != LegislativeBodyType && _type != PropositionType &&
+		_type != ArticleType {
+		instance.invalidFields = append(instance.invalidFields, "The suggestion type is invalid")
+		return instance
+	}
+	instance.suggestion._type = _type
+	return instance
+}
+
+func (instance *builder) Label(label string) *builder {
+	label = strings.TrimSpace(label)
+	if len(label) == 0 {
+		instance.invalidFields = append(instance.invalidFields, "The suggestion label is invalid")
+		return instance
+	}
+	instance.suggestion.label = label
+	return instance
+}
+
+func (instance *builder) Description(description string) *builder {
+	instance.suggestion.description = strings.TrimSpace(description)
+	return instance
+}
+
+func (instance *builder) Score(score float64) *builder {
+	if score < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The suggestion score is invalid")
+		return instance
+	}
+	instance.suggestion.score = score
+	return instance
+}
+
+func (instance *builder) Build() (*Suggestion, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.suggestion, nil
+}
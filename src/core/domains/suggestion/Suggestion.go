@@ -0,0 +1,50 @@
+package suggestion
+
+import (
+	"github.com/google/uuid"
+	"reflect"
+)
+
+const (
+	DeputyType          = "deputy"
+	PartyType           = "party"
+	LegislativeBodyType = "legislative_body"
+	PropositionType     = "proposition"
+	ArticleType         = "article"
+)
+
+type Suggestion struct {
+	id          uuid.UUID
+	_type       string
+	label       string
+	description string
+	score       float64
+}
+
+func (instance *Suggestion) NewUpdater() *builder {
+	return &builder{suggestion: instance}
+}
+
+func (instance *Suggestion) Id() uuid.UUID {
+	return instance.id
+}
+
+func (instance *Suggestion) Type() string {
+	return instance._type
+}
+
+func (instance *Suggestion) Label() string {
+	return instance.label
+}
+
+func (instance *Suggestion) Description() string {
+	return instance.description
+}
+
+func (instance *Suggestion) Score() float64 {
+	return instance.score
+}
+
+func (instance *Suggestion) IsZero() bool {
+	return reflect.DeepEqual(instance, &Suggestion{})
+}
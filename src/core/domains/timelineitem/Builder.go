@@ -0,0 +1,97 @@
+package timelineitem
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/utils"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+type builder struct {
+	timelineItem  *TimelineItem
+	invalidFields []string
+}
+
+func NewBuilder() *builder {
+	return &builder{timelineItem: &TimelineItem{}}
+}
+
+func (instance *builder) Type(itemType string) *builder {
+	if !IsTypeValid(itemType) {
+		instance.invalidFields = append(instance.invalidFields, "The timeline item type is invalid")
+		return instance
+	}
+	instance.timelineItem.itemType = itemType
+	return instance
+}
+
+func (instance *builder) Date(date time.Time) *builder {
+	if date.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The date of the timeline item is invalid")
+		return instance
+	}
+	instance.timelineItem.date = date
+	return instance
+}
+
+func (instance *builder) Title(title string) *builder {
+	title = strings.TrimSpace(title)
+	if len(title) == 0 {
+		instance.invalidFields = append(instance.invalidFields, "The title of the timeline item is invalid")
+		return instance
+	}
+	instance.timelineItem.title = title
+	return instance
+}
+
+func (instance *builder) Description(description string) *builder {
+	instance.timelineItem.description = strings.TrimSpace(description)
+	return instance
+}
+
+func (instance *builder) ArticleId(articleId uuid.UUID) *builder {
+	if !utils.IsUuidValid(articleId) {
+		instance.invalidFields = append(instance.invalidFields, "The article ID of the timeline item is invalid")
+		return instance
+	}
+	instance.timelineItem.articleId = articleId
+	return instance
+}
+
+func (instance *builder) LegislativeBody(legislativeBody string) *builder {
+	instance.timelineItem.legislativeBody = strings.TrimSpace(legislativeBody)
+	return instance
+}
+
+func (instance *builder) AgendaItemRegime(agendaItemRegime string) *builder {
+	instance.timelineItem.agendaItemRegime = strings.TrimSpace(agendaItemRegime)
+	return instance
+}
+
+func (instance *builder) AgendaItemSituation(agendaItemSituation string) *builder {
+	instance.timelineItem.agendaItemSituation = strings.TrimSpace(agendaItemSituation)
+	return instance
+}
+
+func (instance *builder) EventSituation(eventSituation string) *builder {
+	instance.timelineItem.eventSituation = strings.TrimSpace(eventSituation)
+	return instance
+}
+
+func (instance *builder) VotingResult(votingResult string) *builder {
+	instance.timelineItem.votingResult = strings.TrimSpace(votingResult)
+	return instance
+}
+
+func (instance *builder) VotingIsApproved(votingIsApproved *bool) *builder {
+	instance.timelineItem.votingIsApproved = votingIsApproved
+	return instance
+}
+
+func (instance *builder) Build() (*TimelineItem, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.timelineItem, nil
+}
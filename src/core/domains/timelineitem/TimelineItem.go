@@ -0,0 +1,85 @@
+package timelineitem
+
+import (
+	"github.com/google/uuid"
+	"reflect"
+	"time"
+)
+
+const (
+	SubmissionType      = "submission"
+	EventAgendaItemType = "event_agenda_item"
+	VotingType          = "voting"
+	NewsletterType      = "newsletter"
+)
+
+type TimelineItem struct {
+	itemType            string
+	date                time.Time
+	title               string
+	description         string
+	articleId           uuid.UUID
+	legislativeBody     string
+	agendaItemRegime    string
+	agendaItemSituation string
+	eventSituation      string
+	votingResult        string
+	votingIsApproved    *bool
+}
+
+func (instance *TimelineItem) NewUpdater() *builder {
+	return &builder{timelineItem: instance}
+}
+
+func (instance *TimelineItem) Type() string {
+	return instance.itemType
+}
+
+func (instance *TimelineItem) Date() time.Time {
+	return instance.date
+}
+
+func (instance *TimelineItem) Title() string {
+	return instance.title
+}
+
+func (instance *TimelineItem) Description() string {
+	return instance.description
+}
+
+func (instance *TimelineItem) ArticleId() uuid.UUID {
+	return instance.articleId
+}
+
+func (instance *TimelineItem) LegislativeBody() string {
+	return instance.legislativeBody
+}
+
+func (instance *TimelineItem) AgendaItemRegime() string {
+	return instance.agendaItemRegime
+}
+
+func (instance *TimelineItem) AgendaItemSituation() string {
+	return instance.agendaItemSituation
+}
+
+func (instance *TimelineItem) EventSituation() string {
+	return instance.eventSituation
+}
+
+func (instance *TimelineItem) VotingResult() string {
+	return instance.votingResult
+}
+
+func (instance *TimelineItem) VotingIsApproved() *bool {
+	return instance.votingIsApproved
+}
+
+func (instance *TimelineItem) IsZero() bool {
+	return reflect.DeepEqual(instance, &TimelineItem{})
+}
+
+func IsTypeValid(itemType string) bool {
+	return itemType == SubmissionType || itemType == EventAgendaItemType || itemType == VotingType ||
+		itemType == NewsletterType
+}
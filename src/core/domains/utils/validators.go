@@ -0,0 +1,10 @@
+package utils
+
+func IsFederatedUnitValid(federatedUnit string) bool {
+	switch federatedUnit {
+	case "AC", "AL", "AP", "AM", "BA", "CE", "DF", "ES", "GO", "MA", "MT", "MS", "MG", "PA", "PB", "PR", "PE", "PI",
+		"RJ", "RN", "RS", "RO", "RR", "SC", "SP", "SE", "TO":
+		return true
+	}
+	return false
+}
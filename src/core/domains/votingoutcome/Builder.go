@@ -0,0 +1,75 @@
+package votingoutcome
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/domains/legislativebody"
+	"github.com/devlucassantos/vnc-domains/src/domains/propositiontype"
+	"strings"
+	"time"
+)
+
+type builder struct {
+	votingOutcome *VotingOutcome
+	invalidFields []string
+}
+
+func NewBuilder() *builder {
+	return &builder{votingOutcome: &VotingOutcome{}}
+}
+
+func (instance *builder) LegislativeBody(legislativeBody legislativebody.LegislativeBody) *builder {
+	if legislativeBody.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The legislative body of the votes is invalid")
+		return instance
+	}
+	instance.votingOutcome.legislativeBody = legislativeBody
+	return instance
+}
+
+func (instance *builder) PropositionType(propositionType propositiontype.PropositionType) *builder {
+	instance.votingOutcome.propositionType = propositionType
+	return instance
+}
+
+func (instance *builder) Month(month time.Time) *builder {
+	if month.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The month of the votes is invalid")
+		return instance
+	}
+	instance.votingOutcome.month = month
+	return instance
+}
+
+func (instance *builder) NumberOfApprovedVotes(numberOfApprovedVotes int) *builder {
+	if numberOfApprovedVotes < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of approved votes is invalid")
+		return instance
+	}
+	instance.votingOutcome.numberOfApprovedVotes = numberOfApprovedVotes
+	return instance
+}
+
+func (instance *builder) NumberOfRejectedVotes(numberOfRejectedVotes int) *builder {
+	if numberOfRejectedVotes < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of rejected votes is invalid")
+		return instance
+	}
+	instance.votingOutcome.numberOfRejectedVotes = numberOfRejectedVotes
+	return instance
+}
+
+func (instance *builder) NumberOfUndeterminedVotes(numberOfUndeterminedVotes int) *builder {
+	if numberOfUndeterminedVotes < 0 {
+		instance.invalidFields = append(instance.invalidFields, "The number of undetermined votes is invalid")
+		return instance
+	}
+	instance.votingOutcome.numberOfUndeterminedVotes = numberOfUndeterminedVotes
+	return instance
+}
+
+func (instance *builder) Build() (*VotingOutcome, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.votingOutcome, nil
+}
@@ -0,0 +1,53 @@
+package votingoutcome
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/legislativebody"
+	"github.com/devlucassantos/vnc-domains/src/domains/propositiontype"
+	"reflect"
+	"time"
+)
+
+type VotingOutcome struct {
+	legislativeBody           legislativebody.LegislativeBody
+	propositionType           propositiontype.PropositionType
+	month                     time.Time
+	numberOfApprovedVotes     int
+	numberOfRejectedVotes     int
+	numberOfUndeterminedVotes int
+}
+
+func (instance *VotingOutcome) NewUpdater() *builder {
+	return &builder{votingOutcome: instance}
+}
+
+func (instance *VotingOutcome) LegislativeBody() legislativebody.LegislativeBody {
+	return instance.legislativeBody
+}
+
+func (instance *VotingOutcome) PropositionType() propositiontype.PropositionType {
+	return instance.propositionType
+}
+
+func (instance *VotingOutcome) Month() time.Time {
+	return instance.month
+}
+
+func (instance *VotingOutcome) NumberOfApprovedVotes() int {
+	return instance.numberOfApprovedVotes
+}
+
+func (instance *VotingOutcome) NumberOfRejectedVotes() int {
+	return instance.numberOfRejectedVotes
+}
+
+func (instance *VotingOutcome) NumberOfUndeterminedVotes() int {
+	return instance.numberOfUndeterminedVotes
+}
+
+func (instance *VotingOutcome) NumberOfVotes() int {
+	return instance.numberOfApprovedVotes + instance.numberOfRejectedVotes + instance.numberOfUndeterminedVotes
+}
+
+func (instance *VotingOutcome) IsZero() bool {
+	return reflect.DeepEqual(instance, &VotingOutcome{})
+}
@@ -0,0 +1,126 @@
+package votingrecord
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/domains/legislativebody"
+	"github.com/devlucassantos/vnc-domains/src/domains/party"
+	"github.com/devlucassantos/vnc-domains/src/utils"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+	"vnc-api/core/domains/deputyvote"
+	"vnc-api/core/domains/partyorientation"
+)
+
+type builder struct {
+	votingRecord  *VotingRecord
+	invalidFields []string
+}
+
+func NewBuilder() *builder {
+	return &builder{votingRecord: &VotingRecord{}}
+}
+
+func (instance *builder) Id(id uuid.UUID) *builder {
+	if !utils.IsUuidValid(id) {
+		instance.invalidFields = append(instance.invalidFields, "The voting record ID is invalid")
+		return instance
+	}
+	instance.votingRecord.id = id
+	return instance
+}
+
+func (instance *builder) VotingArticleId(votingArticleId uuid.UUID) *builder {
+	if !utils.IsUuidValid(votingArticleId) {
+		instance.invalidFields = append(instance.invalidFields, "The ID of the article of the voting of the "+
+			"voting record is invalid")
+		return instance
+	}
+	instance.votingRecord.votingArticleId = votingArticleId
+	return instance
+}
+
+func (instance *builder) VotingTitle(votingTitle string) *builder {
+	votingTitle = strings.TrimSpace(votingTitle)
+	if len(votingTitle) == 0 {
+		instance.invalidFields = append(instance.invalidFields, "The title of the voting of the voting record is "+
+			"invalid")
+		return instance
+	}
+	instance.votingRecord.votingTitle = votingTitle
+	return instance
+}
+
+func (instance *builder) VotingResult(votingResult string) *builder {
+	votingResult = strings.TrimSpace(votingResult)
+	if len(votingResult) == 0 {
+		instance.invalidFields = append(instance.invalidFields, "The result of the voting of the voting record is "+
+			"invalid")
+		return instance
+	}
+	instance.votingRecord.votingResult = votingResult
+	return instance
+}
+
+func (instance *builder) VotingResultAnnouncedAt(votingResultAnnouncedAt time.Time) *builder {
+	if votingResultAnnouncedAt.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The date and time the result of the voting of the "+
+			"voting record was announced is invalid")
+		return instance
+	}
+	instance.votingRecord.votingResultAnnouncedAt = votingResultAnnouncedAt
+	return instance
+}
+
+func (instance *builder) VotingIsApproved(votingIsApproved *bool) *builder {
+	instance.votingRecord.votingIsApproved = votingIsApproved
+	return instance
+}
+
+func (instance *builder) LegislativeBody(legislativeBody legislativebody.LegislativeBody) *builder {
+	if legislativeBody.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The legislative body of the voting of the voting "+
+			"record is invalid")
+		return instance
+	}
+	instance.votingRecord.legislativeBody = legislativeBody
+	return instance
+}
+
+func (instance *builder) Party(party party.Party) *builder {
+	if party.IsZero() {
+		instance.invalidFields = append(instance.invalidFields, "The party of the deputy at the time of the vote "+
+			"is invalid")
+		return instance
+	}
+	instance.votingRecord.party = party
+	return instance
+}
+
+func (instance *builder) Vote(vote string) *builder {
+	vote = strings.TrimSpace(vote)
+	if !deputyvote.IsVoteValid(vote) {
+		instance.invalidFields = append(instance.invalidFields, "The vote of the voting record is invalid")
+		return instance
+	}
+	instance.votingRecord.vote = vote
+	return instance
+}
+
+func (instance *builder) PartyOrientation(partyOrientation string) *builder {
+	partyOrientation = strings.TrimSpace(partyOrientation)
+	if !partyorientation.IsOrientationValid(partyOrientation) {
+		instance.invalidFields = append(instance.invalidFields, "The party orientation of the voting record is "+
+			"invalid")
+		return instance
+	}
+	instance.votingRecord.partyOrientation = partyOrientation
+	return instance
+}
+
+func (instance *builder) Build() (*VotingRecord, error) {
+	if len(instance.invalidFields) > 0 {
+		return nil, errors.New(strings.Join(instance.invalidFields, "; "))
+	}
+	return instance.votingRecord, nil
+}
@@ -0,0 +1,70 @@
+package votingrecord
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/legislativebody"
+	"github.com/devlucassantos/vnc-domains/src/domains/party"
+	"github.com/google/uuid"
+	"reflect"
+	"time"
+)
+
+type VotingRecord struct {
+	id                      uuid.UUID
+	votingArticleId         uuid.UUID
+	votingTitle             string
+	votingResult            string
+	votingResultAnnouncedAt time.Time
+	votingIsApproved        *bool
+	legislativeBody         legislativebody.LegislativeBody
+	party                   party.Party
+	vote                    string
+	partyOrientation        string
+}
+
+func (instance *VotingRecord) NewUpdater() *builder {
+	return &builder{votingRecord: instance}
+}
+
+func (instance *VotingRecord) Id() uuid.UUID {
+	return instance.id
+}
+
+func (instance *VotingRecord) VotingArticleId() uuid.UUID {
+	return instance.votingArticleId
+}
+
+func (instance *VotingRecord) VotingTitle() string {
+	return instance.votingTitle
+}
+
+func (instance *VotingRecord) VotingResult() string {
+	return instance.votingResult
+}
+
+func (instance *VotingRecord) VotingResultAnnouncedAt() time.Time {
+	return instance.votingResultAnnouncedAt
+}
+
+func (instance *VotingRecord) VotingIsApproved() *bool {
+	return instance.votingIsApproved
+}
+
+func (instance *VotingRecord) LegislativeBody() legislativebody.LegislativeBody {
+	return instance.legislativeBody
+}
+
+func (instance *VotingRecord) Party() party.Party {
+	return instance.party
+}
+
+func (instance *VotingRecord) Vote() string {
+	return instance.vote
+}
+
+func (instance *VotingRecord) PartyOrientation() string {
+	return instance.partyOrientation
+}
+
+func (instance *VotingRecord) IsZero() bool {
+	return reflect.DeepEqual(instance, &VotingRecord{})
+}
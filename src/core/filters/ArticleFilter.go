@@ -13,6 +13,10 @@ type Article struct {
 	Content        string
 	StartDate      *time.Time
 	EndDate        *time.Time
+	CreatedAfter   *time.Time
+	CreatedUntil   *time.Time
+	ExcludeRead    bool
+	OnlyFollowed   bool
 	Proposition
 	Voting
 	Event
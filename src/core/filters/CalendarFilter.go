@@ -0,0 +1,10 @@
+package filters
+
+import (
+	"github.com/google/uuid"
+)
+
+type Calendar struct {
+	TypeId *uuid.UUID
+	Event
+}
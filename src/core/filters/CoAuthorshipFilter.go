@@ -0,0 +1,44 @@
+package filters
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type CoAuthorship struct {
+	StartDate            *time.Time
+	EndDate              *time.Time
+	PartyId              *uuid.UUID
+	PropositionTypeId    *uuid.UUID
+	MinimumWeight        int
+	MaximumNumberOfNodes int
+}
+
+const (
+	MaximumCoAuthorshipPeriodInDaysFilter  = 366
+	DefaultCoAuthorshipMinimumWeightFilter = 1
+)
+
+// GetPeriod completes the period of the filter so that the graph is never built from more than
+// MaximumCoAuthorshipPeriodInDaysFilter days of propositions
+func (instance CoAuthorship) GetPeriod() (time.Time, time.Time) {
+	maximumPeriod := MaximumCoAuthorshipPeriodInDaysFilter * 24 * time.Hour
+	if instance.StartDate != nil && instance.EndDate != nil {
+		return *instance.StartDate, *instance.EndDate
+	} else if instance.StartDate != nil {
+		return *instance.StartDate, instance.StartDate.Add(maximumPeriod)
+	} else if instance.EndDate != nil {
+		return instance.EndDate.Add(-maximumPeriod), *instance.EndDate
+	}
+
+	currentDate := time.Now().Truncate(24 * time.Hour)
+	return currentDate.Add(-maximumPeriod), currentDate
+}
+
+func (instance CoAuthorship) GetMinimumWeight() int {
+	if instance.MinimumWeight < 1 {
+		return DefaultCoAuthorshipMinimumWeightFilter
+	}
+
+	return instance.MinimumWeight
+}
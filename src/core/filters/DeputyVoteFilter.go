@@ -0,0 +1,7 @@
+package filters
+
+type DeputyVote struct {
+	Vote string
+	Voting
+	Pagination
+}
@@ -0,0 +1,12 @@
+package filters
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type FederatedUnitActivity struct {
+	StartDate         *time.Time
+	EndDate           *time.Time
+	PropositionTypeId *uuid.UUID
+}
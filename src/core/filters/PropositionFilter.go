@@ -9,6 +9,7 @@ type Proposition struct {
 	DeputyId         *uuid.UUID
 	PartyId          *uuid.UUID
 	ExternalAuthorId *uuid.UUID
+	FederatedUnits   []string
 }
 
 func (instance Proposition) IsZero() bool {
@@ -0,0 +1,45 @@
+package filters
+
+import (
+	"fmt"
+	"time"
+)
+
+type Recommendation struct {
+	NumberOfArticles              int
+	SeedWindow                    time.Duration
+	CandidateWindow               time.Duration
+	MaximumNumberOfSeeds          int
+	MaximumNumberOfViewsPerReader int
+	SimilarReadersWeight          float64
+	SimilarContentWeight          float64
+}
+
+const (
+	DefaultNumberOfRecommendationsFilter = 15
+	MaximumNumberOfRecommendationsFilter = 50
+)
+
+func (instance Recommendation) GetNumberOfArticles() int {
+	if instance.NumberOfArticles < 1 || instance.NumberOfArticles > MaximumNumberOfRecommendationsFilter {
+		return DefaultNumberOfRecommendationsFilter
+	}
+
+	return instance.NumberOfArticles
+}
+
+func (instance Recommendation) GetSeedWindowInterval() string {
+	return getIntervalFromDuration(instance.SeedWindow, 90*24*time.Hour)
+}
+
+func (instance Recommendation) GetCandidateWindowInterval() string {
+	return getIntervalFromDuration(instance.CandidateWindow, 30*24*time.Hour)
+}
+
+func getIntervalFromDuration(duration time.Duration, defaultDuration time.Duration) string {
+	if duration <= 0 {
+		duration = defaultDuration
+	}
+
+	return fmt.Sprintf("%d seconds", int64(duration.Seconds()))
+}
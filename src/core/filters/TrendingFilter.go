@@ -0,0 +1,39 @@
+package filters
+
+type Trending struct {
+	Window                string
+	ViewsWeight           float64
+	AverageRatingWeight   float64
+	NumberOfRatingsWeight float64
+	UsePrecomputedScores  bool
+}
+
+const (
+	OneDayTrendingWindow        = "24h"
+	OneWeekTrendingWindow       = "7d"
+	OneMonthTrendingWindow      = "30d"
+	DefaultTrendingWindowFilter = OneWeekTrendingWindow
+)
+
+func IsTrendingWindowValid(window string) bool {
+	return window == OneDayTrendingWindow || window == OneWeekTrendingWindow || window == OneMonthTrendingWindow
+}
+
+func (instance Trending) GetWindow() string {
+	if !IsTrendingWindowValid(instance.Window) {
+		return DefaultTrendingWindowFilter
+	}
+
+	return instance.Window
+}
+
+func (instance Trending) GetWindowInterval() string {
+	switch instance.GetWindow() {
+	case OneDayTrendingWindow:
+		return "24 hours"
+	case OneMonthTrendingWindow:
+		return "30 days"
+	default:
+		return "7 days"
+	}
+}
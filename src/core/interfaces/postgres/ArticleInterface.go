@@ -3,16 +3,29 @@ package postgres
 import (
 	"github.com/devlucassantos/vnc-domains/src/domains/article"
 	"github.com/google/uuid"
+	"vnc-api/core/domains/articleoperation"
+	"vnc-api/core/domains/recommendation"
 	"vnc-api/core/filters"
 )
 
 type Article interface {
 	GetArticles(filter filters.Article, userId uuid.UUID) ([]article.Article, int, error)
-	GetTrendingArticles(filter filters.Article, userId uuid.UUID) ([]article.Article, int, error)
-	GetTrendingArticlesByTypeId(articleTypeId uuid.UUID, itemsPerType int, userId uuid.UUID) ([]article.Article, error)
-	GetTrendingArticlesBySpecificTypeId(articleSpecificTypeId uuid.UUID, itemsPerType int, userId uuid.UUID) (
-		[]article.Article, error)
+	GetTrendingArticles(filter filters.Article, trendingFilter filters.Trending, userId uuid.UUID) ([]article.Article,
+		int, error)
+	GetTrendingArticlesByTypeId(articleTypeId uuid.UUID, itemsPerType int, trendingFilter filters.Trending,
+		userId uuid.UUID) ([]article.Article, error)
+	GetTrendingArticlesBySpecificTypeId(articleSpecificTypeId uuid.UUID, itemsPerType int,
+		trendingFilter filters.Trending, userId uuid.UUID) ([]article.Article, error)
 	GetArticlesToViewLater(filter filters.Article, userId uuid.UUID) ([]article.Article, int, error)
+	GetArticlesFromHistory(filter filters.Article, userId uuid.UUID) ([]article.Article, int, error)
+	GetRecommendedArticles(recommendationFilter filters.Recommendation, userId uuid.UUID) (
+		[]recommendation.Recommendation, error)
+	DeleteArticleFromHistory(userId uuid.UUID, articleId uuid.UUID) error
+	ClearHistory(userId uuid.UUID) error
 	SaveArticleRating(userId uuid.UUID, articleId uuid.UUID, rating *int) error
 	SaveArticleToViewLater(userId uuid.UUID, articleId uuid.UUID, viewLater bool) error
+	SaveArticleRatings(userId uuid.UUID, articleOperations []articleoperation.ArticleOperation) (
+		[]articleoperation.ArticleOperation, error)
+	SaveArticlesToViewLater(userId uuid.UUID, articleOperations []articleoperation.ArticleOperation) (
+		[]articleoperation.ArticleOperation, error)
 }
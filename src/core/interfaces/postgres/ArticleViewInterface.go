@@ -0,0 +1,11 @@
+package postgres
+
+import (
+	"github.com/google/uuid"
+	"vnc-api/core/domains/articleview"
+)
+
+type ArticleView interface {
+	CheckArticleAvailability(articleId uuid.UUID) error
+	SaveArticleViews(articleViews []articleview.ArticleView) error
+}
@@ -0,0 +1,15 @@
+package postgres
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/event"
+	"github.com/google/uuid"
+	"vnc-api/core/filters"
+)
+
+type Calendar interface {
+	GetCalendarEvents(filter filters.Calendar, followerId uuid.UUID, numberOfEvents int) ([]event.Event, error)
+	GetCalendarTokenByUserId(userId uuid.UUID) (string, error)
+	GetUserIdByCalendarToken(token string) (uuid.UUID, error)
+	SaveCalendarToken(userId uuid.UUID, token string) error
+	DeleteCalendarToken(userId uuid.UUID) error
+}
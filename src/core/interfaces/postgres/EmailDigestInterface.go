@@ -0,0 +1,17 @@
+package postgres
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/google/uuid"
+	"time"
+	"vnc-api/core/domains/emaildigest"
+)
+
+type EmailDigest interface {
+	GetEmailDigestByUserId(userId uuid.UUID) (*emaildigest.EmailDigest, error)
+	SaveEmailDigest(userId uuid.UUID, frequency string) (*emaildigest.EmailDigest, error)
+	DeleteEmailDigest(userId uuid.UUID) error
+	ClaimPendingEmailDigests(numberOfEmailDigests int) ([]emaildigest.EmailDigest, error)
+	RestoreEmailDigestLastSentAt(userId uuid.UUID, lastSentAt time.Time) error
+	GetEmailDigestArticles(userId uuid.UUID, startDate time.Time, numberOfArticles int) ([]article.Article, error)
+}
@@ -0,0 +1,12 @@
+package postgres
+
+import (
+	"github.com/google/uuid"
+	"vnc-api/core/domains/follow"
+)
+
+type Follow interface {
+	GetFollowsByUserId(userId uuid.UUID) ([]follow.Follow, error)
+	FollowResource(userId uuid.UUID, resourceType string, resourceId uuid.UUID) error
+	UnfollowResource(userId uuid.UUID, resourceType string, resourceId uuid.UUID) error
+}
@@ -0,0 +1,12 @@
+package postgres
+
+import (
+	"vnc-api/core/domains/coauthorshipedge"
+	"vnc-api/core/domains/coauthorshipnode"
+	"vnc-api/core/filters"
+)
+
+type Graph interface {
+	GetCoAuthorshipGraph(filter filters.CoAuthorship) ([]coauthorshipnode.CoAuthorshipNode,
+		[]coauthorshipedge.CoAuthorshipEdge, error)
+}
@@ -0,0 +1,17 @@
+package postgres
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/devlucassantos/vnc-domains/src/domains/legislativebody"
+	"github.com/google/uuid"
+	"time"
+	"vnc-api/core/domains/frequentrapporteur"
+)
+
+type LegislativeBody interface {
+	GetLegislativeBodyById(legislativeBodyId uuid.UUID) (*legislativebody.LegislativeBody, error)
+	GetLegislativeBodyUpcomingEvents(legislativeBodyId uuid.UUID, startDate time.Time, endDate time.Time,
+		numberOfEvents int) ([]article.Article, error)
+	GetLegislativeBodyFrequentRapporteurs(legislativeBodyId uuid.UUID, numberOfRapporteurs int) (
+		[]frequentrapporteur.FrequentRapporteur, error)
+}
@@ -0,0 +1,15 @@
+package postgres
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/deputy"
+	"github.com/devlucassantos/vnc-domains/src/domains/party"
+	"github.com/google/uuid"
+	"time"
+	"vnc-api/core/domains/partystatistics"
+)
+
+type Party interface {
+	GetPartyById(partyId uuid.UUID) (*party.Party, error)
+	GetPartyDeputies(partyId uuid.UUID) ([]deputy.Deputy, error)
+	GetPartyStatistics(partyId uuid.UUID, activityStartDate time.Time) (*partystatistics.PartyStatistics, error)
+}
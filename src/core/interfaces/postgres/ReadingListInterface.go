@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"github.com/google/uuid"
+	"vnc-api/core/domains/readinglist"
+	"vnc-api/core/domains/readinglistitem"
+	"vnc-api/core/filters"
+)
+
+type ReadingList interface {
+	GetReadingListsByUserId(userId uuid.UUID) ([]readinglist.ReadingList, error)
+	GetReadingListById(readingListId uuid.UUID, userId uuid.UUID) (*readinglist.ReadingList, error)
+	GetReadingListByShareToken(shareToken string) (*readinglist.ReadingList, error)
+	GetReadingListItems(readingListId uuid.UUID, pagination filters.Pagination, userId uuid.UUID) (
+		[]readinglistitem.ReadingListItem, error)
+	CreateReadingList(readingList readinglist.ReadingList, userId uuid.UUID) (uuid.UUID, error)
+	UpdateReadingList(readingList readinglist.ReadingList, userId uuid.UUID) error
+	DeleteReadingList(readingListId uuid.UUID, userId uuid.UUID) error
+	SaveArticleToReadingList(readingListId uuid.UUID, userId uuid.UUID, articleId uuid.UUID, note *string) error
+	RemoveArticleFromReadingList(readingListId uuid.UUID, userId uuid.UUID, articleId uuid.UUID) error
+	SortReadingListArticles(readingListId uuid.UUID, userId uuid.UUID, articleIds []uuid.UUID) error
+}
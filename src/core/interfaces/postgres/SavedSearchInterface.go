@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"github.com/google/uuid"
+	"time"
+	"vnc-api/core/domains/savedsearch"
+)
+
+type SavedSearch interface {
+	GetSavedSearchesByUserId(userId uuid.UUID) ([]savedsearch.SavedSearch, error)
+	GetSavedSearchById(savedSearchId uuid.UUID, userId uuid.UUID) (*savedsearch.SavedSearch, error)
+	GetNumberOfSavedSearchesByUserId(userId uuid.UUID) (int, error)
+	CreateSavedSearch(savedSearch savedsearch.SavedSearch, userId uuid.UUID) (uuid.UUID, error)
+	UpdateSavedSearch(savedSearch savedsearch.SavedSearch, userId uuid.UUID) error
+	DeleteSavedSearch(savedSearchId uuid.UUID, userId uuid.UUID) error
+	DisableSavedSearchEmailNotifications(savedSearchId uuid.UUID) error
+	MarkSavedSearchAsOpened(savedSearchId uuid.UUID, userId uuid.UUID) error
+	ClaimPendingSavedSearches(numberOfSavedSearches int, checkInterval time.Duration) ([]savedsearch.SavedSearch,
+		error)
+	SaveNumberOfNewArticles(savedSearch savedsearch.SavedSearch, numberOfNewArticles int) error
+	RestoreSavedSearchLastCheckedAt(savedSearchId uuid.UUID, lastCheckedAt time.Time) error
+}
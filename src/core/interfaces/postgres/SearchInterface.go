@@ -0,0 +1,9 @@
+package postgres
+
+import "vnc-api/core/domains/suggestion"
+
+type Search interface {
+	GetSuggestions(text string, numberOfSuggestionsPerType int) ([]suggestion.Suggestion, error)
+	GetSpellingCorrections(words []string, numberOfCorrectionsPerWord int) (map[string][]string, error)
+	RefreshVocabulary() error
+}
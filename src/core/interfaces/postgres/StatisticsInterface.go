@@ -0,0 +1,13 @@
+package postgres
+
+import (
+	"vnc-api/core/domains/federatedunitactivity"
+	"vnc-api/core/domains/votingoutcome"
+	"vnc-api/core/filters"
+)
+
+type Statistics interface {
+	GetVotingOutcomes(filter filters.Voting) ([]votingoutcome.VotingOutcome, error)
+	GetFederatedUnitActivities(filter filters.FederatedUnitActivity) ([]federatedunitactivity.FederatedUnitActivity,
+		error)
+}
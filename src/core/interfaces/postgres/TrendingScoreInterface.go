@@ -0,0 +1,11 @@
+package postgres
+
+import (
+	"time"
+	"vnc-api/core/filters"
+)
+
+type TrendingScore interface {
+	GetTrendingScoresAge(window string) (*time.Duration, error)
+	RefreshTrendingScores(trendingFilter filters.Trending, minimumRefreshInterval time.Duration) error
+}
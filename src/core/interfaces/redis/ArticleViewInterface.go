@@ -0,0 +1,10 @@
+package redis
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ArticleView interface {
+	RegisterArticleViewer(articleId uuid.UUID, viewer string, deduplicationWindow time.Duration) (bool, error)
+}
@@ -0,0 +1,14 @@
+package services
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ArticleView interface {
+	RegisterArticleView(articleId uuid.UUID, userId uuid.UUID, ipAddress string, userAgent string) error
+	RegisterLoadedArticleView(articleId uuid.UUID, userId uuid.UUID, ipAddress string, userAgent string)
+	FlushArticleViews() error
+	DrainArticleViews() error
+	GetFlushInterval() time.Duration
+}
@@ -0,0 +1,15 @@
+package services
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/event"
+	"github.com/google/uuid"
+	"vnc-api/core/filters"
+)
+
+type Calendar interface {
+	GetCalendarEvents(filter filters.Calendar) ([]event.Event, error)
+	GetPrivateCalendarEvents(token string, filter filters.Calendar) ([]event.Event, error)
+	GetPrivateCalendarToken(userId uuid.UUID) (string, error)
+	CreatePrivateCalendarToken(userId uuid.UUID) (string, error)
+	DeletePrivateCalendarToken(userId uuid.UUID) error
+}
@@ -0,0 +1,22 @@
+package services
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/devlucassantos/vnc-domains/src/domains/deputy"
+	"github.com/google/uuid"
+	"vnc-api/core/domains/deputypair"
+	"vnc-api/core/domains/deputystatistics"
+	"vnc-api/core/domains/rapporteurship"
+	"vnc-api/core/domains/votingrecord"
+	"vnc-api/core/filters"
+)
+
+type Deputy interface {
+	GetDeputyById(deputyId uuid.UUID) (*deputy.Deputy, error)
+	GetDeputyStatistics(deputyId uuid.UUID) (*deputystatistics.DeputyStatistics, error)
+	GetDeputyPropositions(deputyId uuid.UUID, pagination filters.Pagination) ([]article.Article, int, error)
+	GetDeputyRapporteurships(deputyId uuid.UUID, pagination filters.Pagination) ([]rapporteurship.Rapporteurship,
+		int, error)
+	GetDeputyVotingRecords(deputyId uuid.UUID, filter filters.DeputyVote) ([]votingrecord.VotingRecord, int, error)
+	GetDeputyPairs(deputyIds []uuid.UUID) ([]deputypair.DeputyPair, error)
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"github.com/google/uuid"
+	"time"
+	"vnc-api/core/domains/emaildigest"
+)
+
+type EmailDigest interface {
+	GetEmailDigest(userId uuid.UUID) (*emaildigest.EmailDigest, error)
+	SubscribeToEmailDigest(userId uuid.UUID, frequency string) (*emaildigest.EmailDigest, error)
+	UnsubscribeFromEmailDigest(userId uuid.UUID) error
+	GetEmailDigestUnsubscribePage(userId uuid.UUID, signature string) ([]byte, error)
+	UnsubscribeFromEmailDigestWithSignature(userId uuid.UUID, signature string) error
+	SendPendingEmailDigests() error
+	GetCheckInterval() time.Duration
+}
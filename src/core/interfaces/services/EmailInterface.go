@@ -1,9 +1,18 @@
 package services
 
 import (
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
 	"github.com/devlucassantos/vnc-domains/src/domains/user"
+	"github.com/google/uuid"
+	"vnc-api/core/domains/emaildigest"
+	"vnc-api/core/domains/savedsearch"
 )
 
 type Email interface {
 	SendUserAccountActivationEmail(userData user.User) error
+	SendEmailDigest(emailDigest emaildigest.EmailDigest, articles []article.Article,
+		savedSearches []savedsearch.SavedSearch, savedSearchArticles map[uuid.UUID][]article.Article,
+		unsubscribeUrl string) error
+	SendSavedSearchAlert(savedSearch savedsearch.SavedSearch, articles []article.Article, numberOfNewArticles int,
+		unsubscribeUrl string) error
 }
@@ -0,0 +1,12 @@
+package services
+
+import (
+	"github.com/google/uuid"
+	"vnc-api/core/domains/follow"
+)
+
+type Follow interface {
+	GetFollows(userId uuid.UUID) ([]follow.Follow, error)
+	FollowResource(userId uuid.UUID, resourceType string, resourceId uuid.UUID) error
+	UnfollowResource(userId uuid.UUID, resourceType string, resourceId uuid.UUID) error
+}
@@ -0,0 +1,17 @@
+package services
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/devlucassantos/vnc-domains/src/domains/legislativebody"
+	"github.com/google/uuid"
+	"vnc-api/core/domains/frequentrapporteur"
+)
+
+type LegislativeBody interface {
+	GetLegislativeBodyById(legislativeBodyId uuid.UUID) (*legislativebody.LegislativeBody, error)
+	GetLegislativeBodyUpcomingEvents(legislativeBodyId uuid.UUID) ([]article.Article, error)
+	GetLegislativeBodyRecentEvents(legislativeBodyId uuid.UUID) ([]article.Article, error)
+	GetLegislativeBodyRecentVotes(legislativeBodyId uuid.UUID) ([]article.Article, error)
+	GetLegislativeBodyFrequentRapporteurs(legislativeBodyId uuid.UUID) ([]frequentrapporteur.FrequentRapporteur,
+		error)
+}
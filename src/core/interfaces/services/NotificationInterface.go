@@ -0,0 +1,20 @@
+package services
+
+import (
+	"github.com/google/uuid"
+	"time"
+	"vnc-api/core/domains/notification"
+	"vnc-api/core/domains/notificationpreference"
+	"vnc-api/core/filters"
+)
+
+type Notification interface {
+	GetNotifications(userId uuid.UUID, onlyUnread bool, pagination filters.Pagination) ([]notification.Notification,
+		int, int, error)
+	MarkNotificationAsRead(userId uuid.UUID, notificationId uuid.UUID) error
+	MarkAllNotificationsAsRead(userId uuid.UUID) error
+	GetNotificationPreferences(userId uuid.UUID) ([]notificationpreference.NotificationPreference, error)
+	SaveNotificationPreference(userId uuid.UUID, notificationPreference notificationpreference.NotificationPreference) error
+	GenerateNotifications() error
+	GetGenerationInterval() time.Duration
+}
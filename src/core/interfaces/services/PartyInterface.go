@@ -0,0 +1,17 @@
+package services
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/devlucassantos/vnc-domains/src/domains/deputy"
+	"github.com/devlucassantos/vnc-domains/src/domains/party"
+	"github.com/google/uuid"
+	"vnc-api/core/domains/partystatistics"
+	"vnc-api/core/filters"
+)
+
+type Party interface {
+	GetPartyById(partyId uuid.UUID) (*party.Party, error)
+	GetPartyDeputies(partyId uuid.UUID) ([]deputy.Deputy, error)
+	GetPartyStatistics(partyId uuid.UUID, numberOfMonths int) (*partystatistics.PartyStatistics, error)
+	GetPartyPropositions(partyId uuid.UUID, pagination filters.Pagination) ([]article.Article, int, error)
+}
@@ -3,8 +3,10 @@ package services
 import (
 	"github.com/devlucassantos/vnc-domains/src/domains/proposition"
 	"github.com/google/uuid"
+	"vnc-api/core/domains/timelineitem"
 )
 
 type Proposition interface {
 	GetPropositionByArticleId(articleId uuid.UUID, userId uuid.UUID) (*proposition.Proposition, error)
+	GetPropositionTimelineByArticleId(articleId uuid.UUID) ([]timelineitem.TimelineItem, error)
 }
@@ -0,0 +1,23 @@
+package services
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/google/uuid"
+	"time"
+	"vnc-api/core/domains/savedsearch"
+	"vnc-api/core/filters"
+)
+
+type SavedSearch interface {
+	GetSavedSearches(userId uuid.UUID) ([]savedsearch.SavedSearch, error)
+	GetSavedSearchById(savedSearchId uuid.UUID, userId uuid.UUID) (*savedsearch.SavedSearch, error)
+	GetSavedSearchArticles(savedSearchId uuid.UUID, pagination filters.Pagination, userId uuid.UUID) (
+		[]article.Article, int, error)
+	CreateSavedSearch(savedSearch savedsearch.SavedSearch, userId uuid.UUID) (*savedsearch.SavedSearch, error)
+	UpdateSavedSearch(savedSearch savedsearch.SavedSearch, userId uuid.UUID) (*savedsearch.SavedSearch, error)
+	DeleteSavedSearch(savedSearchId uuid.UUID, userId uuid.UUID) error
+	GetSavedSearchAlertUnsubscribePage(savedSearchId uuid.UUID, signature string) ([]byte, error)
+	UnsubscribeFromSavedSearchAlertWithSignature(savedSearchId uuid.UUID, signature string) error
+	CheckPendingSavedSearches() error
+	GetCheckInterval() time.Duration
+}
@@ -0,0 +1,13 @@
+package services
+
+import (
+	"time"
+	"vnc-api/core/domains/suggestion"
+)
+
+type Search interface {
+	GetSuggestions(text string) ([]suggestion.Suggestion, error)
+	GetSpellingSuggestions(text string) (string, []string, error)
+	RefreshVocabulary() error
+	GetVocabularyRefreshInterval() time.Duration
+}
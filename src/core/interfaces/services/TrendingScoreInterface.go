@@ -0,0 +1,8 @@
+package services
+
+import "time"
+
+type TrendingScore interface {
+	RefreshTrendingScores() error
+	GetRefreshInterval() time.Duration
+}
@@ -3,8 +3,12 @@ package services
 import (
 	"github.com/devlucassantos/vnc-domains/src/domains/voting"
 	"github.com/google/uuid"
+	"vnc-api/core/domains/deputyvote"
+	"vnc-api/core/domains/partyorientation"
 )
 
 type Voting interface {
 	GetVotingByArticleId(articleId uuid.UUID, userId uuid.UUID) (*voting.Voting, error)
+	GetDeputyVotesByVotingId(votingId uuid.UUID) ([]deputyvote.DeputyVote, error)
+	GetPartyOrientationsByVotingId(votingId uuid.UUID) ([]partyorientation.PartyOrientation, error)
 }
@@ -3,17 +3,24 @@ package services
 import (
 	"github.com/devlucassantos/vnc-domains/src/domains/article"
 	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/core/domains/articleoperation"
+	"vnc-api/core/domains/recommendation"
 	"vnc-api/core/filters"
 	"vnc-api/core/interfaces/postgres"
+	"vnc-api/core/services/utils"
 )
 
 type Article struct {
-	repository postgres.Article
+	repository              postgres.Article
+	trendingScoreRepository postgres.TrendingScore
 }
 
-func NewArticleService(repository postgres.Article) *Article {
+func NewArticleService(repository postgres.Article, trendingScoreRepository postgres.TrendingScore) *Article {
 	return &Article{
-		repository: repository,
+		repository:              repository,
+		trendingScoreRepository: trendingScoreRepository,
 	}
 }
 
@@ -21,24 +28,72 @@ func (instance Article) GetArticles(filter filters.Article, userId uuid.UUID) ([
 	return instance.repository.GetArticles(filter, userId)
 }
 
-func (instance Article) GetTrendingArticles(filter filters.Article, userId uuid.UUID) ([]article.Article, int, error) {
-	return instance.repository.GetTrendingArticles(filter, userId)
+func (instance Article) GetTrendingArticles(filter filters.Article, trendingFilter filters.Trending,
+	userId uuid.UUID) ([]article.Article, int, error) {
+	return instance.repository.GetTrendingArticles(filter, instance.prepareTrendingFilter(trendingFilter), userId)
 }
 
-func (instance Article) GetTrendingArticlesByTypeId(articleTypeId uuid.UUID, itemsPerType int, userId uuid.UUID) (
-	[]article.Article, error) {
-	return instance.repository.GetTrendingArticlesByTypeId(articleTypeId, itemsPerType, userId)
+func (instance Article) GetTrendingArticlesByTypeId(articleTypeId uuid.UUID, itemsPerType int,
+	trendingFilter filters.Trending, userId uuid.UUID) ([]article.Article, error) {
+	return instance.repository.GetTrendingArticlesByTypeId(articleTypeId, itemsPerType,
+		instance.prepareTrendingFilter(trendingFilter), userId)
 }
 
 func (instance Article) GetTrendingArticlesBySpecificTypeId(articleSpecificTypeId uuid.UUID, itemsPerType int,
-	userId uuid.UUID) ([]article.Article, error) {
-	return instance.repository.GetTrendingArticlesBySpecificTypeId(articleSpecificTypeId, itemsPerType, userId)
+	trendingFilter filters.Trending, userId uuid.UUID) ([]article.Article, error) {
+	return instance.repository.GetTrendingArticlesBySpecificTypeId(articleSpecificTypeId, itemsPerType,
+		instance.prepareTrendingFilter(trendingFilter), userId)
+}
+
+func (instance Article) GetFollowedArticles(filter filters.Article, userId uuid.UUID) ([]article.Article, int,
+	error) {
+	filter.OnlyFollowed = true
+	return instance.repository.GetArticles(filter, userId)
 }
 
 func (instance Article) GetArticlesToViewLater(filter filters.Article, userId uuid.UUID) ([]article.Article, int, error) {
 	return instance.repository.GetArticlesToViewLater(filter, userId)
 }
 
+func (instance Article) GetArticlesFromHistory(filter filters.Article, userId uuid.UUID) ([]article.Article, int, error) {
+	return instance.repository.GetArticlesFromHistory(filter, userId)
+}
+
+func (instance Article) GetRecommendedArticles(numberOfArticles int, userId uuid.UUID) (
+	[]recommendation.Recommendation, error) {
+	recommendationFilter := getRecommendationFilter(numberOfArticles)
+
+	var recommendations []recommendation.Recommendation
+	if userId != uuid.Nil {
+		personalizedRecommendations, err := instance.repository.GetRecommendedArticles(recommendationFilter, userId)
+		if err != nil {
+			return nil, err
+		}
+		recommendations = personalizedRecommendations
+	}
+
+	numberOfMissingArticles := recommendationFilter.GetNumberOfArticles() - len(recommendations)
+	if numberOfMissingArticles <= 0 {
+		return recommendations, nil
+	}
+
+	trendingRecommendations, err := instance.getTrendingRecommendations(numberOfMissingArticles, recommendations,
+		userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(recommendations, trendingRecommendations...), nil
+}
+
+func (instance Article) DeleteArticleFromHistory(userId uuid.UUID, articleId uuid.UUID) error {
+	return instance.repository.DeleteArticleFromHistory(userId, articleId)
+}
+
+func (instance Article) ClearHistory(userId uuid.UUID) error {
+	return instance.repository.ClearHistory(userId)
+}
+
 func (instance Article) SaveArticleRating(userId uuid.UUID, articleId uuid.UUID, rating *int) error {
 	return instance.repository.SaveArticleRating(userId, articleId, rating)
 }
@@ -46,3 +101,92 @@ func (instance Article) SaveArticleRating(userId uuid.UUID, articleId uuid.UUID,
 func (instance Article) SaveArticleToViewLater(userId uuid.UUID, articleId uuid.UUID, viewLater bool) error {
 	return instance.repository.SaveArticleToViewLater(userId, articleId, viewLater)
 }
+
+func (instance Article) SaveArticleRatings(userId uuid.UUID, articleOperations []articleoperation.ArticleOperation) (
+	[]articleoperation.ArticleOperation, error) {
+	return instance.repository.SaveArticleRatings(userId, articleOperations)
+}
+
+func (instance Article) SaveArticlesToViewLater(userId uuid.UUID,
+	articleOperations []articleoperation.ArticleOperation) ([]articleoperation.ArticleOperation, error) {
+	return instance.repository.SaveArticlesToViewLater(userId, articleOperations)
+}
+
+func (instance Article) prepareTrendingFilter(trendingFilter filters.Trending) filters.Trending {
+	trendingFilter = getTrendingFilterWithScoreWeights(trendingFilter)
+
+	trendingScoresAge, err := instance.trendingScoreRepository.GetTrendingScoresAge(trendingFilter.GetWindow())
+	if err != nil {
+		log.Warnf("Error retrieving the age of the trending scores for the window %s, the scores will be "+
+			"calculated on demand: %s", trendingFilter.GetWindow(), err.Error())
+		return trendingFilter
+	}
+
+	maximumStaleness := utils.GetDurationFromEnvironmentVariable("TRENDING_SCORE_MAXIMUM_STALENESS", 30*time.Minute)
+	trendingFilter.UsePrecomputedScores = trendingScoresAge != nil && *trendingScoresAge <= maximumStaleness
+
+	return trendingFilter
+}
+
+func getTrendingFilterWithScoreWeights(trendingFilter filters.Trending) filters.Trending {
+	trendingFilter.ViewsWeight = utils.GetFloatFromEnvironmentVariable("TRENDING_VIEWS_WEIGHT", 1)
+	trendingFilter.AverageRatingWeight = utils.GetFloatFromEnvironmentVariable("TRENDING_AVERAGE_RATING_WEIGHT", 0.5)
+	trendingFilter.NumberOfRatingsWeight = utils.GetFloatFromEnvironmentVariable("TRENDING_NUMBER_OF_RATINGS_WEIGHT",
+		0.25)
+	return trendingFilter
+}
+
+func (instance Article) getTrendingRecommendations(numberOfArticles int,
+	recommendations []recommendation.Recommendation, userId uuid.UUID) ([]recommendation.Recommendation, error) {
+	recommendedArticleIds := make(map[uuid.UUID]bool)
+	for _, recommendationData := range recommendations {
+		recommendedArticle := recommendationData.Article()
+		recommendedArticleIds[recommendedArticle.Id()] = true
+	}
+
+	itemsPerPage := numberOfArticles + len(recommendations)
+	trendingArticles, _, err := instance.GetTrendingArticles(filters.Article{
+		Pagination:  filters.Pagination{ItemsPerPage: &itemsPerPage},
+		ExcludeRead: true,
+	}, filters.Trending{}, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var trendingRecommendations []recommendation.Recommendation
+	for _, trendingArticle := range trendingArticles {
+		if len(trendingRecommendations) == numberOfArticles {
+			break
+		} else if recommendedArticleIds[trendingArticle.Id()] {
+			continue
+		}
+
+		trendingRecommendation, err := recommendation.NewBuilder().
+			Article(trendingArticle).
+			Reason(recommendation.TrendingReason).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for the trending recommendation of article %s: %s",
+				trendingArticle.Id(), err.Error())
+			return nil, err
+		}
+
+		trendingRecommendations = append(trendingRecommendations, *trendingRecommendation)
+	}
+
+	return trendingRecommendations, nil
+}
+
+func getRecommendationFilter(numberOfArticles int) filters.Recommendation {
+	return filters.Recommendation{
+		NumberOfArticles: numberOfArticles,
+		SeedWindow:       utils.GetDurationFromEnvironmentVariable("RECOMMENDATION_SEED_WINDOW", 90*24*time.Hour),
+		CandidateWindow: utils.GetDurationFromEnvironmentVariable("RECOMMENDATION_CANDIDATE_WINDOW",
+			30*24*time.Hour),
+		MaximumNumberOfSeeds: utils.GetIntFromEnvironmentVariable("RECOMMENDATION_MAXIMUM_NUMBER_OF_SEEDS", 20),
+		MaximumNumberOfViewsPerReader: utils.GetIntFromEnvironmentVariable(
+			"RECOMMENDATION_MAXIMUM_NUMBER_OF_VIEWS_PER_READER", 50),
+		SimilarReadersWeight: utils.GetFloatFromEnvironmentVariable("RECOMMENDATION_SIMILAR_READERS_WEIGHT", 1),
+		SimilarContentWeight: utils.GetFloatFromEnvironmentVariable("RECOMMENDATION_SIMILAR_CONTENT_WEIGHT", 0.5),
+	}
+}
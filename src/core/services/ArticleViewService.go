@@ -0,0 +1,226 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"vnc-api/core/domains/articleview"
+	"vnc-api/core/interfaces/postgres"
+	"vnc-api/core/interfaces/redis"
+	"vnc-api/core/services/utils"
+)
+
+var botUserAgentPatterns = []string{"bot", "crawler", "spider", "crawling", "slurp", "facebookexternalhit",
+	"embedly", "bingpreview", "headless", "lighthouse", "pingdom", "uptimerobot", "site24x7-monitor", "curl", "wget",
+	"python-requests", "python-urllib", "go-http-client", "java/", "okhttp", "libwww", "scrapy", "httpclient"}
+
+type articleViewBuffer struct {
+	mutex               sync.Mutex
+	flushMutex          sync.Mutex
+	pendingArticleViews sync.WaitGroup
+	articleViews        []articleview.ArticleView
+}
+
+type ArticleView struct {
+	repository      postgres.ArticleView
+	redisRepository redis.ArticleView
+	buffer          *articleViewBuffer
+}
+
+func NewArticleViewService(repository postgres.ArticleView, redisRepository redis.ArticleView) *ArticleView {
+	return &ArticleView{
+		repository:      repository,
+		redisRepository: redisRepository,
+		buffer:          &articleViewBuffer{},
+	}
+}
+
+func (instance ArticleView) RegisterArticleView(articleId uuid.UUID, userId uuid.UUID, ipAddress string,
+	userAgent string) error {
+	if isBotUserAgent(userAgent) {
+		return nil
+	}
+
+	err := instance.repository.CheckArticleAvailability(articleId)
+	if err != nil {
+		return err
+	}
+
+	instance.bufferArticleViewInBackground(articleId, userId, ipAddress, userAgent)
+
+	return nil
+}
+
+// RegisterLoadedArticleView registers the view of an article that has just been retrieved, so its availability is not
+// checked again. The view is deduplicated and buffered in the background so that the request never waits for Redis
+func (instance ArticleView) RegisterLoadedArticleView(articleId uuid.UUID, userId uuid.UUID, ipAddress string,
+	userAgent string) {
+	if isBotUserAgent(userAgent) {
+		return
+	}
+
+	instance.bufferArticleViewInBackground(articleId, userId, ipAddress, userAgent)
+}
+
+func (instance ArticleView) FlushArticleViews() error {
+	instance.buffer.flushMutex.Lock()
+	defer instance.buffer.flushMutex.Unlock()
+
+	instance.buffer.mutex.Lock()
+	articleViews := instance.buffer.articleViews
+	instance.buffer.articleViews = nil
+	instance.buffer.mutex.Unlock()
+
+	batchSize := getArticleViewFlushBatchSize()
+	for start := 0; start < len(articleViews); start += batchSize {
+		end := min(start+batchSize, len(articleViews))
+		err := instance.repository.SaveArticleViews(articleViews[start:end])
+		if err != nil {
+			instance.buffer.requeue(articleViews[start:])
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (instance ArticleView) DrainArticleViews() error {
+	instance.buffer.pendingArticleViews.Wait()
+
+	maximumNumberOfAttempts := 3
+	var err error
+	for attempt := 1; attempt <= maximumNumberOfAttempts; attempt++ {
+		err = instance.FlushArticleViews()
+		if err == nil {
+			return nil
+		}
+
+		log.Warnf("Error draining the buffered article views (Attempt %d of %d): %s", attempt,
+			maximumNumberOfAttempts, err.Error())
+		if attempt < maximumNumberOfAttempts {
+			time.Sleep(time.Duration(attempt) * time.Second)
+		}
+	}
+
+	instance.buffer.mutex.Lock()
+	numberOfLostArticleViews := len(instance.buffer.articleViews)
+	instance.buffer.mutex.Unlock()
+	log.Errorf("%d buffered article views were lost because they could not be registered before shutting down",
+		numberOfLostArticleViews)
+
+	return err
+}
+
+func (instance ArticleView) GetFlushInterval() time.Duration {
+	return utils.GetDurationFromEnvironmentVariable("ARTICLE_VIEW_FLUSH_INTERVAL", 10*time.Second)
+}
+
+func (instance ArticleView) bufferArticleViewInBackground(articleId uuid.UUID, userId uuid.UUID, ipAddress string,
+	userAgent string) {
+	instance.buffer.pendingArticleViews.Add(1)
+	go func() {
+		defer instance.buffer.pendingArticleViews.Done()
+		instance.bufferArticleView(articleId, userId, ipAddress, userAgent)
+	}()
+}
+
+func (instance ArticleView) bufferArticleView(articleId uuid.UUID, userId uuid.UUID, ipAddress string,
+	userAgent string) {
+	viewer := getArticleViewer(userId, ipAddress, userAgent)
+	deduplicationWindow := utils.GetDurationFromEnvironmentVariable("ARTICLE_VIEW_DEDUPLICATION_WINDOW",
+		30*time.Minute)
+	viewerRegistered, err := instance.redisRepository.RegisterArticleViewer(articleId, viewer, deduplicationWindow)
+	if err != nil {
+		log.Warnf("Error checking whether the view of article %s by viewer %s is duplicated, the view will be "+
+			"registered anyway: %s", articleId, viewer, err.Error())
+	} else if !viewerRegistered {
+		return
+	}
+
+	articleView, err := articleview.NewBuilder().
+		ArticleId(articleId).
+		UserId(userId).
+		CreatedAt(time.Now()).
+		Build()
+	if err != nil {
+		log.Errorf("Error validating the view of article %s by viewer %s: %s", articleId, viewer, err.Error())
+		return
+	}
+
+	numberOfBufferedArticleViews := instance.buffer.add(*articleView)
+	if numberOfBufferedArticleViews >= getArticleViewFlushBatchSize() {
+		err = instance.FlushArticleViews()
+		if err != nil {
+			log.Error("Error flushing the buffered article views: ", err.Error())
+		}
+	}
+}
+
+func (instance *articleViewBuffer) add(articleView articleview.ArticleView) int {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+
+	instance.articleViews = append(instance.articleViews, articleView)
+	instance.discardOldestArticleViews()
+
+	return len(instance.articleViews)
+}
+
+func (instance *articleViewBuffer) requeue(articleViews []articleview.ArticleView) {
+	instance.mutex.Lock()
+	defer instance.mutex.Unlock()
+
+	instance.articleViews = append(articleViews, instance.articleViews...)
+	instance.discardOldestArticleViews()
+}
+
+// discardOldestArticleViews must be called with the mutex of the buffer locked
+func (instance *articleViewBuffer) discardOldestArticleViews() {
+	maximumBufferSize := utils.GetIntFromEnvironmentVariable("ARTICLE_VIEW_MAXIMUM_BUFFER_SIZE", 100000)
+	numberOfDiscardedArticleViews := len(instance.articleViews) - maximumBufferSize
+	if numberOfDiscardedArticleViews > 0 {
+		log.Warnf("The article view buffer is full, discarding the %d oldest views", numberOfDiscardedArticleViews)
+		instance.articleViews = instance.articleViews[numberOfDiscardedArticleViews:]
+	}
+}
+
+func getArticleViewFlushBatchSize() int {
+	return utils.GetIntFromEnvironmentVariable("ARTICLE_VIEW_FLUSH_BATCH_SIZE", 1000)
+}
+
+func getArticleViewer(userId uuid.UUID, ipAddress string, userAgent string) string {
+	if userId != uuid.Nil {
+		return fmt.Sprintf("user:%s", userId)
+	}
+
+	fingerprint := sha256.Sum256([]byte(fmt.Sprintf("%s|%s", ipAddress, userAgent)))
+	return fmt.Sprintf("anonymous:%s", hex.EncodeToString(fingerprint[:]))
+}
+
+func isBotUserAgent(userAgent string) bool {
+	userAgent = strings.ToLower(strings.TrimSpace(userAgent))
+	if userAgent == "" {
+		return true
+	}
+
+	patterns := botUserAgentPatterns
+	for _, pattern := range strings.Split(os.Getenv("ARTICLE_VIEW_BOT_USER_AGENTS"), ",") {
+		if pattern = strings.ToLower(strings.TrimSpace(pattern)); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	for _, pattern := range patterns {
+		if strings.Contains(userAgent, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
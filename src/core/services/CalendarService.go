@@ -0,0 +1,73 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/devlucassantos/vnc-domains/src/domains/event"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/postgres"
+	"vnc-api/core/services/utils"
+)
+
+type Calendar struct {
+	repository postgres.Calendar
+}
+
+func NewCalendarService(repository postgres.Calendar) *Calendar {
+	return &Calendar{
+		repository: repository,
+	}
+}
+
+func (instance Calendar) GetCalendarEvents(filter filters.Calendar) ([]event.Event, error) {
+	return instance.getCalendarEvents(filter, uuid.Nil)
+}
+
+func (instance Calendar) GetPrivateCalendarEvents(token string, filter filters.Calendar) ([]event.Event, error) {
+	userId, err := instance.repository.GetUserIdByCalendarToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return instance.getCalendarEvents(filter, userId)
+}
+
+func (instance Calendar) GetPrivateCalendarToken(userId uuid.UUID) (string, error) {
+	return instance.repository.GetCalendarTokenByUserId(userId)
+}
+
+func (instance Calendar) CreatePrivateCalendarToken(userId uuid.UUID) (string, error) {
+	tokenBytes := make([]byte, 16)
+	_, err := rand.Read(tokenBytes)
+	if err != nil {
+		log.Errorf("Error generating the private calendar token for user %s: %s", userId, err.Error())
+		return "", err
+	}
+
+	token := hex.EncodeToString(tokenBytes)
+	err = instance.repository.SaveCalendarToken(userId, token)
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (instance Calendar) DeletePrivateCalendarToken(userId uuid.UUID) error {
+	return instance.repository.DeleteCalendarToken(userId)
+}
+
+func (instance Calendar) getCalendarEvents(filter filters.Calendar, followerId uuid.UUID) ([]event.Event, error) {
+	maximumNumberOfEvents := utils.GetIntFromEnvironmentVariable("CALENDAR_MAXIMUM_NUMBER_OF_EVENTS", 500)
+
+	if filter.Event.StartDate == nil {
+		pastPeriod := utils.GetDurationFromEnvironmentVariable("CALENDAR_PAST_PERIOD", 30*24*time.Hour)
+		startDate := time.Now().Add(-pastPeriod)
+		filter.Event.StartDate = &startDate
+	}
+
+	return instance.repository.GetCalendarEvents(filter, followerId, maximumNumberOfEvents)
+}
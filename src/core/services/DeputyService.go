@@ -0,0 +1,57 @@
+package services
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/devlucassantos/vnc-domains/src/domains/deputy"
+	"github.com/google/uuid"
+	"vnc-api/core/domains/deputypair"
+	"vnc-api/core/domains/deputystatistics"
+	"vnc-api/core/domains/rapporteurship"
+	"vnc-api/core/domains/votingrecord"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/postgres"
+)
+
+type Deputy struct {
+	repository        postgres.Deputy
+	articleRepository postgres.Article
+}
+
+func NewDeputyService(repository postgres.Deputy, articleRepository postgres.Article) *Deputy {
+	return &Deputy{
+		repository:        repository,
+		articleRepository: articleRepository,
+	}
+}
+
+func (instance Deputy) GetDeputyById(deputyId uuid.UUID) (*deputy.Deputy, error) {
+	return instance.repository.GetDeputyById(deputyId)
+}
+
+func (instance Deputy) GetDeputyStatistics(deputyId uuid.UUID) (*deputystatistics.DeputyStatistics, error) {
+	return instance.repository.GetDeputyStatistics(deputyId)
+}
+
+func (instance Deputy) GetDeputyPropositions(deputyId uuid.UUID, pagination filters.Pagination) ([]article.Article,
+	int, error) {
+	articleFilter := filters.Article{
+		Proposition: filters.Proposition{DeputyId: &deputyId},
+		Pagination:  pagination,
+	}
+
+	return instance.articleRepository.GetArticles(articleFilter, uuid.Nil)
+}
+
+func (instance Deputy) GetDeputyRapporteurships(deputyId uuid.UUID, pagination filters.Pagination) (
+	[]rapporteurship.Rapporteurship, int, error) {
+	return instance.repository.GetDeputyRapporteurships(deputyId, pagination)
+}
+
+func (instance Deputy) GetDeputyVotingRecords(deputyId uuid.UUID, filter filters.DeputyVote) (
+	[]votingrecord.VotingRecord, int, error) {
+	return instance.repository.GetDeputyVotingRecords(deputyId, filter)
+}
+
+func (instance Deputy) GetDeputyPairs(deputyIds []uuid.UUID) ([]deputypair.DeputyPair, error) {
+	return instance.repository.GetDeputyPairs(deputyIds)
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/core/domains/emaildigest"
+	"vnc-api/core/domains/savedsearch"
+	"vnc-api/core/interfaces/postgres"
+	"vnc-api/core/interfaces/services"
+	"vnc-api/core/services/utils"
+)
+
+var emailDigestUnsubscribeLink = utils.UnsubscribeLink{
+	SigningKeyEnvironmentVariable: "EMAIL_DIGEST_SIGNING_KEY",
+	Path:                          "/api/v1/email-digest/unsubscribe",
+	IdParameter:                   "userId",
+	PageTitle:                     "Cancelar inscrição",
+	PageMessage: "Deseja deixar de receber o resumo de artigos do Você na Câmara? Você pode se inscrever novamente " +
+		"a qualquer momento na",
+}
+
+type EmailDigest struct {
+	repository            postgres.EmailDigest
+	savedSearchRepository postgres.SavedSearch
+	articleRepository     postgres.Article
+	emailService          services.Email
+}
+
+func NewEmailDigestService(repository postgres.EmailDigest, savedSearchRepository postgres.SavedSearch,
+	articleRepository postgres.Article, emailService services.Email) *EmailDigest {
+	return &EmailDigest{
+		repository:            repository,
+		savedSearchRepository: savedSearchRepository,
+		articleRepository:     articleRepository,
+		emailService:          emailService,
+	}
+}
+
+func (instance EmailDigest) GetEmailDigest(userId uuid.UUID) (*emaildigest.EmailDigest, error) {
+	return instance.repository.GetEmailDigestByUserId(userId)
+}
+
+func (instance EmailDigest) SubscribeToEmailDigest(userId uuid.UUID, frequency string) (*emaildigest.EmailDigest,
+	error) {
+	return instance.repository.SaveEmailDigest(userId, frequency)
+}
+
+func (instance EmailDigest) UnsubscribeFromEmailDigest(userId uuid.UUID) error {
+	return instance.repository.DeleteEmailDigest(userId)
+}
+
+func (instance EmailDigest) GetEmailDigestUnsubscribePage(userId uuid.UUID, signature string) ([]byte, error) {
+	return emailDigestUnsubscribeLink.GetPage(userId, signature)
+}
+
+func (instance EmailDigest) UnsubscribeFromEmailDigestWithSignature(userId uuid.UUID, signature string) error {
+	err := emailDigestUnsubscribeLink.ValidateSignature(userId, signature)
+	if err != nil {
+		return err
+	}
+
+	return instance.repository.DeleteEmailDigest(userId)
+}
+
+func (instance EmailDigest) SendPendingEmailDigests() error {
+	batchSize := utils.GetIntFromEnvironmentVariable("EMAIL_DIGEST_BATCH_SIZE", 100)
+	maximumNumberOfArticles := utils.GetIntFromEnvironmentVariable("EMAIL_DIGEST_MAXIMUM_NUMBER_OF_ARTICLES", 20)
+	maximumNumberOfSavedSearchArticles := utils.GetIntFromEnvironmentVariable(
+		"EMAIL_DIGEST_MAXIMUM_NUMBER_OF_ARTICLES_PER_SAVED_SEARCH", 5)
+
+	err := utils.ProcessClaimedBatches(batchSize, instance.repository.ClaimPendingEmailDigests,
+		func(emailDigest emaildigest.EmailDigest) error {
+			return instance.sendEmailDigest(emailDigest, maximumNumberOfArticles, maximumNumberOfSavedSearchArticles)
+		}, instance.restoreEmailDigestLastSentAt)
+	if err != nil {
+		log.Error("Error claiming the pending email digests: ", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (instance EmailDigest) GetCheckInterval() time.Duration {
+	return utils.GetDurationFromEnvironmentVariable("EMAIL_DIGEST_CHECK_INTERVAL", 15*time.Minute)
+}
+
+func (instance EmailDigest) sendEmailDigest(emailDigest emaildigest.EmailDigest, maximumNumberOfArticles int,
+	maximumNumberOfSavedSearchArticles int) error {
+	userData := emailDigest.User()
+
+	articles, err := instance.repository.GetEmailDigestArticles(userData.Id(), emailDigest.LastSentAt(),
+		maximumNumberOfArticles)
+	if err != nil {
+		log.Errorf("Error retrieving the articles of the email digest of user %s: %s", userData.Id(), err.Error())
+		return err
+	}
+
+	savedSearches, savedSearchArticles, err := instance.getEmailDigestSavedSearchArticles(emailDigest,
+		maximumNumberOfSavedSearchArticles)
+	if err != nil {
+		return err
+	} else if len(articles) == 0 && len(savedSearches) == 0 {
+		return nil
+	}
+
+	unsubscribeUrl, err := emailDigestUnsubscribeLink.GetUrl(userData.Id())
+	if err != nil {
+		log.Errorf("Error generating the unsubscribe link of the email digest of user %s: %s", userData.Id(),
+			err.Error())
+		return err
+	}
+
+	err = instance.emailService.SendEmailDigest(emailDigest, articles, savedSearches, savedSearchArticles,
+		unsubscribeUrl)
+	if err != nil {
+		log.Errorf("Error sending the email digest of user %s: %s", userData.Id(), err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// getEmailDigestSavedSearchArticles returns the saved searches of the user that had new articles in the period of
+// the email digest, along with these articles
+func (instance EmailDigest) getEmailDigestSavedSearchArticles(emailDigest emaildigest.EmailDigest,
+	maximumNumberOfArticles int) ([]savedsearch.SavedSearch, map[uuid.UUID][]article.Article, error) {
+	userData := emailDigest.User()
+
+	savedSearches, err := instance.savedSearchRepository.GetSavedSearchesByUserId(userData.Id())
+	if err != nil {
+		log.Errorf("Error retrieving the saved searches of the email digest of user %s: %s", userData.Id(),
+			err.Error())
+		return nil, nil, err
+	}
+
+	lastSentAt := emailDigest.LastSentAt()
+	sentAt := emailDigest.SentAt()
+	var savedSearchesWithArticles []savedsearch.SavedSearch
+	savedSearchArticles := make(map[uuid.UUID][]article.Article)
+	for _, savedSearchData := range savedSearches {
+		articleFilter := getSavedSearchArticleFilter(savedSearchData)
+		articleFilter.CreatedAfter = &lastSentAt
+		articleFilter.CreatedUntil = &sentAt
+		articleFilter.Pagination.ItemsPerPage = &maximumNumberOfArticles
+
+		articles, _, err := instance.articleRepository.GetArticles(articleFilter, userData.Id())
+		if err != nil {
+			log.Errorf("Error retrieving the articles of saved search %s for the email digest of user %s: %s",
+				savedSearchData.Id(), userData.Id(), err.Error())
+			return nil, nil, err
+		} else if len(articles) == 0 {
+			continue
+		}
+
+		savedSearchesWithArticles = append(savedSearchesWithArticles, savedSearchData)
+		savedSearchArticles[savedSearchData.Id()] = articles
+	}
+
+	return savedSearchesWithArticles, savedSearchArticles, nil
+}
+
+func (instance EmailDigest) restoreEmailDigestLastSentAt(emailDigest emaildigest.EmailDigest) {
+	userData := emailDigest.User()
+	err := instance.repository.RestoreEmailDigestLastSentAt(userData.Id(), emailDigest.LastSentAt())
+	if err != nil {
+		log.Errorf("Error restoring the email digest of user %s, the articles of this period will not be sent: %s",
+			userData.Id(), err.Error())
+	}
+}
@@ -3,12 +3,18 @@ package services
 import (
 	"bytes"
 	"fmt"
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
 	"github.com/devlucassantos/vnc-domains/src/domains/user"
+	"github.com/google/uuid"
 	"github.com/labstack/gommon/log"
 	"html/template"
+	"mime"
 	"net/smtp"
 	"os"
+	"strings"
 	"time"
+	"vnc-api/core/domains/emaildigest"
+	"vnc-api/core/domains/savedsearch"
 )
 
 type Email struct{}
@@ -29,7 +35,7 @@ func (instance Email) SendUserAccountActivationEmail(userData user.User) error {
 		"application_url": os.Getenv("APPLICATION_URL"),
 	}
 
-	err := sendEmail(subject, userData.Email(), templatePath, emailData)
+	err := sendEmail(subject, userData.Email(), templatePath, emailData, nil)
 	if err != nil {
 		log.Errorf("Error sending account activation email for user %s: %s", userData.Email(), err.Error())
 		return err
@@ -38,7 +44,110 @@ func (instance Email) SendUserAccountActivationEmail(userData user.User) error {
 	return nil
 }
 
-func sendEmail(subject string, to string, templatePath string, emailData interface{}) error {
+func (instance Email) SendEmailDigest(emailDigest emaildigest.EmailDigest, articles []article.Article,
+	savedSearches []savedsearch.SavedSearch, savedSearchArticles map[uuid.UUID][]article.Article,
+	unsubscribeUrl string) error {
+	subject := "Resumo semanal do Você na Câmara"
+	if emailDigest.Frequency() == emaildigest.DailyFrequency {
+		subject = "Resumo diário do Você na Câmara"
+	}
+
+	templatePath := "core/services/resources/email_digest_template.html"
+
+	applicationUrl := os.Getenv("APPLICATION_URL")
+	var savedSearchSlice []map[string]interface{}
+	for _, savedSearchData := range savedSearches {
+		savedSearchSlice = append(savedSearchSlice, map[string]interface{}{
+			"name":     savedSearchData.Name(),
+			"url":      fmt.Sprint(applicationUrl, "/saved-searches/", savedSearchData.Id()),
+			"articles": getEmailArticles(savedSearchArticles[savedSearchData.Id()], applicationUrl),
+		})
+	}
+
+	userData := emailDigest.User()
+	emailData := map[string]interface{}{
+		"user_name":       fmt.Sprintf("%s %s", userData.FirstName(), userData.LastName()),
+		"articles":        getEmailArticles(articles, applicationUrl),
+		"saved_searches":  savedSearchSlice,
+		"unsubscribe_url": unsubscribeUrl,
+		"current_year":    time.Now().Year(),
+		"application_url": applicationUrl,
+	}
+
+	headers := map[string]string{
+		"List-Unsubscribe":      fmt.Sprintf("<%s>", unsubscribeUrl),
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+	}
+
+	err := sendEmail(subject, userData.Email(), templatePath, emailData, headers)
+	if err != nil {
+		log.Errorf("Error sending email digest for user %s: %s", userData.Email(), err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (instance Email) SendSavedSearchAlert(savedSearch savedsearch.SavedSearch, articles []article.Article,
+	numberOfNewArticles int, unsubscribeUrl string) error {
+	subject := fmt.Sprintf("Novos artigos para a sua busca \"%s\"", savedSearch.Name())
+
+	templatePath := "core/services/resources/saved_search_alert_template.html"
+
+	applicationUrl := os.Getenv("APPLICATION_URL")
+	userData := savedSearch.User()
+	emailData := map[string]interface{}{
+		"user_name":              fmt.Sprintf("%s %s", userData.FirstName(), userData.LastName()),
+		"saved_search_name":      savedSearch.Name(),
+		"saved_search_url":       fmt.Sprint(applicationUrl, "/saved-searches/", savedSearch.Id()),
+		"number_of_new_articles": numberOfNewArticles,
+		"articles":               getEmailArticles(articles, applicationUrl),
+		"unsubscribe_url":        unsubscribeUrl,
+		"current_year":           time.Now().Year(),
+		"application_url":        applicationUrl,
+	}
+
+	headers := map[string]string{
+		"List-Unsubscribe":      fmt.Sprintf("<%s>", unsubscribeUrl),
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+	}
+
+	err := sendEmail(subject, userData.Email(), templatePath, emailData, headers)
+	if err != nil {
+		log.Errorf("Error sending the alert of saved search %s for user %s: %s", savedSearch.Id(), userData.Email(),
+			err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func getEmailArticles(articles []article.Article, applicationUrl string) []map[string]interface{} {
+	var articleSlice []map[string]interface{}
+	for _, articleData := range articles {
+		articleType := articleData.Type()
+		articleSlice = append(articleSlice, map[string]interface{}{
+			"title":   articleData.Title(),
+			"type":    articleType.Description(),
+			"content": getEmailDigestArticleContentPreview(articleData.Content()),
+			"url":     fmt.Sprint(applicationUrl, "/articles/", articleData.Id()),
+		})
+	}
+
+	return articleSlice
+}
+
+func getEmailDigestArticleContentPreview(content string) string {
+	contentRunes := []rune(strings.TrimSpace(content))
+	if len(contentRunes) <= 280 {
+		return string(contentRunes)
+	}
+
+	return strings.TrimSpace(string(contentRunes[:280])) + "..."
+}
+
+func sendEmail(subject string, to string, templatePath string, emailData interface{},
+	headers map[string]string) error {
 	smtpHost := os.Getenv("SMTP_HOST")
 	smtpPort := os.Getenv("SMTP_PORT")
 	smtpUserEmail := os.Getenv("SMTP_USER_EMAIL")
@@ -53,8 +162,12 @@ func sendEmail(subject string, to string, templatePath string, emailData interfa
 
 	var body bytes.Buffer
 	body.WriteString("MIME-version: 1.0;\nContent-Type: text/html; charset=\"UTF-8\";\n")
-	body.WriteString(fmt.Sprintf("Subject: %s\n", subject))
+	// The subject may contain text provided by the user, so it is encoded to keep it in a single header
+	body.WriteString(fmt.Sprintf("Subject: %s\n", mime.QEncoding.Encode("UTF-8", subject)))
 	body.WriteString(fmt.Sprintf("To: %s\n", to))
+	for header, value := range headers {
+		body.WriteString(fmt.Sprintf("%s: %s\n", header, value))
+	}
 	body.WriteString(fmt.Sprintf("From: %s\n\n", smtpUserEmail))
 
 	err = emailTemplate.Execute(&body, emailData)
@@ -0,0 +1,29 @@
+package services
+
+import (
+	"github.com/google/uuid"
+	"vnc-api/core/domains/follow"
+	"vnc-api/core/interfaces/postgres"
+)
+
+type Follow struct {
+	repository postgres.Follow
+}
+
+func NewFollowService(repository postgres.Follow) *Follow {
+	return &Follow{
+		repository: repository,
+	}
+}
+
+func (instance Follow) GetFollows(userId uuid.UUID) ([]follow.Follow, error) {
+	return instance.repository.GetFollowsByUserId(userId)
+}
+
+func (instance Follow) FollowResource(userId uuid.UUID, resourceType string, resourceId uuid.UUID) error {
+	return instance.repository.FollowResource(userId, resourceType, resourceId)
+}
+
+func (instance Follow) UnfollowResource(userId uuid.UUID, resourceType string, resourceId uuid.UUID) error {
+	return instance.repository.UnfollowResource(userId, resourceType, resourceId)
+}
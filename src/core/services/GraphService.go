@@ -0,0 +1,26 @@
+package services
+
+import (
+	"vnc-api/core/domains/coauthorshipedge"
+	"vnc-api/core/domains/coauthorshipnode"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/postgres"
+	"vnc-api/core/services/utils"
+)
+
+type Graph struct {
+	repository postgres.Graph
+}
+
+func NewGraphService(repository postgres.Graph) *Graph {
+	return &Graph{
+		repository: repository,
+	}
+}
+
+func (instance Graph) GetCoAuthorshipGraph(filter filters.CoAuthorship) ([]coauthorshipnode.CoAuthorshipNode,
+	[]coauthorshipedge.CoAuthorshipEdge, error) {
+	filter.MaximumNumberOfNodes = utils.GetIntFromEnvironmentVariable("COAUTHORSHIP_GRAPH_MAXIMUM_NUMBER_OF_NODES",
+		300)
+	return instance.repository.GetCoAuthorshipGraph(filter)
+}
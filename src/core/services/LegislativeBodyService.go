@@ -0,0 +1,86 @@
+package services
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/devlucassantos/vnc-domains/src/domains/legislativebody"
+	"github.com/google/uuid"
+	"time"
+	"vnc-api/core/domains/frequentrapporteur"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/postgres"
+	"vnc-api/core/services/utils"
+)
+
+type LegislativeBody struct {
+	repository        postgres.LegislativeBody
+	articleRepository postgres.Article
+}
+
+func NewLegislativeBodyService(repository postgres.LegislativeBody,
+	articleRepository postgres.Article) *LegislativeBody {
+	return &LegislativeBody{
+		repository:        repository,
+		articleRepository: articleRepository,
+	}
+}
+
+func (instance LegislativeBody) GetLegislativeBodyById(legislativeBodyId uuid.UUID) (
+	*legislativebody.LegislativeBody, error) {
+	return instance.repository.GetLegislativeBodyById(legislativeBodyId)
+}
+
+func (instance LegislativeBody) GetLegislativeBodyUpcomingEvents(legislativeBodyId uuid.UUID) ([]article.Article,
+	error) {
+	numberOfItems := getNumberOfItemsOfTheLegislativeBody()
+	upcomingEventsPeriod := utils.GetDurationFromEnvironmentVariable("LEGISLATIVE_BODY_UPCOMING_EVENTS_PERIOD",
+		14*24*time.Hour)
+
+	startDate := time.Now()
+	endDate := startDate.Add(upcomingEventsPeriod)
+	return instance.repository.GetLegislativeBodyUpcomingEvents(legislativeBodyId, startDate, endDate, numberOfItems)
+}
+
+func (instance LegislativeBody) GetLegislativeBodyRecentEvents(legislativeBodyId uuid.UUID) ([]article.Article,
+	error) {
+	numberOfItems := getNumberOfItemsOfTheLegislativeBody()
+
+	endDate := time.Now().AddDate(0, 0, -1)
+	articleFilter := filters.Article{
+		Event: filters.Event{
+			EndDate:           &endDate,
+			LegislativeBodyId: &legislativeBodyId,
+		},
+		Pagination: filters.Pagination{ItemsPerPage: &numberOfItems},
+	}
+
+	events, _, err := instance.articleRepository.GetArticles(articleFilter, uuid.Nil)
+	return events, err
+}
+
+func (instance LegislativeBody) GetLegislativeBodyRecentVotes(legislativeBodyId uuid.UUID) ([]article.Article,
+	error) {
+	numberOfItems := getNumberOfItemsOfTheLegislativeBody()
+
+	articleFilter := filters.Article{
+		Voting:     filters.Voting{LegislativeBodyId: &legislativeBodyId},
+		Pagination: filters.Pagination{ItemsPerPage: &numberOfItems},
+	}
+
+	votes, _, err := instance.articleRepository.GetArticles(articleFilter, uuid.Nil)
+	return votes, err
+}
+
+func (instance LegislativeBody) GetLegislativeBodyFrequentRapporteurs(legislativeBodyId uuid.UUID) (
+	[]frequentrapporteur.FrequentRapporteur, error) {
+	return instance.repository.GetLegislativeBodyFrequentRapporteurs(legislativeBodyId,
+		getNumberOfItemsOfTheLegislativeBody())
+}
+
+func getNumberOfItemsOfTheLegislativeBody() int {
+	numberOfItems := utils.GetIntFromEnvironmentVariable("LEGISLATIVE_BODY_NUMBER_OF_ITEMS", 10)
+	if numberOfItems < 1 {
+		return 10
+	}
+
+	return numberOfItems
+}
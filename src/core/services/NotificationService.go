@@ -0,0 +1,83 @@
+package services
+
+import (
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/core/domains/notification"
+	"vnc-api/core/domains/notificationpreference"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/postgres"
+	"vnc-api/core/services/utils"
+)
+
+type Notification struct {
+	repository postgres.Notification
+}
+
+func NewNotificationService(repository postgres.Notification) *Notification {
+	return &Notification{
+		repository: repository,
+	}
+}
+
+func (instance Notification) GetNotifications(userId uuid.UUID, onlyUnread bool, pagination filters.Pagination) (
+	[]notification.Notification, int, int, error) {
+	return instance.repository.GetNotificationsByUserId(userId, onlyUnread, pagination)
+}
+
+func (instance Notification) MarkNotificationAsRead(userId uuid.UUID, notificationId uuid.UUID) error {
+	return instance.repository.MarkNotificationAsRead(userId, notificationId)
+}
+
+func (instance Notification) MarkAllNotificationsAsRead(userId uuid.UUID) error {
+	return instance.repository.MarkAllNotificationsAsRead(userId)
+}
+
+func (instance Notification) GetNotificationPreferences(userId uuid.UUID) (
+	[]notificationpreference.NotificationPreference, error) {
+	savedNotificationPreferences, err := instance.repository.GetNotificationPreferencesByUserId(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Notification types without a saved preference are enabled by default
+	var notificationPreferences []notificationpreference.NotificationPreference
+	for _, notificationType := range notification.GetTypes() {
+		enabled := true
+		for _, savedNotificationPreference := range savedNotificationPreferences {
+			if savedNotificationPreference.Type() == notificationType {
+				enabled = savedNotificationPreference.Enabled()
+				break
+			}
+		}
+
+		notificationPreference, err := notificationpreference.NewBuilder().
+			Type(notificationType).
+			Enabled(enabled).
+			Build()
+		if err != nil {
+			log.Errorf("Error validating data for the notification preference %s of user %s: %s", notificationType,
+				userId, err.Error())
+			return nil, err
+		}
+
+		notificationPreferences = append(notificationPreferences, *notificationPreference)
+	}
+
+	return notificationPreferences, nil
+}
+
+func (instance Notification) SaveNotificationPreference(userId uuid.UUID,
+	notificationPreference notificationpreference.NotificationPreference) error {
+	return instance.repository.SaveNotificationPreference(userId, notificationPreference)
+}
+
+func (instance Notification) GenerateNotifications() error {
+	window := utils.GetDurationFromEnvironmentVariable("NOTIFICATION_GENERATION_WINDOW", 24*time.Hour)
+	return instance.repository.GenerateNotifications(window)
+}
+
+func (instance Notification) GetGenerationInterval() time.Duration {
+	return utils.GetDurationFromEnvironmentVariable("NOTIFICATION_GENERATION_INTERVAL", 5*time.Minute)
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/devlucassantos/vnc-domains/src/domains/deputy"
+	"github.com/devlucassantos/vnc-domains/src/domains/party"
+	"github.com/google/uuid"
+	"time"
+	"vnc-api/core/domains/partystatistics"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/postgres"
+)
+
+type Party struct {
+	repository        postgres.Party
+	articleRepository postgres.Article
+}
+
+func NewPartyService(repository postgres.Party, articleRepository postgres.Article) *Party {
+	return &Party{
+		repository:        repository,
+		articleRepository: articleRepository,
+	}
+}
+
+func (instance Party) GetPartyById(partyId uuid.UUID) (*party.Party, error) {
+	return instance.repository.GetPartyById(partyId)
+}
+
+func (instance Party) GetPartyDeputies(partyId uuid.UUID) ([]deputy.Deputy, error) {
+	return instance.repository.GetPartyDeputies(partyId)
+}
+
+func (instance Party) GetPartyStatistics(partyId uuid.UUID, numberOfMonths int) (*partystatistics.PartyStatistics,
+	error) {
+	currentDate := time.Now()
+	activityStartDate := time.Date(currentDate.Year(), currentDate.Month()-time.Month(numberOfMonths-1), 1, 0, 0, 0,
+		0, time.UTC)
+
+	return instance.repository.GetPartyStatistics(partyId, activityStartDate)
+}
+
+func (instance Party) GetPartyPropositions(partyId uuid.UUID, pagination filters.Pagination) ([]article.Article,
+	int, error) {
+	articleFilter := filters.Article{
+		Proposition: filters.Proposition{PartyId: &partyId},
+		Pagination:  pagination,
+	}
+
+	return instance.articleRepository.GetArticles(articleFilter, uuid.Nil)
+}
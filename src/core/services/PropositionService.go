@@ -3,6 +3,7 @@ package services
 import (
 	"github.com/devlucassantos/vnc-domains/src/domains/proposition"
 	"github.com/google/uuid"
+	"vnc-api/core/domains/timelineitem"
 	"vnc-api/core/interfaces/postgres"
 )
 
@@ -19,3 +20,8 @@ func NewPropositionService(repository postgres.Proposition) *Proposition {
 func (instance Proposition) GetPropositionByArticleId(articleId uuid.UUID, userId uuid.UUID) (*proposition.Proposition, error) {
 	return instance.repository.GetPropositionByArticleId(articleId, userId)
 }
+
+func (instance Proposition) GetPropositionTimelineByArticleId(articleId uuid.UUID) ([]timelineitem.TimelineItem,
+	error) {
+	return instance.repository.GetPropositionTimelineByArticleId(articleId)
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"vnc-api/core/domains/readinglist"
+	"vnc-api/core/domains/readinglistitem"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/postgres"
+)
+
+type ReadingList struct {
+	repository postgres.ReadingList
+}
+
+func NewReadingListService(repository postgres.ReadingList) *ReadingList {
+	return &ReadingList{
+		repository: repository,
+	}
+}
+
+func (instance ReadingList) GetReadingLists(userId uuid.UUID) ([]readinglist.ReadingList, error) {
+	return instance.repository.GetReadingListsByUserId(userId)
+}
+
+func (instance ReadingList) GetReadingListById(readingListId uuid.UUID, userId uuid.UUID) (*readinglist.ReadingList,
+	error) {
+	return instance.repository.GetReadingListById(readingListId, userId)
+}
+
+func (instance ReadingList) GetSharedReadingList(shareToken string) (*readinglist.ReadingList, error) {
+	return instance.repository.GetReadingListByShareToken(shareToken)
+}
+
+func (instance ReadingList) GetReadingListItems(readingListId uuid.UUID, pagination filters.Pagination,
+	userId uuid.UUID) ([]readinglistitem.ReadingListItem, error) {
+	return instance.repository.GetReadingListItems(readingListId, pagination, userId)
+}
+
+func (instance ReadingList) CreateReadingList(readingList readinglist.ReadingList, userId uuid.UUID) (
+	*readinglist.ReadingList, error) {
+	readingListData, err := instance.prepareShareToken(readingList)
+	if err != nil {
+		return nil, err
+	}
+
+	readingListId, err := instance.repository.CreateReadingList(*readingListData, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return instance.repository.GetReadingListById(readingListId, userId)
+}
+
+func (instance ReadingList) UpdateReadingList(readingList readinglist.ReadingList, userId uuid.UUID) (
+	*readinglist.ReadingList, error) {
+	readingListData, err := instance.prepareShareToken(readingList)
+	if err != nil {
+		return nil, err
+	}
+
+	err = instance.repository.UpdateReadingList(*readingListData, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return instance.repository.GetReadingListById(readingList.Id(), userId)
+}
+
+func (instance ReadingList) DeleteReadingList(readingListId uuid.UUID, userId uuid.UUID) error {
+	readingListData, err := instance.repository.GetReadingListById(readingListId, userId)
+	if err != nil {
+		return err
+	} else if readingListData.IsDefault() {
+		return errors.New("the default reading list cannot be deleted")
+	}
+
+	return instance.repository.DeleteReadingList(readingListId, userId)
+}
+
+func (instance ReadingList) SaveArticleToReadingList(readingListId uuid.UUID, userId uuid.UUID, articleId uuid.UUID,
+	note *string) error {
+	return instance.repository.SaveArticleToReadingList(readingListId, userId, articleId, note)
+}
+
+func (instance ReadingList) RemoveArticleFromReadingList(readingListId uuid.UUID, userId uuid.UUID,
+	articleId uuid.UUID) error {
+	return instance.repository.RemoveArticleFromReadingList(readingListId, userId, articleId)
+}
+
+func (instance ReadingList) SortReadingListArticles(readingListId uuid.UUID, userId uuid.UUID,
+	articleIds []uuid.UUID) error {
+	return instance.repository.SortReadingListArticles(readingListId, userId, articleIds)
+}
+
+func (instance ReadingList) prepareShareToken(readingList readinglist.ReadingList) (*readinglist.ReadingList, error) {
+	if !readingList.IsPublic() {
+		return &readingList, nil
+	}
+
+	shareTokenBytes := make([]byte, 16)
+	_, err := rand.Read(shareTokenBytes)
+	if err != nil {
+		log.Error("Error generating the share token for the reading list: ", err.Error())
+		return nil, err
+	}
+
+	return readingList.NewUpdater().
+		ShareToken(hex.EncodeToString(shareTokenBytes)).
+		Build()
+}
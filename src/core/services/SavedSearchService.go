@@ -0,0 +1,218 @@
+package services
+
+import (
+	"errors"
+	"github.com/devlucassantos/vnc-domains/src/domains/article"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/core/domains/savedsearch"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/postgres"
+	"vnc-api/core/interfaces/services"
+	"vnc-api/core/services/utils"
+)
+
+var savedSearchAlertUnsubscribeLink = utils.UnsubscribeLink{
+	SigningKeyEnvironmentVariable: "SAVED_SEARCH_SIGNING_KEY",
+	Path:                          "/api/v1/saved-searches/unsubscribe",
+	IdParameter:                   "savedSearchId",
+	PageTitle:                     "Desativar alertas",
+	PageMessage: "Deseja deixar de receber os alertas por e-mail desta busca salva? A busca continuará salva e você " +
+		"pode reativar os alertas a qualquer momento na",
+}
+
+type SavedSearch struct {
+	repository        postgres.SavedSearch
+	articleRepository postgres.Article
+	emailService      services.Email
+}
+
+func NewSavedSearchService(repository postgres.SavedSearch, articleRepository postgres.Article,
+	emailService services.Email) *SavedSearch {
+	return &SavedSearch{
+		repository:        repository,
+		articleRepository: articleRepository,
+		emailService:      emailService,
+	}
+}
+
+func (instance SavedSearch) GetSavedSearches(userId uuid.UUID) ([]savedsearch.SavedSearch, error) {
+	return instance.repository.GetSavedSearchesByUserId(userId)
+}
+
+func (instance SavedSearch) GetSavedSearchById(savedSearchId uuid.UUID, userId uuid.UUID) (*savedsearch.SavedSearch,
+	error) {
+	return instance.repository.GetSavedSearchById(savedSearchId, userId)
+}
+
+func (instance SavedSearch) GetSavedSearchArticles(savedSearchId uuid.UUID, pagination filters.Pagination,
+	userId uuid.UUID) ([]article.Article, int, error) {
+	savedSearchData, err := instance.repository.GetSavedSearchById(savedSearchId, userId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	articleFilter := getSavedSearchArticleFilter(*savedSearchData)
+	articleFilter.Pagination = pagination
+
+	articles, totalNumberOfArticles, err := instance.articleRepository.GetArticles(articleFilter, userId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Failing to register the opening only delays the reset of the number of new articles
+	err = instance.repository.MarkSavedSearchAsOpened(savedSearchId, userId)
+	if err != nil {
+		log.Warnf("The number of new articles of saved search %s was not reset: %s", savedSearchId, err.Error())
+	}
+
+	return articles, totalNumberOfArticles, nil
+}
+
+func (instance SavedSearch) CreateSavedSearch(savedSearch savedsearch.SavedSearch, userId uuid.UUID) (
+	*savedsearch.SavedSearch, error) {
+	numberOfSavedSearches, err := instance.repository.GetNumberOfSavedSearchesByUserId(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	maximumNumberOfSavedSearches := utils.GetIntFromEnvironmentVariable("SAVED_SEARCH_MAXIMUM_NUMBER_PER_USER", 20)
+	if numberOfSavedSearches >= maximumNumberOfSavedSearches {
+		return nil, errors.New("the maximum number of saved searches per user has been reached")
+	}
+
+	savedSearchId, err := instance.repository.CreateSavedSearch(savedSearch, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return instance.repository.GetSavedSearchById(savedSearchId, userId)
+}
+
+func (instance SavedSearch) UpdateSavedSearch(savedSearch savedsearch.SavedSearch, userId uuid.UUID) (
+	*savedsearch.SavedSearch, error) {
+	err := instance.repository.UpdateSavedSearch(savedSearch, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return instance.repository.GetSavedSearchById(savedSearch.Id(), userId)
+}
+
+func (instance SavedSearch) DeleteSavedSearch(savedSearchId uuid.UUID, userId uuid.UUID) error {
+	return instance.repository.DeleteSavedSearch(savedSearchId, userId)
+}
+
+func (instance SavedSearch) GetSavedSearchAlertUnsubscribePage(savedSearchId uuid.UUID, signature string) ([]byte,
+	error) {
+	return savedSearchAlertUnsubscribeLink.GetPage(savedSearchId, signature)
+}
+
+func (instance SavedSearch) UnsubscribeFromSavedSearchAlertWithSignature(savedSearchId uuid.UUID,
+	signature string) error {
+	err := savedSearchAlertUnsubscribeLink.ValidateSignature(savedSearchId, signature)
+	if err != nil {
+		return err
+	}
+
+	return instance.repository.DisableSavedSearchEmailNotifications(savedSearchId)
+}
+
+func (instance SavedSearch) CheckPendingSavedSearches() error {
+	batchSize := utils.GetIntFromEnvironmentVariable("SAVED_SEARCH_BATCH_SIZE", 100)
+	maximumNumberOfArticles := utils.GetIntFromEnvironmentVariable(
+		"SAVED_SEARCH_MAXIMUM_NUMBER_OF_ARTICLES_PER_EMAIL", 10)
+
+	err := utils.ProcessClaimedBatches(batchSize, func(batchSize int) ([]savedsearch.SavedSearch, error) {
+		return instance.repository.ClaimPendingSavedSearches(batchSize, instance.GetCheckInterval())
+	}, func(savedSearch savedsearch.SavedSearch) error {
+		return instance.checkSavedSearch(savedSearch, maximumNumberOfArticles)
+	}, instance.restoreSavedSearchLastCheckedAt)
+	if err != nil {
+		log.Error("Error claiming the pending saved searches: ", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (instance SavedSearch) GetCheckInterval() time.Duration {
+	return utils.GetDurationFromEnvironmentVariable("SAVED_SEARCH_CHECK_INTERVAL", 15*time.Minute)
+}
+
+func (instance SavedSearch) checkSavedSearch(savedSearch savedsearch.SavedSearch, maximumNumberOfArticles int) error {
+	userData := savedSearch.User()
+
+	lastOpenedAt := savedSearch.LastOpenedAt()
+	// The articles created after the claim belong to the next check, so they are neither counted nor notified twice
+	checkedAt := savedSearch.CheckedAt()
+	numberOfArticlesToCount := 1
+	articleFilter := getSavedSearchArticleFilter(savedSearch)
+	articleFilter.CreatedAfter = &lastOpenedAt
+	articleFilter.CreatedUntil = &checkedAt
+	articleFilter.Pagination.ItemsPerPage = &numberOfArticlesToCount
+
+	_, numberOfNewArticles, err := instance.articleRepository.GetArticles(articleFilter, userData.Id())
+	if err != nil {
+		log.Errorf("Error counting the new articles of saved search %s: %s", savedSearch.Id(), err.Error())
+		return err
+	}
+
+	err = instance.repository.SaveNumberOfNewArticles(savedSearch, numberOfNewArticles)
+	if err != nil {
+		return err
+	} else if !savedSearch.NotifyByEmail() || numberOfNewArticles == 0 {
+		return nil
+	}
+
+	// Only the articles that appeared since the previous check are sent, so each article is notified once
+	notifiedUntil := savedSearch.LastCheckedAt()
+	if lastOpenedAt.After(notifiedUntil) {
+		notifiedUntil = lastOpenedAt
+	}
+	articleFilter.CreatedAfter = &notifiedUntil
+	articleFilter.Pagination.ItemsPerPage = &maximumNumberOfArticles
+
+	articles, numberOfArticlesToNotify, err := instance.articleRepository.GetArticles(articleFilter, userData.Id())
+	if err != nil {
+		log.Errorf("Error retrieving the articles of the alert of saved search %s: %s", savedSearch.Id(),
+			err.Error())
+		return err
+	} else if numberOfArticlesToNotify == 0 {
+		return nil
+	}
+
+	unsubscribeUrl, err := savedSearchAlertUnsubscribeLink.GetUrl(savedSearch.Id())
+	if err != nil {
+		log.Errorf("Error generating the unsubscribe link of the alert of saved search %s: %s", savedSearch.Id(),
+			err.Error())
+		return err
+	}
+
+	err = instance.emailService.SendSavedSearchAlert(savedSearch, articles, numberOfArticlesToNotify,
+		unsubscribeUrl)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (instance SavedSearch) restoreSavedSearchLastCheckedAt(savedSearch savedsearch.SavedSearch) {
+	err := instance.repository.RestoreSavedSearchLastCheckedAt(savedSearch.Id(), savedSearch.LastCheckedAt())
+	if err != nil {
+		log.Errorf("Error restoring saved search %s, the articles of this period will not be notified: %s",
+			savedSearch.Id(), err.Error())
+	}
+}
+
+func getSavedSearchArticleFilter(savedSearch savedsearch.SavedSearch) filters.Article {
+	articleFilter := savedSearch.Filter()
+	if articleFilter.Event.RemoveEventsInTheFuture != nil && *articleFilter.Event.RemoveEventsInTheFuture {
+		eventEndDate := time.Now()
+		articleFilter.Event.EndDate = &eventEndDate
+	}
+
+	return articleFilter
+}
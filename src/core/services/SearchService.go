@@ -0,0 +1,99 @@
+package services
+
+import (
+	"github.com/labstack/gommon/log"
+	"slices"
+	"strings"
+	"time"
+	"vnc-api/core/domains/suggestion"
+	"vnc-api/core/interfaces/postgres"
+	"vnc-api/core/services/utils"
+)
+
+const (
+	numberOfSuggestionsPerType         = 5
+	numberOfSpellingCorrectionsPerWord = 3
+	maximumNumberOfSpellingSuggestions = 5
+)
+
+type Search struct {
+	repository postgres.Search
+}
+
+func NewSearchService(repository postgres.Search) *Search {
+	return &Search{
+		repository: repository,
+	}
+}
+
+func (instance Search) GetSuggestions(text string) ([]suggestion.Suggestion, error) {
+	suggestions, err := instance.repository.GetSuggestions(text, numberOfSuggestionsPerType)
+	if err != nil {
+		log.Errorf("Error retrieving search suggestions for the text %s: %s", text, err.Error())
+		return nil, err
+	}
+
+	return suggestions, nil
+}
+
+func (instance Search) GetSpellingSuggestions(text string) (string, []string, error) {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return "", nil, nil
+	}
+
+	var searchedWords []string
+	for _, word := range words {
+		searchedWords = append(searchedWords, strings.ToLower(word))
+	}
+
+	spellingCorrections, err := instance.repository.GetSpellingCorrections(searchedWords,
+		numberOfSpellingCorrectionsPerWord)
+	if err != nil {
+		log.Errorf("Error retrieving spelling corrections for the text %s: %s", text, err.Error())
+		return "", nil, err
+	}
+
+	correctedWords := make([]string, len(words))
+	for index, word := range words {
+		correctedWords[index] = word
+		corrections := spellingCorrections[searchedWords[index]]
+		if len(corrections) > 0 && !slices.Contains(corrections, searchedWords[index]) {
+			correctedWords[index] = corrections[0]
+		}
+	}
+
+	correctedQuery := strings.Join(correctedWords, " ")
+	if strings.EqualFold(correctedQuery, strings.Join(words, " ")) {
+		return "", nil, nil
+	}
+
+	suggestions := []string{correctedQuery}
+	for index, word := range searchedWords {
+		corrections := spellingCorrections[word]
+		if len(corrections) == 0 || slices.Contains(corrections, word) {
+			continue
+		}
+
+		for _, correction := range corrections[1:] {
+			if len(suggestions) >= maximumNumberOfSpellingSuggestions {
+				return correctedQuery, suggestions, nil
+			}
+
+			alternativeWords := make([]string, len(correctedWords))
+			copy(alternativeWords, correctedWords)
+			alternativeWords[index] = correction
+			suggestions = append(suggestions, strings.Join(alternativeWords, " "))
+		}
+	}
+
+	return correctedQuery, suggestions, nil
+}
+
+func (instance Search) RefreshVocabulary() error {
+	return instance.repository.RefreshVocabulary()
+}
+
+func (instance Search) GetVocabularyRefreshInterval() time.Duration {
+	return utils.GetDurationFromEnvironmentVariable("SEARCH_VOCABULARY_REFRESH_INTERVAL", time.Hour)
+}
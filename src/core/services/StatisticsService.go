@@ -0,0 +1,27 @@
+package services
+
+import (
+	"vnc-api/core/domains/federatedunitactivity"
+	"vnc-api/core/domains/votingoutcome"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/postgres"
+)
+
+type Statistics struct {
+	repository postgres.Statistics
+}
+
+func NewStatisticsService(repository postgres.Statistics) *Statistics {
+	return &Statistics{
+		repository: repository,
+	}
+}
+
+func (instance Statistics) GetVotingOutcomes(filter filters.Voting) ([]votingoutcome.VotingOutcome, error) {
+	return instance.repository.GetVotingOutcomes(filter)
+}
+
+func (instance Statistics) GetFederatedUnitActivities(filter filters.FederatedUnitActivity) (
+	[]federatedunitactivity.FederatedUnitActivity, error) {
+	return instance.repository.GetFederatedUnitActivities(filter)
+}
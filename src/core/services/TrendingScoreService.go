@@ -0,0 +1,42 @@
+package services
+
+import (
+	"github.com/labstack/gommon/log"
+	"time"
+	"vnc-api/core/filters"
+	"vnc-api/core/interfaces/postgres"
+	"vnc-api/core/services/utils"
+)
+
+var trendingWindows = []string{filters.OneDayTrendingWindow, filters.OneWeekTrendingWindow,
+	filters.OneMonthTrendingWindow}
+
+type TrendingScore struct {
+	repository postgres.TrendingScore
+}
+
+func NewTrendingScoreService(repository postgres.TrendingScore) *TrendingScore {
+	return &TrendingScore{
+		repository: repository,
+	}
+}
+
+func (instance TrendingScore) RefreshTrendingScores() error {
+	// Scores refreshed by another instance of the API in the last half interval are kept
+	minimumRefreshInterval := instance.GetRefreshInterval() / 2
+	for _, window := range trendingWindows {
+		err := instance.repository.RefreshTrendingScores(getTrendingFilterWithScoreWeights(filters.Trending{
+			Window: window,
+		}), minimumRefreshInterval)
+		if err != nil {
+			log.Errorf("Error refreshing the trending scores for the window %s: %s", window, err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (instance TrendingScore) GetRefreshInterval() time.Duration {
+	return utils.GetDurationFromEnvironmentVariable("TRENDING_SCORE_REFRESH_INTERVAL", 5*time.Minute)
+}
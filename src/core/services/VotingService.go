@@ -3,6 +3,8 @@ package services
 import (
 	"github.com/devlucassantos/vnc-domains/src/domains/voting"
 	"github.com/google/uuid"
+	"vnc-api/core/domains/deputyvote"
+	"vnc-api/core/domains/partyorientation"
 	"vnc-api/core/interfaces/postgres"
 )
 
@@ -19,3 +21,12 @@ func NewVotingService(repository postgres.Voting) *Voting {
 func (instance Voting) GetVotingByArticleId(articleId uuid.UUID, userId uuid.UUID) (*voting.Voting, error) {
 	return instance.repository.GetVotingByArticleId(articleId, userId)
 }
+
+func (instance Voting) GetDeputyVotesByVotingId(votingId uuid.UUID) ([]deputyvote.DeputyVote, error) {
+	return instance.repository.GetDeputyVotesByVotingId(votingId)
+}
+
+func (instance Voting) GetPartyOrientationsByVotingId(votingId uuid.UUID) ([]partyorientation.PartyOrientation,
+	error) {
+	return instance.repository.GetPartyOrientationsByVotingId(votingId)
+}
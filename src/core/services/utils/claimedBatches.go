@@ -0,0 +1,27 @@
+package utils
+
+// ProcessClaimedBatches claims and processes batches of pending items until a batch is incomplete. Claiming an item
+// moves its schedule forward, so other instances of the API skip it. When processing fails, the schedule of the item
+// is restored and the loop stops, so the item is claimed again on the next check instead of in this loop
+func ProcessClaimedBatches[T any](batchSize int, claim func(batchSize int) ([]T, error), process func(item T) error,
+	restore func(item T)) error {
+	for {
+		items, err := claim(batchSize)
+		if err != nil {
+			return err
+		}
+
+		var numberOfFailures int
+		for _, item := range items {
+			err = process(item)
+			if err != nil {
+				restore(item)
+				numberOfFailures++
+			}
+		}
+
+		if len(items) < batchSize || numberOfFailures > 0 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"github.com/labstack/gommon/log"
+	"os"
+	"strconv"
+	"time"
+)
+
+func GetFloatFromEnvironmentVariable(environmentVariable string, defaultValue float64) float64 {
+	valueAsString := os.Getenv(environmentVariable)
+	if valueAsString == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.ParseFloat(valueAsString, 64)
+	if err != nil || value < 0 {
+		log.Warnf("Invalid value for the environment variable %s (Value: %s), using the default value %.2f",
+			environmentVariable, valueAsString, defaultValue)
+		return defaultValue
+	}
+
+	return value
+}
+
+func GetIntFromEnvironmentVariable(environmentVariable string, defaultValue int) int {
+	valueAsString := os.Getenv(environmentVariable)
+	if valueAsString == "" {
+		return defaultValue
+	}
+
+	value, err := strconv.Atoi(valueAsString)
+	if err != nil || value <= 0 {
+		log.Warnf("Invalid value for the environment variable %s (Value: %s), using the default value %d",
+			environmentVariable, valueAsString, defaultValue)
+		return defaultValue
+	}
+
+	return value
+}
+
+func GetDurationFromEnvironmentVariable(environmentVariable string, defaultValue time.Duration) time.Duration {
+	valueAsString := os.Getenv(environmentVariable)
+	if valueAsString == "" {
+		return defaultValue
+	}
+
+	value, err := time.ParseDuration(valueAsString)
+	if err != nil || value <= 0 {
+		log.Warnf("Invalid value for the environment variable %s (Value: %s), using the default value %s",
+			environmentVariable, valueAsString, defaultValue)
+		return defaultValue
+	}
+
+	return value
+}
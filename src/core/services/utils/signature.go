@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// The signing keys must have at least the size of the SHA-256 output, as recommended for HMAC keys
+const minimumSigningKeySize = 32
+
+func GetSignature(signingKeyEnvironmentVariable string, message string) (string, error) {
+	signingKey := os.Getenv(signingKeyEnvironmentVariable)
+	if signingKey == "" {
+		return "", fmt.Errorf("the signing key %s is not configured", signingKeyEnvironmentVariable)
+	} else if len(signingKey) < minimumSigningKeySize {
+		return "", fmt.Errorf("the signing key %s must have at least %d bytes", signingKeyEnvironmentVariable,
+			minimumSigningKeySize)
+	}
+
+	mac := hmac.New(sha256.New, []byte(signingKey))
+	mac.Write([]byte(message))
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+func ValidateSignature(signingKeyEnvironmentVariable string, message string, signature string) error {
+	expectedSignature, err := GetSignature(signingKeyEnvironmentVariable, message)
+	if err != nil {
+		return err
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(expectedSignature)) {
+		return errors.New("the signature of the link is invalid")
+	}
+
+	return nil
+}
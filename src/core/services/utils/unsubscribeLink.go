@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/labstack/gommon/log"
+	"html/template"
+	"net/url"
+	"os"
+	"time"
+)
+
+// UnsubscribeLink describes the signed links sent by email that allow the users to stop receiving a type of email
+// without logging in to the platform
+type UnsubscribeLink struct {
+	SigningKeyEnvironmentVariable string
+	Path                          string
+	IdParameter                   string
+	PageTitle                     string
+	PageMessage                   string
+}
+
+func (instance UnsubscribeLink) GetUrl(id uuid.UUID) (string, error) {
+	signature, err := GetSignature(instance.SigningKeyEnvironmentVariable, id.String())
+	if err != nil {
+		return "", err
+	}
+
+	queryParameters := url.Values{}
+	queryParameters.Set(instance.IdParameter, id.String())
+	queryParameters.Set("signature", signature)
+
+	return fmt.Sprint(os.Getenv("API_URL"), instance.Path, "?", queryParameters.Encode()), nil
+}
+
+func (instance UnsubscribeLink) ValidateSignature(id uuid.UUID, signature string) error {
+	return ValidateSignature(instance.SigningKeyEnvironmentVariable, id.String(), signature)
+}
+
+func (instance UnsubscribeLink) GetPage(id uuid.UUID, signature string) ([]byte, error) {
+	err := instance.ValidateSignature(id, signature)
+	if err != nil {
+		return nil, err
+	}
+
+	unsubscribeUrl, err := instance.GetUrl(id)
+	if err != nil {
+		return nil, err
+	}
+
+	templatePath := "core/services/resources/unsubscribe_page_template.html"
+	pageTemplate, err := template.ParseFiles(templatePath)
+	if err != nil {
+		log.Errorf("Error loading the unsubscribe page template from the path %s: %s", templatePath, err.Error())
+		return nil, err
+	}
+
+	var page bytes.Buffer
+	err = pageTemplate.Execute(&page, map[string]interface{}{
+		"title":           instance.PageTitle,
+		"message":         instance.PageMessage,
+		"unsubscribe_url": unsubscribeUrl,
+		"current_year":    time.Now().Year(),
+		"application_url": os.Getenv("APPLICATION_URL"),
+	})
+	if err != nil {
+		log.Errorf("Error executing the unsubscribe page template of %s: %s", instance.Path, err.Error())
+		return nil, err
+	}
+
+	return page.Bytes(), nil
+}
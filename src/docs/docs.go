@@ -26,7 +26,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "This request is responsible for listing the most recent articles available on the platform.",
+                "description": "This request is responsible for listing the most recent articles available on the platform. When a search by content returns no articles, the response includes spelling suggestions and a corrected query.",
                 "produces": [
                     "application/json"
                 ],
@@ -84,6 +84,12 @@ const docTemplate = `{
                         "name": "propositionExternalAuthorId",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "Federated units (UFs) represented by the deputies who drafted the proposition, at the time it was drafted, separated by commas. Example: MG,SP",
+                        "name": "propositionFederatedUnit",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "Date from which the voting results were announced. Accepted format: YYYY-MM-DD",
@@ -144,6 +150,12 @@ const docTemplate = `{
                         "name": "removeEventsInTheFuture",
                         "in": "query"
                     },
+                    {
+                        "type": "boolean",
+                        "description": "Remove articles already read by the user? Only applies to authenticated users",
+                        "name": "excludeRead",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "description": "Page number. By default, it is 1",
@@ -197,22 +209,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/articles/trending": {
+        "/articles/following": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This request is responsible for listing trending articles on the platform.",
+                "description": "This request is responsible for listing the most recent propositions, votes and events related to the deputies, parties, external authors, proposition types, legislative bodies and propositions followed by the user.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Articles"
                 ],
-                "summary": "List trending articles",
-                "operationId": "GetTrendingArticles",
+                "summary": "List the most recent articles about the resources followed by the user",
+                "operationId": "GetFollowedArticles",
                 "parameters": [
                     {
                         "type": "string",
@@ -262,6 +274,12 @@ const docTemplate = `{
                         "name": "propositionExternalAuthorId",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "Federated units (UFs) represented by the deputies who drafted the proposition, at the time it was drafted, separated by commas. Example: MG,SP",
+                        "name": "propositionFederatedUnit",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "Date from which the voting results were announced. Accepted format: YYYY-MM-DD",
@@ -375,92 +393,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/articles/trending/type": {
-            "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "This request is responsible for listing the trending articles by article types or specific types.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Articles"
-                ],
-                "summary": "List trending articles by article types",
-                "operationId": "GetTrendingArticlesByType",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "List of IDs of the types of articles that should be returned (separated by commas). By default, it returns all types",
-                        "name": "articleTypeIds",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "List of IDs of the specific types of articles that should be returned (separated by commas). By default, it returns all specific types",
-                        "name": "articleSpecificTypeIds",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Number of articles returned by type. The default is 5 and the allowed values are between 1 and 20",
-                        "name": "itemsPerType",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Successful request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.ArticleTypeWithSpecificTypes"
-                        }
-                    },
-                    "400": {
-                        "description": "Badly formatted request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized access",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
-                    },
-                    "500": {
-                        "description": "An unexpected error occurred while processing the request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
-                    },
-                    "503": {
-                        "description": "Some of the services/resources are temporarily unavailable",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
-                    }
-                }
-            }
-        },
-        "/articles/view-later": {
+        "/articles/history": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This request is responsible for listing the articles bookmarked for later viewing by the user on the platform. The articles will be listed in the order in which the user bookmarked the articles.",
+                "description": "This request is responsible for listing the articles already read by the user on the platform. The articles will be listed from the most recently viewed to the least recently viewed.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Articles"
                 ],
-                "summary": "List articles bookmarked for later viewing by the user",
-                "operationId": "GetArticlesToViewLater",
+                "summary": "List articles in the reading history of the user",
+                "operationId": "GetArticlesFromHistory",
                 "parameters": [
                     {
                         "type": "string",
@@ -510,6 +458,12 @@ const docTemplate = `{
                         "name": "propositionExternalAuthorId",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "Federated units (UFs) represented by the deputies who drafted the proposition, at the time it was drafted, separated by commas. Example: MG,SP",
+                        "name": "propositionFederatedUnit",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "Date from which the voting results were announced. Accepted format: YYYY-MM-DD",
@@ -621,45 +575,25 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/articles/{articleId}/event": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This request is responsible for looking up the details of an article of an event by the article ID.",
+                "description": "This request is responsible for removing all articles from the reading history of the user. The views of the articles are kept for the platform statistics, but are no longer associated with the user.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Articles"
                 ],
-                "summary": "Get article details by ID (Only for event articles)",
-                "operationId": "GetEventArticleById",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Article ID",
-                        "name": "articleId",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "Clear the reading history of the user",
+                "operationId": "ClearHistory",
                 "responses": {
-                    "200": {
-                        "description": "Successful request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.EventArticle"
-                        }
-                    },
-                    "400": {
-                        "description": "Badly formatted request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
+                    "204": {
+                        "description": "Successful request"
                     },
                     "401": {
                         "description": "Unauthorized access",
@@ -667,14 +601,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.HttpError"
                         }
                     },
-                    "404": {
-                        "description": "Requested resource not found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
-                    },
-                    "422": {
-                        "description": "Some of the data provided is invalid",
+                    "403": {
+                        "description": "Access denied",
                         "schema": {
                             "$ref": "#/definitions/swagger.HttpError"
                         }
@@ -694,22 +622,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/articles/{articleId}/newsletter": {
-            "get": {
+        "/articles/history/{articleId}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This request is responsible for looking up the details of an article of a newsletter by the article ID.",
+                "description": "This request is responsible for removing an article from the reading history of the user. The views of the article are kept for the platform statistics, but are no longer associated with the user.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Articles"
                 ],
-                "summary": "Get article details by ID (Only for newsletter articles)",
-                "operationId": "GetNewsletterArticleById",
+                "summary": "Remove an article from the reading history of the user",
+                "operationId": "DeleteArticleFromHistory",
                 "parameters": [
                     {
                         "type": "string",
@@ -720,11 +648,8 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Successful request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.NewsletterArticle"
-                        }
+                    "204": {
+                        "description": "Successful request"
                     },
                     "400": {
                         "description": "Badly formatted request",
@@ -738,6 +663,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.HttpError"
                         }
                     },
+                    "403": {
+                        "description": "Access denied",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
                     "404": {
                         "description": "Requested resource not found",
                         "schema": {
@@ -765,36 +696,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/articles/{articleId}/proposition": {
-            "get": {
+        "/articles/rating/batch": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This request is responsible for looking up the details of an article of a proposition by the article ID.",
+                "description": "This request is responsible for rating or removing the rating of up to 100 articles at once. All ratings are saved in a single transaction and the result of the operation is returned for each article, in the order in which the articles were informed. Articles with invalid or duplicated data are ignored and reported with the ` + "`" + `invalid` + "`" + ` status.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Articles"
                 ],
-                "summary": "Get article details by ID (Only for proposition articles)",
-                "operationId": "GetPropositionArticleById",
+                "summary": "Rate several articles at once",
+                "operationId": "SaveArticleRatings",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Article ID",
-                        "name": "articleId",
-                        "in": "path",
-                        "required": true
+                        "description": "Request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RatingBatch"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "Successful request",
                         "schema": {
-                            "$ref": "#/definitions/swagger.PropositionArticle"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/swagger.ArticleOperation"
+                            }
                         }
                     },
                     "400": {
@@ -809,8 +748,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.HttpError"
                         }
                     },
-                    "404": {
-                        "description": "Requested resource not found",
+                    "403": {
+                        "description": "Access denied",
                         "schema": {
                             "$ref": "#/definitions/swagger.HttpError"
                         }
@@ -836,46 +775,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/articles/{articleId}/rating": {
-            "put": {
+        "/articles/recommended": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This request is responsible for recording the user's rating of an article.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This request is responsible for listing the articles recommended to the user based on the articles they read and rated, together with the reason for each recommendation. Anonymous users and users without reading history receive the trending articles.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Articles"
                 ],
-                "summary": "Rate article",
-                "operationId": "SaveArticleRating",
+                "summary": "List recommended articles",
+                "operationId": "GetRecommendedArticles",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Article ID",
-                        "name": "articleId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Request body",
-                        "name": "requestBody",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.Rating"
-                        }
+                        "type": "integer",
+                        "description": "Number of articles returned. The default is 15 and the allowed values are between 1 and 50",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Successful request"
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/swagger.RecommendedArticle"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Badly formatted request",
@@ -889,18 +821,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.HttpError"
                         }
                     },
-                    "403": {
-                        "description": "Access denied",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
-                    },
-                    "404": {
-                        "description": "Requested resource not found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
-                    },
                     "422": {
                         "description": "Some of the data provided is invalid",
                         "schema": {
@@ -922,122 +842,161 @@ const docTemplate = `{
                 }
             }
         },
-        "/articles/{articleId}/view-later": {
-            "put": {
+        "/articles/trending": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This request is responsible for adding or removing the article from the list of articles bookmarked for later viewing by the user.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This request is responsible for listing trending articles on the platform.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Articles"
                 ],
-                "summary": "Add or remove an article from the list of articles bookmarked for later viewing by the user",
-                "operationId": "SaveArticleToViewLater",
+                "summary": "List trending articles",
+                "operationId": "GetTrendingArticles",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Article ID",
-                        "name": "articleId",
-                        "in": "path",
-                        "required": true
+                        "description": "Article type ID",
+                        "name": "typeId",
+                        "in": "query"
                     },
                     {
-                        "description": "Request body",
-                        "name": "requestBody",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.ViewLater"
-                        }
-                    }
-                ],
-                "responses": {
-                    "204": {
-                        "description": "Successful request"
+                        "type": "string",
+                        "description": "Article specific type ID",
+                        "name": "specificTypeId",
+                        "in": "query"
                     },
-                    "400": {
-                        "description": "Badly formatted request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Part of the content of the articles, in the title or content",
+                        "name": "content",
+                        "in": "query"
                     },
-                    "401": {
-                        "description": "Unauthorized access",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Date from which the articles were created. Accepted format: YYYY-MM-DD",
+                        "name": "startDate",
+                        "in": "query"
                     },
-                    "403": {
-                        "description": "Access denied",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "Date until which the articles were created. Accepted format: YYYY-MM-DD",
+                        "name": "endDate",
+                        "in": "query"
                     },
-                    "404": {
-                        "description": "Requested resource not found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "ID of the deputy who drafted the proposition",
+                        "name": "propositionDeputyId",
+                        "in": "query"
                     },
-                    "422": {
-                        "description": "Some of the data provided is invalid",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "ID of the party that drafted the proposition",
+                        "name": "propositionPartyId",
+                        "in": "query"
                     },
-                    "500": {
-                        "description": "An unexpected error occurred while processing the request",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
+                    {
+                        "type": "string",
+                        "description": "ID of the external author who drafted the proposition",
+                        "name": "propositionExternalAuthorId",
+                        "in": "query"
                     },
-                    "503": {
-                        "description": "Some of the services/resources are temporarily unavailable",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
-                    }
-                }
-            }
-        },
-        "/articles/{articleId}/voting": {
-            "get": {
-                "security": [
                     {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "This request is responsible for looking up the details of an article of a voting by the article ID.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Articles"
-                ],
-                "summary": "Get article details by ID (Only for voting articles)",
-                "operationId": "GetVotingArticleById",
-                "parameters": [
+                        "type": "string",
+                        "description": "Federated units (UFs) represented by the deputies who drafted the proposition, at the time it was drafted, separated by commas. Example: MG,SP",
+                        "name": "propositionFederatedUnit",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Article ID",
-                        "name": "articleId",
-                        "in": "path",
-                        "required": true
+                        "description": "Date from which the voting results were announced. Accepted format: YYYY-MM-DD",
+                        "name": "votingStartDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date until which the voting results were announced. Accepted format: YYYY-MM-DD",
+                        "name": "votingEndDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Voting result. Accepted values: approved, rejected and undetermined",
+                        "name": "votingResult",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the legislative body responsible for the voting",
+                        "name": "votingLegislativeBodyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date from which the events occurred. Accepted format: YYYY-MM-DD",
+                        "name": "eventStartDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date until which the events occurred. Accepted format: YYYY-MM-DD",
+                        "name": "eventEndDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the event situation",
+                        "name": "eventSituationId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the legislative body responsible for the event",
+                        "name": "eventLegislativeBodyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the rapporteur (deputy) for one or more items on the event agenda",
+                        "name": "eventRapporteurId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Remove events in the future?",
+                        "name": "removeEventsInTheFuture",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number. By default, it is 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of articles returned per page. The default is 15 and the allowed values are between 1 and 100",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Period considered when calculating the trending score. Accepted values: 24h, 7d and 30d. The default is 7d",
+                        "name": "window",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "Successful request",
                         "schema": {
-                            "$ref": "#/definitions/swagger.VotingArticle"
+                            "$ref": "#/definitions/swagger.ArticlePagination"
                         }
                     },
                     "400": {
@@ -1052,12 +1011,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.HttpError"
                         }
                     },
-                    "404": {
-                        "description": "Requested resource not found",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
-                    },
                     "422": {
                         "description": "Some of the data provided is invalid",
                         "schema": {
@@ -1079,36 +1032,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/refresh": {
-            "post": {
-                "description": "This request is responsible for refreshing the user's access tokens on the platform.",
-                "consumes": [
-                    "application/json"
+        "/articles/trending/type": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This request is responsible for listing the trending articles by article types or specific types.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Articles"
                 ],
-                "summary": "Refresh access tokens",
-                "operationId": "Refresh",
+                "summary": "List trending articles by article types",
+                "operationId": "GetTrendingArticlesByType",
                 "parameters": [
                     {
-                        "description": "Request body",
-                        "name": "requestBody",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.RefreshTokens"
-                        }
+                        "type": "string",
+                        "description": "List of IDs of the types of articles that should be returned (separated by commas). By default, it returns all types",
+                        "name": "articleTypeIds",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "List of IDs of the specific types of articles that should be returned (separated by commas). By default, it returns all specific types",
+                        "name": "articleSpecificTypeIds",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of articles returned by type. The default is 5 and the allowed values are between 1 and 20",
+                        "name": "itemsPerType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Period considered when calculating the trending score. Accepted values: 24h, 7d and 30d. The default is 7d",
+                        "name": "window",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "Successful request",
                         "schema": {
-                            "$ref": "#/definitions/swagger.User"
+                            "$ref": "#/definitions/swagger.ArticleTypeWithSpecificTypes"
                         }
                     },
                     "400": {
@@ -1123,12 +1093,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.HttpError"
                         }
                     },
-                    "422": {
-                        "description": "Some of the data provided is invalid",
-                        "schema": {
-                            "$ref": "#/definitions/swagger.HttpError"
-                        }
-                    },
                     "500": {
                         "description": "An unexpected error occurred while processing the request",
                         "schema": {
@@ -1144,36 +1108,155 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/sign-in": {
-            "post": {
-                "description": "This request is responsible for signing the user into the platform.",
-                "consumes": [
-                    "application/json"
+        "/articles/view-later": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This request is responsible for listing the articles bookmarked for later viewing by the user on the platform. The articles will be listed in the order of the default reading list of the user, which can be reordered by the user.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Articles"
                 ],
-                "summary": "Sign In",
-                "operationId": "SignIn",
+                "summary": "List articles bookmarked for later viewing by the user",
+                "operationId": "GetArticlesToViewLater",
                 "parameters": [
                     {
-                        "description": "Request body",
-                        "name": "requestBody",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.SignIn"
-                        }
-                    }
-                ],
-                "responses": {
+                        "type": "string",
+                        "description": "Article type ID",
+                        "name": "typeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Article specific type ID",
+                        "name": "specificTypeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Part of the content of the articles, in the title or content",
+                        "name": "content",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date from which the articles were created. Accepted format: YYYY-MM-DD",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date until which the articles were created. Accepted format: YYYY-MM-DD",
+                        "name": "endDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the deputy who drafted the proposition",
+                        "name": "propositionDeputyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the party that drafted the proposition",
+                        "name": "propositionPartyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the external author who drafted the proposition",
+                        "name": "propositionExternalAuthorId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Federated units (UFs) represented by the deputies who drafted the proposition, at the time it was drafted, separated by commas. Example: MG,SP",
+                        "name": "propositionFederatedUnit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date from which the voting results were announced. Accepted format: YYYY-MM-DD",
+                        "name": "votingStartDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date until which the voting results were announced. Accepted format: YYYY-MM-DD",
+                        "name": "votingEndDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Voting result. Accepted values: approved, rejected and undetermined",
+                        "name": "votingResult",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the legislative body responsible for the voting",
+                        "name": "votingLegislativeBodyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date from which the events occurred. Accepted format: YYYY-MM-DD",
+                        "name": "eventStartDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date until which the events occurred. Accepted format: YYYY-MM-DD",
+                        "name": "eventEndDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the event situation",
+                        "name": "eventSituationId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the legislative body responsible for the event",
+                        "name": "eventLegislativeBodyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the rapporteur (deputy) for one or more items on the event agenda",
+                        "name": "eventRapporteurId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Remove events in the future?",
+                        "name": "removeEventsInTheFuture",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number. By default, it is 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of articles returned per page. The default is 15 and the allowed values are between 1 and 100",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
                     "200": {
                         "description": "Successful request",
                         "schema": {
-                            "$ref": "#/definitions/swagger.User"
+                            "$ref": "#/definitions/swagger.ArticlePagination"
                         }
                     },
                     "400": {
@@ -1209,25 +1292,45 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/sign-out": {
-            "post": {
+        "/articles/view-later/batch": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This request is responsible for signing the user out of the platform.",
+                "description": "This request is responsible for adding or removing up to 100 articles at once from the list of articles bookmarked for later viewing by the user. All bookmarks are saved in a single transaction and the result of the operation is returned for each article, in the order in which the articles were informed. Articles with invalid or duplicated data are ignored and reported with the ` + "`" + `invalid` + "`" + ` status.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Articles"
+                ],
+                "summary": "Add or remove several articles from the list of articles bookmarked for later viewing by the user",
+                "operationId": "SaveArticlesToViewLater",
+                "parameters": [
+                    {
+                        "description": "Request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ViewLaterBatch"
+                        }
+                    }
                 ],
-                "summary": "Sign Out",
-                "operationId": "SignOut",
                 "responses": {
-                    "204": {
-                        "description": "Successful request"
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/swagger.ArticleOperation"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Badly formatted request",
@@ -1247,6 +1350,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.HttpError"
                         }
                     },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
                     "500": {
                         "description": "An unexpected error occurred while processing the request",
                         "schema": {
@@ -1262,36 +1371,42 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/sign-up": {
-            "post": {
-                "description": "This request is responsible for signing the user up to the platform.",
-                "consumes": [
-                    "application/json"
+        "/articles/{articleId}/event": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "This request is responsible for looking up the details of an article of an event by the article ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication"
+                    "Articles"
                 ],
-                "summary": "Sign Up",
-                "operationId": "SignUp",
+                "summary": "Get article details by ID (Only for event articles)",
+                "operationId": "GetEventArticleById",
                 "parameters": [
                     {
-                        "description": "Request body",
-                        "name": "requestBody",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/request.SignUp"
-                        }
+                        "type": "string",
+                        "description": "Article ID",
+                        "name": "articleId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Register the view of the article? By default, it is true",
+                        "name": "recordView",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
+                    "200": {
                         "description": "Successful request",
                         "schema": {
-                            "$ref": "#/definitions/swagger.User"
+                            "$ref": "#/definitions/swagger.EventArticle"
                         }
                     },
                     "400": {
@@ -1306,8 +1421,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.HttpError"
                         }
                     },
-                    "409": {
-                        "description": "Some of the data provided is conflicting",
+                    "404": {
+                        "description": "Requested resource not found",
                         "schema": {
                             "$ref": "#/definitions/swagger.HttpError"
                         }
@@ -1333,25 +1448,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/resources": {
+        "/articles/{articleId}/newsletter": {
             "get": {
-                "description": "This request is responsible for listing all the platform resources.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for looking up the details of an article of a newsletter by the article ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Resources"
+                    "Articles"
+                ],
+                "summary": "Get article details by ID (Only for newsletter articles)",
+                "operationId": "GetNewsletterArticleById",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Article ID",
+                        "name": "articleId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Register the view of the article? By default, it is true",
+                        "name": "recordView",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List all resources",
-                "operationId": "GetResources",
                 "responses": {
                     "200": {
                         "description": "Successful request",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/swagger.Resources"
-                            }
+                            "$ref": "#/definitions/swagger.NewsletterArticle"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
                         }
                     },
                     "401": {
@@ -1360,6 +1498,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.HttpError"
                         }
                     },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
                     "500": {
                         "description": "An unexpected error occurred while processing the request",
                         "schema": {
@@ -1375,35 +1525,107 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/activate-account": {
-            "patch": {
+        "/articles/{articleId}/proposition": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This request is responsible for activating the user's account, proving that the email address provided during registration really exists and belongs to the user.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "This request is responsible for looking up the details of an article of a proposition by the article ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Articles"
                 ],
-                "summary": "Activate user account",
-                "operationId": "ActivateAccount",
+                "summary": "Get article details by ID (Only for proposition articles)",
+                "operationId": "GetPropositionArticleById",
                 "parameters": [
                     {
-                        "description": "Request body",
-                        "name": "requestBody",
-                        "in": "body",
-                        "required": true,
+                        "type": "string",
+                        "description": "Article ID",
+                        "name": "articleId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Register the view of the article? By default, it is true",
+                        "name": "recordView",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
                         "schema": {
-                            "$ref": "#/definitions/request.UserAccountActivation"
+                            "$ref": "#/definitions/swagger.PropositionArticle"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
                         }
                     }
+                }
+            }
+        },
+        "/articles/{articleId}/proposition/timeline": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for returning the path of a proposition through the Chamber as a single chronologically ordered list, which includes its submission, each event agenda item where it appeared (with the regime and situation of the item and the situation of the event), each voting about it (with its result) and the newsletters that mention it. Each item carries the ID of the article where its details can be found.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Articles"
+                ],
+                "summary": "Get the timeline of a proposition by the article ID",
+                "operationId": "GetPropositionTimelineByArticleId",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Article ID",
+                        "name": "articleId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
@@ -1411,7 +1633,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/swagger.User"
+                                "$ref": "#/definitions/swagger.TimelineItem"
                             }
                         }
                     },
@@ -1427,14 +1649,94 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.HttpError"
                         }
                     },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/articles/{articleId}/rating": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for recording the user's rating of an article.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Articles"
+                ],
+                "summary": "Rate article",
+                "operationId": "SaveArticleRating",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Article ID",
+                        "name": "articleId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.Rating"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
                     "403": {
                         "description": "Access denied",
                         "schema": {
                             "$ref": "#/definitions/swagger.HttpError"
                         }
                     },
-                    "409": {
-                        "description": "Some of the data provided is conflicting",
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
                         "schema": {
                             "$ref": "#/definitions/swagger.HttpError"
                         }
@@ -1454,22 +1756,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/resend-activation-email": {
-            "patch": {
+        "/articles/{articleId}/view": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "This request is responsible for resending the user account activation email. Each time it is sent, a new code is generated, invalidating the previous code.",
+                "description": "This request is responsible for registering the view of an article by the user (or by the anonymous visitor). Repeated views of the same article by the same viewer within the deduplication window, as well as views from known bots, are ignored. Views are buffered and registered in the database asynchronously.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Users"
+                    "Articles"
+                ],
+                "summary": "Register the view of an article",
+                "operationId": "RegisterArticleView",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Article ID",
+                        "name": "articleId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Resend user account activation email",
-                "operationId": "ResendActivationEmail",
                 "responses": {
                     "204": {
                         "description": "Successful request"
@@ -1486,14 +1797,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/swagger.HttpError"
                         }
                     },
-                    "403": {
-                        "description": "Access denied",
+                    "404": {
+                        "description": "Requested resource not found",
                         "schema": {
                             "$ref": "#/definitions/swagger.HttpError"
                         }
                     },
-                    "409": {
-                        "description": "Some of the data provided is conflicting",
+                    "422": {
+                        "description": "Some of the data provided is invalid",
                         "schema": {
                             "$ref": "#/definitions/swagger.HttpError"
                         }
@@ -1512,815 +1823,5561 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "request.Rating": {
-            "type": "object",
-            "properties": {
-                "rating": {
-                    "type": "integer",
-                    "example": 3
-                }
-            }
-        },
-        "request.RefreshTokens": {
-            "type": "object",
-            "properties": {
-                "refresh_token": {
-                    "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJ1c2VybmFtZSI6InByb2YiL..."
-                }
-            }
         },
-        "request.SignIn": {
-            "type": "object",
-            "properties": {
-                "email": {
-                    "type": "string",
-                    "example": "example@email.com"
-                },
-                "password": {
-                    "type": "string",
-                    "example": "luc@ssantos123"
-                }
-            }
-        },
-        "request.SignUp": {
-            "type": "object",
-            "properties": {
-                "email": {
-                    "type": "string",
-                    "example": "example@email.com"
-                },
-                "first_name": {
-                    "type": "string",
-                    "example": "Lucas"
-                },
-                "last_name": {
-                    "type": "string",
-                    "example": "Santos"
-                },
-                "password": {
-                    "type": "string",
-                    "example": "luc@ssantos123"
-                }
+        "/articles/{articleId}/view-later": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for adding or removing the article from the list of articles bookmarked for later viewing by the user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Articles"
+                ],
+                "summary": "Add or remove an article from the list of articles bookmarked for later viewing by the user",
+                "operationId": "SaveArticleToViewLater",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Article ID",
+                        "name": "articleId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ViewLater"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/articles/{articleId}/voting": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for looking up the details of an article of a voting by the article ID, including how each deputy voted (yes, no, abstention, obstruction or absent) and the orientation given by each party (yes, no, abstention, obstruction or free).",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Articles"
+                ],
+                "summary": "Get article details by ID (Only for voting articles)",
+                "operationId": "GetVotingArticleById",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Article ID",
+                        "name": "articleId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Register the view of the article? By default, it is true",
+                        "name": "recordView",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.VotingArticle"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "description": "This request is responsible for refreshing the user's access tokens on the platform.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Refresh access tokens",
+                "operationId": "Refresh",
+                "parameters": [
+                    {
+                        "description": "Request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RefreshTokens"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/sign-in": {
+            "post": {
+                "description": "This request is responsible for signing the user into the platform.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Sign In",
+                "operationId": "SignIn",
+                "parameters": [
+                    {
+                        "description": "Request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.SignIn"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/sign-out": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for signing the user out of the platform.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Sign Out",
+                "operationId": "SignOut",
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/sign-up": {
+            "post": {
+                "description": "This request is responsible for signing the user up to the platform.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication"
+                ],
+                "summary": "Sign Up",
+                "operationId": "SignUp",
+                "parameters": [
+                    {
+                        "description": "Request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.SignUp"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "409": {
+                        "description": "Some of the data provided is conflicting",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/calendar/events.ics": {
+            "get": {
+                "description": "This request is responsible for returning the events available on the platform as an iCalendar (RFC 5545) file that can be imported or subscribed to in calendar applications. Each event keeps the same UID across requests and its status follows the situation of the event, so cancelled events are returned with the status CANCELLED. By default, only the events of the last 30 days and the future events are returned.",
+                "produces": [
+                    "text/calendar",
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Export the events as an iCalendar file",
+                "operationId": "GetEventCalendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the event type",
+                        "name": "eventTypeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date from which the events occurred. Accepted format: YYYY-MM-DD",
+                        "name": "eventStartDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date until which the events occurred. Accepted format: YYYY-MM-DD",
+                        "name": "eventEndDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the event situation",
+                        "name": "eventSituationId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the legislative body responsible for the event",
+                        "name": "eventLegislativeBodyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the rapporteur (deputy) for one or more items on the event agenda",
+                        "name": "eventRapporteurId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Remove events in the future?",
+                        "name": "removeEventsInTheFuture",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/calendar/private/{calendarToken}/events.ics": {
+            "get": {
+                "description": "This request is responsible for returning, as an iCalendar (RFC 5545) file, the events of the legislative bodies followed by the user who owns the private calendar token. The URL of this calendar is returned by the request that creates the private calendar of the user and does not require authentication, so that it can be subscribed to in calendar applications. It accepts the same filters as the public calendar of events.",
+                "produces": [
+                    "text/calendar",
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Export the events of the legislative bodies followed by a user as an iCalendar file",
+                "operationId": "GetPrivateEventCalendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Private calendar token",
+                        "name": "calendarToken",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the event type",
+                        "name": "eventTypeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date from which the events occurred. Accepted format: YYYY-MM-DD",
+                        "name": "eventStartDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date until which the events occurred. Accepted format: YYYY-MM-DD",
+                        "name": "eventEndDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the event situation",
+                        "name": "eventSituationId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the legislative body responsible for the event",
+                        "name": "eventLegislativeBodyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the rapporteur (deputy) for one or more items on the event agenda",
+                        "name": "eventRapporteurId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Remove events in the future?",
+                        "name": "removeEventsInTheFuture",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/deputies/compare": {
+            "get": {
+                "description": "This request is responsible for comparing from two to four deputies. For each deputy, it returns the same summary of the legislative activity presented in the profile of the deputy (number of propositions by type, number of rapporteurships and approval rate) and whether the deputy has changed party. For each pair of deputies, it returns the number of propositions that both deputies co-authored and, considering the votings in which both deputies were present, how often they voted the same way.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Deputies"
+                ],
+                "summary": "Compare deputies side by side",
+                "operationId": "GetDeputyComparison",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list with the IDs of the deputies that will be compared. Between 2 and 4 different deputies must be provided",
+                        "name": "ids",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.DeputyComparison"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/deputies/{deputyId}": {
+            "get": {
+                "description": "This request is responsible for returning the profile of a deputy, which includes the identity of the deputy, the current party and federated unit and, when the deputy has drafted propositions under a different party or federated unit, the most recent of them. The profile also contains the paginated lists of the propositions drafted by the deputy and of the agenda items for which the deputy was the rapporteur, along with a summary of the legislative activity: the number of propositions by type and the approval rate of the propositions that were voted, considering the latest voting with a defined result of each proposition.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Deputies"
+                ],
+                "summary": "Get the profile of a deputy by ID",
+                "operationId": "GetDeputyById",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Deputy ID",
+                        "name": "deputyId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number of the propositions drafted by the deputy. By default, it is 1",
+                        "name": "propositionsPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number of the rapporteurships of the deputy. By default, it is 1",
+                        "name": "rapporteurshipsPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of propositions and rapporteurships returned per page. The default is 15 and the allowed values are between 1 and 100",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.DeputyProfile"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/deputies/{deputyId}/votes": {
+            "get": {
+                "description": "This request is responsible for returning the paginated list of the votes of a deputy, from the most recent voting to the oldest. Each vote contains the voting in which it was cast, the party of the deputy at the time of the vote and, when there was one, the orientation that the party gave to its deputies.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Deputies"
+                ],
+                "summary": "Get the votes of a deputy",
+                "operationId": "GetDeputyVotes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Deputy ID",
+                        "name": "deputyId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Vote of the deputy. Accepted values: yes, no, abstention, obstruction and absent",
+                        "name": "vote",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date from which the voting results were announced. Accepted format: YYYY-MM-DD",
+                        "name": "votingStartDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date until which the voting results were announced. Accepted format: YYYY-MM-DD",
+                        "name": "votingEndDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Voting result. Accepted values: approved, rejected and undetermined",
+                        "name": "votingResult",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the legislative body responsible for the voting",
+                        "name": "votingLegislativeBodyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number. By default, it is 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of votes returned per page. The default is 15 and the allowed values are between 1 and 100",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.VotingRecordPagination"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/email-digest/unsubscribe": {
+            "get": {
+                "description": "This request is responsible for returning the page opened by the signed link included in each digest, in which the user confirms that they want to unsubscribe from the email digest. Opening the link does not unsubscribe the user, so that link previews and email scanners cannot unsubscribe them.",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get the confirmation page to unsubscribe from the email digest",
+                "operationId": "GetEmailDigestUnsubscribePage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Signature of the unsubscribe link",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "This request is responsible for unsubscribing the user from the email digest through the signed link included in each digest, without requiring authentication. It is sent by the confirmation page, after which the user is redirected to the platform, and by the one-click unsubscribe of email clients (RFC 8058), which send the List-Unsubscribe=One-Click body.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Unsubscribe from the email digest through the link sent by email",
+                "operationId": "UnsubscribeFromEmailDigestByLink",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Signature of the unsubscribe link",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "One-click unsubscribe of email clients. Accepted value: One-Click",
+                        "name": "List-Unsubscribe",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "303": {
+                        "description": "Successful request, redirecting to the platform"
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/feeds/{feed}": {
+            "get": {
+                "description": "This request is responsible for returning the most recent articles available on the platform as an RSS 2.0 or Atom feed. The feed is chosen by the path, in the format ` + "`" + `{type}.{format}` + "`" + `, where the type is articles, propositions, votes, events or newsletters and the format is rss or atom. It accepts the same filters as the article listing, except for excludeRead, and the typeId parameter is ignored by the feeds of a specific type. Entries are identified by the article ID, and the response carries the ETag and Last-Modified headers so that clients can use conditional requests (If-None-Match and If-Modified-Since).",
+                "produces": [
+                    "application/rss+xml",
+                    "application/atom+xml",
+                    "application/json"
+                ],
+                "tags": [
+                    "Feeds"
+                ],
+                "summary": "Get a feed of the most recent articles",
+                "operationId": "GetArticleFeed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Feed name. Accepted values: articles.rss, articles.atom, propositions.rss, propositions.atom, votes.rss, votes.atom, events.rss, events.atom, newsletters.rss and newsletters.atom",
+                        "name": "feed",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Article type ID",
+                        "name": "typeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Article specific type ID",
+                        "name": "specificTypeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Part of the content of the articles, in the title or content",
+                        "name": "content",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date from which the articles were created. Accepted format: YYYY-MM-DD",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date until which the articles were created. Accepted format: YYYY-MM-DD",
+                        "name": "endDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the deputy who drafted the proposition",
+                        "name": "propositionDeputyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the party that drafted the proposition",
+                        "name": "propositionPartyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the external author who drafted the proposition",
+                        "name": "propositionExternalAuthorId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Federated units (UFs) represented by the deputies who drafted the proposition, at the time it was drafted, separated by commas. Example: MG,SP",
+                        "name": "propositionFederatedUnit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date from which the voting results were announced. Accepted format: YYYY-MM-DD",
+                        "name": "votingStartDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date until which the voting results were announced. Accepted format: YYYY-MM-DD",
+                        "name": "votingEndDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Voting result. Accepted values: approved, rejected and undetermined",
+                        "name": "votingResult",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the legislative body responsible for the voting",
+                        "name": "votingLegislativeBodyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date from which the events occurred. Accepted format: YYYY-MM-DD",
+                        "name": "eventStartDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date until which the events occurred. Accepted format: YYYY-MM-DD",
+                        "name": "eventEndDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the event situation",
+                        "name": "eventSituationId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the legislative body responsible for the event",
+                        "name": "eventLegislativeBodyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the rapporteur (deputy) for one or more items on the event agenda",
+                        "name": "eventRapporteurId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Remove events in the future?",
+                        "name": "removeEventsInTheFuture",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number. By default, it is 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of articles returned per page. The default is 15 and the allowed values are between 1 and 100",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "The feed has not been modified since the last request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/graphs/coauthorship": {
+            "get": {
+                "description": "This request is responsible for returning the network of authors of the propositions, in which the nodes are the deputies and external authors that drafted propositions together and the edges link each pair of co-authors, with the number of propositions they share as the weight. Only the collaborations with at least the minimum weight are included, and the network is limited to the authors with the most propositions among them. The propositions are limited to a period of up to 366 days, which ends on the current date when no dates are informed. The party and the federated unit of each author are the ones of their most recent proposition in the period. The party filter keeps the propositions that have at least one author from the party, so the collaborations of the party with other parties remain in the network. Besides JSON, the network can be exported in the GraphML and GEXF formats, which can be loaded in tools such as Gephi.",
+                "produces": [
+                    "application/json",
+                    "application/graphml+xml",
+                    "application/gexf+xml"
+                ],
+                "tags": [
+                    "Graphs"
+                ],
+                "summary": "Get the co-authorship network of the propositions",
+                "operationId": "GetCoAuthorshipGraph",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Date from which the propositions were submitted. By default, it is 366 days before the end date. Accepted format: YYYY-MM-DD",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date until which the propositions were submitted. By default, it is 366 days after the start date or, without a start date, the current date. Accepted format: YYYY-MM-DD",
+                        "name": "endDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of a party that must be among the authors of the propositions",
+                        "name": "partyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Proposition type ID",
+                        "name": "propositionTypeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Minimum number of propositions shared by two authors for their collaboration to be included. By default, it is 1",
+                        "name": "minimumWeight",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Format of the response. Accepted values: json, graphml and gexf. By default, it is json",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.CoAuthorshipGraph"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/legislative-bodies/{legislativeBodyId}": {
+            "get": {
+                "description": "This request is responsible for returning, in a single request, the profile of a legislative body (such as a committee), which includes its type, the upcoming events (from the current day onwards, in chronological order), the most recent events and votes and the deputies who were most frequently rapporteurs of items on the agendas of its events.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Legislative Bodies"
+                ],
+                "summary": "Get the profile of a legislative body by ID",
+                "operationId": "GetLegislativeBodyById",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Legislative body ID",
+                        "name": "legislativeBodyId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.LegislativeBodyProfile"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for listing the notifications of the user about the new votes, events and newsletter mentions of the propositions they follow, from the most recent to the oldest, together with the number of unread notifications.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "List the notifications of the user",
+                "operationId": "GetNotifications",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "List only the unread notifications?",
+                        "name": "onlyUnread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number. By default, it is 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of notifications returned per page. The default is 15 and the allowed values are between 1 and 100",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.NotificationPagination"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/preferences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for listing, for each notification type, whether the user receives notifications of that type. All types are enabled by default.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "List the notification preferences of the user",
+                "operationId": "GetNotificationPreferences",
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/swagger.NotificationPreference"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/preferences/{type}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for enabling or disabling the notifications of a type for the user. Disabling a type stops new notifications of that type from being generated, without removing the existing ones.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Enable or disable a notification type",
+                "operationId": "SaveNotificationPreference",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification type. Accepted values: proposition_voting, proposition_event and proposition_newsletter",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.NotificationPreference"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/read": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for marking all the unread notifications of the user as read.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Mark all notifications as read",
+                "operationId": "MarkAllNotificationsAsRead",
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{notificationId}/read": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for marking a notification of the user as read.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Mark a notification as read",
+                "operationId": "MarkNotificationAsRead",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification ID",
+                        "name": "notificationId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/parties/{partyId}": {
+            "get": {
+                "description": "This request is responsible for returning the profile of a party, which includes its current deputies, the paginated list of the propositions drafted by deputies while affiliated with the party and a summary of its legislative activity: the number of propositions by type, the approval and rejection rates of the propositions that were voted (considering the latest voting with a defined result of each proposition) and the activity of the party in each month of the requested period.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Parties"
+                ],
+                "summary": "Get the profile of a party by ID",
+                "operationId": "GetPartyById",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Party ID",
+                        "name": "partyId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of months of the monthly activity, counting the current month. The default is 12 and the allowed values are between 1 and 60",
+                        "name": "months",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number of the propositions drafted under the party. By default, it is 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of propositions returned per page. The default is 15 and the allowed values are between 1 and 100",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.PartyProfile"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/reading-lists": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for listing the reading lists created by the user. The default reading list, which contains the articles bookmarked for later viewing, is listed first once the user bookmarks an article for later viewing.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reading Lists"
+                ],
+                "summary": "List the reading lists of the user",
+                "operationId": "GetReadingLists",
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/swagger.ReadingList"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for creating a new reading list for the user. When the reading list is public, the response includes the link through which it can be shared.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reading Lists"
+                ],
+                "summary": "Create a reading list",
+                "operationId": "CreateReadingList",
+                "parameters": [
+                    {
+                        "description": "Request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ReadingList"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ReadingList"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/reading-lists/shared/{shareToken}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for returning the details of a public reading list through its share token, along with its articles in the order defined by its owner.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reading Lists"
+                ],
+                "summary": "Get the details of a shared reading list",
+                "operationId": "GetSharedReadingList",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Share token of the reading list",
+                        "name": "shareToken",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number. By default, it is 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of articles returned per page. The default is 15 and the allowed values are between 1 and 100",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ReadingListWithArticles"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/reading-lists/{readingListId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for returning the details of a reading list of the user, along with its articles in the order defined by the user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reading Lists"
+                ],
+                "summary": "Get the details of a reading list",
+                "operationId": "GetReadingListById",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reading list ID",
+                        "name": "readingListId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number. By default, it is 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of articles returned per page. The default is 15 and the allowed values are between 1 and 100",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ReadingListWithArticles"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for renaming a reading list of the user, changing its description or making it public or private. Making a reading list private invalidates its share link.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reading Lists"
+                ],
+                "summary": "Update a reading list",
+                "operationId": "UpdateReadingList",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reading list ID",
+                        "name": "readingListId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ReadingList"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ReadingList"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for deleting a reading list of the user. The default reading list, which contains the articles bookmarked for later viewing, cannot be deleted.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reading Lists"
+                ],
+                "summary": "Delete a reading list",
+                "operationId": "DeleteReadingList",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reading list ID",
+                        "name": "readingListId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/reading-lists/{readingListId}/articles/{articleId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for adding an article to the end of a reading list of the user or, if the article is already in the reading list, updating its note. When the note is omitted, the current note of the article is kept. Adding an article to the default reading list also bookmarks it for later viewing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reading Lists"
+                ],
+                "summary": "Add an article to a reading list",
+                "operationId": "SaveArticleToReadingList",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reading list ID",
+                        "name": "readingListId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Article ID",
+                        "name": "articleId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ReadingListItem"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for removing an article from a reading list of the user. Removing an article from the default reading list also removes its bookmark for later viewing.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reading Lists"
+                ],
+                "summary": "Remove an article from a reading list",
+                "operationId": "RemoveArticleFromReadingList",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reading list ID",
+                        "name": "readingListId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Article ID",
+                        "name": "articleId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/reading-lists/{readingListId}/order": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for changing the order of the articles in a reading list of the user. The articles informed are moved to the beginning of the reading list in the order given, and the remaining articles keep their relative order after them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reading Lists"
+                ],
+                "summary": "Sort the articles of a reading list",
+                "operationId": "SortReadingListArticles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Reading list ID",
+                        "name": "readingListId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ReadingListOrder"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/resources": {
+            "get": {
+                "description": "This request is responsible for listing all the platform resources.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resources"
+                ],
+                "summary": "List all resources",
+                "operationId": "GetResources",
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/swagger.Resources"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/saved-searches": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for listing the searches saved by the user, along with the number of new articles that match each search since it was last opened.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "List the saved searches of the user",
+                "operationId": "GetSavedSearches",
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/swagger.SavedSearch"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for saving a search of articles for the user. The query accepts the same filters as the article listing (GET /articles), in query string format, and the pagination parameters are ignored. When the email notification is enabled, the user receives an email whenever new articles match the search.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Save a search",
+                "operationId": "CreateSavedSearch",
+                "parameters": [
+                    {
+                        "description": "Request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.SavedSearch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.SavedSearch"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/saved-searches/unsubscribe": {
+            "get": {
+                "description": "This request is responsible for returning the page opened by the signed link included in each alert of a saved search, in which the user confirms that they want to disable the email alerts of the search. Opening the link does not disable the alerts, so that link previews and email scanners cannot disable them.",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Get the confirmation page to disable the email alerts of a saved search",
+                "operationId": "GetSavedSearchAlertUnsubscribePage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved search ID",
+                        "name": "savedSearchId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Signature of the unsubscribe link",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "This request is responsible for disabling the email alerts of a saved search through the signed link included in each alert, without requiring authentication. The search itself is kept. It is sent by the confirmation page, after which the user is redirected to the platform, and by the one-click unsubscribe of email clients (RFC 8058), which send the List-Unsubscribe=One-Click body.",
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Disable the email alerts of a saved search through the link sent by email",
+                "operationId": "UnsubscribeFromSavedSearchAlertByLink",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved search ID",
+                        "name": "savedSearchId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Signature of the unsubscribe link",
+                        "name": "signature",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "One-click unsubscribe of email clients. Accepted value: One-Click",
+                        "name": "List-Unsubscribe",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "303": {
+                        "description": "Successful request, redirecting to the platform"
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/saved-searches/{savedSearchId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for returning the details of a saved search of the user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Get the details of a saved search",
+                "operationId": "GetSavedSearchById",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved search ID",
+                        "name": "savedSearchId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.SavedSearch"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for renaming a saved search of the user, changing its query or enabling or disabling its email notification. Changing the query resets the number of new articles of the search.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Update a saved search",
+                "operationId": "UpdateSavedSearch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved search ID",
+                        "name": "savedSearchId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.SavedSearch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.SavedSearch"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for deleting a saved search of the user, which also stops its email notifications.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Delete a saved search",
+                "operationId": "DeleteSavedSearch",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved search ID",
+                        "name": "savedSearchId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/saved-searches/{savedSearchId}/articles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for listing the articles that match a saved search of the user. Running the search marks it as opened, resetting its number of new articles.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Run a saved search",
+                "operationId": "GetSavedSearchArticles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Saved search ID",
+                        "name": "savedSearchId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page number. By default, it is 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of articles returned per page. The default is 15 and the allowed values are between 1 and 100",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.ArticlePagination"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/search/suggest": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for listing the suggestions (deputies, parties, legislative bodies, propositions and articles) that match the text typed in the search box. Propositions can be searched by the acronym of their type, number and year (e.g. PL 1234/2024). The search ignores accents and tolerates small typos. The ID of each suggestion can be used directly in the filter parameters indicated by it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "List search suggestions",
+                "operationId": "GetSuggestions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Text typed in the search box. It must contain at least 2 characters",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/swagger.Suggestion"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/states": {
+            "get": {
+                "description": "This request is responsible for returning, for each federated unit (UF), the number of propositions drafted by its deputies and the number of deputies who drafted them. The federated unit considered is the one the deputy represented when the proposition was drafted. Propositions drafted by deputies from more than one federated unit are counted in each of them. The result can be returned as JSON or, with the format parameter, as a CSV file with one line per federated unit.",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Statistics"
+                ],
+                "summary": "Get the volume of propositions per federated unit",
+                "operationId": "GetFederatedUnitStatistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Date from which the propositions were submitted. Accepted format: YYYY-MM-DD",
+                        "name": "startDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date until which the propositions were submitted. Accepted format: YYYY-MM-DD",
+                        "name": "endDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Proposition type ID",
+                        "name": "propositionTypeId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Format of the response. Accepted values: json and csv. By default, it is json",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.FederatedUnitStatistics"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats/votes": {
+            "get": {
+                "description": "This request is responsible for returning the number of approved, rejected and undetermined votes (according to whether the voting was approved), grouped by the legislative body responsible for the voting, the type of the main proposition of the voting and the month in which the result was announced. Votes without a main proposition are grouped without a proposition type. The result can be returned as JSON or, with the format parameter, as a CSV file with one line per group.",
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Statistics"
+                ],
+                "summary": "Get the aggregated outcomes of the votes",
+                "operationId": "GetVotingStatistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Date from which the voting results were announced. Accepted format: YYYY-MM-DD",
+                        "name": "votingStartDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date until which the voting results were announced. Accepted format: YYYY-MM-DD",
+                        "name": "votingEndDate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Voting result. Accepted values: approved, rejected and undetermined",
+                        "name": "votingResult",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the legislative body responsible for the voting",
+                        "name": "votingLegislativeBodyId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Format of the response. Accepted values: json and csv. By default, it is json",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.VotingStatistics"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/activate-account": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for activating the user's account, proving that the email address provided during registration really exists and belongs to the user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Activate user account",
+                "operationId": "ActivateAccount",
+                "parameters": [
+                    {
+                        "description": "Request body",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UserAccountActivation"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/swagger.User"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "409": {
+                        "description": "Some of the data provided is conflicting",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/calendar": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for returning the URL of the private calendar of the user, which contains the events of the legislative bodies they follow.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get the private calendar of the user",
+                "operationId": "GetPrivateCalendar",
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.PrivateCalendar"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for creating the private calendar of the user, with the events of the legislative bodies they follow. If the user already has a private calendar, a new URL is generated and the previous one stops working.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Create the private calendar of the user",
+                "operationId": "CreatePrivateCalendar",
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.PrivateCalendar"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for deleting the private calendar of the user, after which its URL stops working.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Delete the private calendar of the user",
+                "operationId": "DeletePrivateCalendar",
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/email-digest": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for returning the subscription of the user to the email digest with the new articles about the resources they follow.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Get the email digest subscription of the user",
+                "operationId": "GetEmailDigest",
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.EmailDigest"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for subscribing the user to the daily or weekly email digest with the new articles about the resources they follow or, if the user is already subscribed, changing the frequency of the digest.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Subscribe to the email digest",
+                "operationId": "SubscribeToEmailDigest",
+                "parameters": [
+                    {
+                        "description": "Request body. Accepted values for the frequency: daily and weekly",
+                        "name": "requestBody",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.EmailDigest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.EmailDigest"
+                        }
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for unsubscribing the user from the email digest.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Unsubscribe from the email digest",
+                "operationId": "UnsubscribeFromEmailDigest",
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/following": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for listing the deputies, parties, external authors, proposition types, legislative bodies and propositions followed by the user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "List the resources followed by the user",
+                "operationId": "GetFollows",
+                "responses": {
+                    "200": {
+                        "description": "Successful request",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/swagger.Follow"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/following/{resourceType}/{resourceId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for following a deputy, party, external author, proposition type, legislative body or proposition, so that its articles are listed in the feed of the user. Propositions are identified by the ID of their article and their new votes, events and newsletter mentions generate notifications.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Follow a resource",
+                "operationId": "FollowResource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resource type. Accepted values: deputy, party, external_author, proposition_type, legislative_body and proposition",
+                        "name": "resourceType",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource ID",
+                        "name": "resourceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for unfollowing a deputy, party, external author, proposition type, legislative body or proposition followed by the user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Unfollow a resource",
+                "operationId": "UnfollowResource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Resource type. Accepted values: deputy, party, external_author, proposition_type, legislative_body and proposition",
+                        "name": "resourceType",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Resource ID",
+                        "name": "resourceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Requested resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "422": {
+                        "description": "Some of the data provided is invalid",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/resend-activation-email": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "This request is responsible for resending the user account activation email. Each time it is sent, a new code is generated, invalidating the previous code.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Resend user account activation email",
+                "operationId": "ResendActivationEmail",
+                "responses": {
+                    "204": {
+                        "description": "Successful request"
+                    },
+                    "400": {
+                        "description": "Badly formatted request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "403": {
+                        "description": "Access denied",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "409": {
+                        "description": "Some of the data provided is conflicting",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "An unexpected error occurred while processing the request",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    },
+                    "503": {
+                        "description": "Some of the services/resources are temporarily unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/swagger.HttpError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "request.ArticleRating": {
+            "type": "object",
+            "properties": {
+                "article_id": {
+                    "type": "string",
+                    "example": "b27947d6-3224-4479-8da4-7917ae16b34d"
+                },
+                "rating": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "request.ArticleViewLater": {
+            "type": "object",
+            "properties": {
+                "article_id": {
+                    "type": "string",
+                    "example": "b27947d6-3224-4479-8da4-7917ae16b34d"
+                },
+                "view_later": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "request.EmailDigest": {
+            "type": "object",
+            "properties": {
+                "frequency": {
+                    "type": "string",
+                    "example": "weekly"
+                }
+            }
+        },
+        "request.NotificationPreference": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "request.Rating": {
+            "type": "object",
+            "properties": {
+                "rating": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "request.RatingBatch": {
+            "type": "object",
+            "properties": {
+                "articles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/request.ArticleRating"
+                    }
+                }
+            }
+        },
+        "request.ReadingList": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Proposições e votações sobre a reforma tributária"
+                },
+                "is_public": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Reforma tributária"
+                }
+            }
+        },
+        "request.ReadingListItem": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "type": "string",
+                    "example": "Rever antes da votação no plenário"
+                }
+            }
+        },
+        "request.ReadingListOrder": {
+            "type": "object",
+            "properties": {
+                "article_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "b27947d6-3224-4479-8da4-7917ae16b34d"
+                    ]
+                }
+            }
+        },
+        "request.RefreshTokens": {
+            "type": "object",
+            "properties": {
+                "refresh_token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJ1c2VybmFtZSI6InByb2YiL..."
+                }
+            }
+        },
+        "request.SavedSearch": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Reforma tributária aprovada"
+                },
+                "notify_by_email": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "query": {
+                    "type": "string",
+                    "example": "content=reforma%20tribut%C3%A1ria\u0026votingResult=approved"
+                }
+            }
+        },
+        "request.SignIn": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "example@email.com"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "luc@ssantos123"
+                }
+            }
+        },
+        "request.SignUp": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "example@email.com"
+                },
+                "first_name": {
+                    "type": "string",
+                    "example": "Lucas"
+                },
+                "last_name": {
+                    "type": "string",
+                    "example": "Santos"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "luc@ssantos123"
+                }
+            }
+        },
+        "request.UserAccountActivation": {
+            "type": "object",
+            "properties": {
+                "activation_code": {
+                    "type": "string",
+                    "example": "NJBJQ3"
+                }
+            }
+        },
+        "request.ViewLater": {
+            "type": "object",
+            "properties": {
+                "view_later": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "request.ViewLaterBatch": {
+            "type": "object",
+            "properties": {
+                "articles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/request.ArticleViewLater"
+                    }
+                }
+            }
+        },
+        "swagger.AgendaItemRegime": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Matéria Sobre a Mesa"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "667084f2-eddb-495b-b50f-8f60a2949a92"
+                }
+            }
+        },
+        "swagger.Article": {
+            "type": "object",
+            "properties": {
+                "average_rating": {
+                    "type": "number",
+                    "example": 3.5
+                },
+                "content": {
+                    "type": "string",
+                    "example": "Foi sancionado o projeto de lei que altera a Lei n° 11.033 para prorrogar o Regime Tributário..."
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-05T20:25:19.98031Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "b27947d6-3224-4479-8da4-7917ae16b34d"
+                },
+                "multimedia_description": {
+                    "type": "string",
+                    "example": "A imagem retrata uma pequena cidade costeira afetada por uma forte chuva..."
+                },
+                "multimedia_url": {
+                    "type": "string",
+                    "example": "https://image.url.com/article/b27947d6-3224-4479-8da4-7917ae16b34d/image.png"
+                },
+                "number_of_ratings": {
+                    "type": "integer",
+                    "example": 452
+                },
+                "situation": {
+                    "$ref": "#/definitions/swagger.ArticleSituation"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Novo projeto de lei impulsiona crescimento do setor portuário até 2028"
+                },
+                "type": {
+                    "$ref": "#/definitions/swagger.ArticleTypeWithSpecificType"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-05T20:25:19.98031Z"
+                },
+                "user_rating": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "view_later": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "swagger.ArticleOperation": {
+            "type": "object",
+            "properties": {
+                "article_id": {
+                    "type": "string",
+                    "example": "b27947d6-3224-4479-8da4-7917ae16b34d"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Article not found"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "not_found"
+                }
+            }
+        },
+        "swagger.ArticlePagination": {
+            "type": "object",
+            "properties": {
+                "corrected_query": {
+                    "type": "string",
+                    "example": "reforma tributária"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.Article"
+                    }
+                },
+                "items_per_page": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "suggestions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "reforma tributária",
+                        "reforma trabalhista"
+                    ]
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 4562
+                }
+            }
+        },
+        "swagger.ArticleSituation": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string",
+                    "example": "#D2D2D2"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Em Andamento"
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2023-02-15T17:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "16f6b271-fc96-4143-972c-0a2ac149dc55"
+                },
+                "is_approved": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "result": {
+                    "type": "string",
+                    "example": "Aprovado o Substitutivo ao Projeto de Lei nº 1..."
+                },
+                "result_announced_at": {
+                    "type": "string",
+                    "example": "2023-01-18T20:17:32Z"
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2023-02-12T10:00:00Z"
+                }
+            }
+        },
+        "swagger.ArticleSpecificType": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string",
+                    "example": "#C4170C"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Projeto de Lei"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "111c1a6d-d061-40b2-ad39-ec714f05c81c"
+                }
+            }
+        },
+        "swagger.ArticleType": {
+            "type": "object",
+            "properties": {
+                "codes": {
+                    "type": "string",
+                    "example": "proposition"
+                },
+                "color": {
+                    "type": "string",
+                    "example": "#06D13C"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Proposições"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "560206f4-7360-4e21-8e45-33026f7e0953"
+                }
+            }
+        },
+        "swagger.ArticleTypeWithArticles": {
+            "type": "object",
+            "properties": {
+                "articles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.Article"
+                    }
+                },
+                "color": {
+                    "type": "string",
+                    "example": "#C4170C"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Projeto de Lei"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "111c1a6d-d061-40b2-ad39-ec714f05c81c"
+                }
+            }
+        },
+        "swagger.ArticleTypeWithSpecificType": {
+            "type": "object",
+            "properties": {
+                "codes": {
+                    "type": "string",
+                    "example": "proposition"
+                },
+                "color": {
+                    "type": "string",
+                    "example": "#06D13C"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Proposições"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "560206f4-7360-4e21-8e45-33026f7e0953"
+                },
+                "specific_type": {
+                    "$ref": "#/definitions/swagger.ArticleSpecificType"
+                }
+            }
+        },
+        "swagger.ArticleTypeWithSpecificTypes": {
+            "type": "object",
+            "properties": {
+                "codes": {
+                    "type": "string",
+                    "example": "proposition"
+                },
+                "color": {
+                    "type": "string",
+                    "example": "#06D13C"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Proposições"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "560206f4-7360-4e21-8e45-33026f7e0953"
+                },
+                "specific_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.ArticleTypeWithArticles"
+                    }
+                }
+            }
+        },
+        "swagger.CoAuthorshipEdge": {
+            "type": "object",
+            "properties": {
+                "source": {
+                    "type": "string",
+                    "example": "a4b04454-f426-44d2-843e-1331510b19ad"
+                },
+                "target": {
+                    "type": "string",
+                    "example": "c7d1e2f3-4a5b-4c6d-8e9f-0a1b2c3d4e5f"
+                },
+                "weight": {
+                    "type": "integer",
+                    "example": 5
+                }
+            }
+        },
+        "swagger.CoAuthorshipGraph": {
+            "type": "object",
+            "properties": {
+                "edges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.CoAuthorshipEdge"
+                    }
+                },
+                "nodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.CoAuthorshipNode"
+                    }
+                }
+            }
+        },
+        "swagger.CoAuthorshipNode": {
+            "type": "object",
+            "properties": {
+                "external_author_type": {
+                    "type": "string",
+                    "example": "Órgão do Poder Executivo"
+                },
+                "federated_unit": {
+                    "type": "string",
+                    "example": "AL"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a4b04454-f426-44d2-843e-1331510b19ad"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "José do Povo"
+                },
+                "number_of_propositions": {
+                    "type": "integer",
+                    "example": 37
+                },
+                "party_acronym": {
+                    "type": "string",
+                    "example": "PVNC"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "deputy"
+                }
+            }
+        },
+        "swagger.ComparedDeputy": {
+            "type": "object",
+            "properties": {
+                "changed_party": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "electoral_name": {
+                    "type": "string",
+                    "example": "José do Povo"
+                },
+                "federated_unit": {
+                    "type": "string",
+                    "example": "AL"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a4b04454-f426-44d2-843e-1331510b19ad"
+                },
+                "image_description": {
+                    "type": "string",
+                    "example": "Foto do(a) deputado(a) federal José do Povo (PVNC-AL)"
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "https://www.camara.leg.br/internet/deputado/bandep/87624.jpg"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "José da Silva Santos"
+                },
+                "party": {
+                    "$ref": "#/definitions/swagger.Party"
+                },
+                "previous_federated_unit": {
+                    "type": "string",
+                    "example": "SP"
+                },
+                "previous_party": {
+                    "$ref": "#/definitions/swagger.Party"
+                },
+                "statistics": {
+                    "$ref": "#/definitions/swagger.DeputyStatistics"
+                }
+            }
+        },
+        "swagger.Deputy": {
+            "type": "object",
+            "properties": {
+                "electoral_name": {
+                    "type": "string",
+                    "example": "José do Povo"
+                },
+                "federated_unit": {
+                    "type": "string",
+                    "example": "AL"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a4b04454-f426-44d2-843e-1331510b19ad"
+                },
+                "image_description": {
+                    "type": "string",
+                    "example": "Foto do(a) deputado(a) federal José do Povo (PVNC-AL)"
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "https://www.camara.leg.br/internet/deputado/bandep/87624.jpg"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "José da Silva Santos"
+                },
+                "party": {
+                    "$ref": "#/definitions/swagger.Party"
+                },
+                "previous_federated_unit": {
+                    "type": "string",
+                    "example": "SP"
+                },
+                "previous_party": {
+                    "$ref": "#/definitions/swagger.Party"
+                }
+            }
+        },
+        "swagger.DeputyComparison": {
+            "type": "object",
+            "properties": {
+                "deputies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.ComparedDeputy"
+                    }
+                },
+                "pairs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.DeputyPair"
+                    }
+                }
+            }
+        },
+        "swagger.DeputyPair": {
+            "type": "object",
+            "properties": {
+                "first_deputy_id": {
+                    "type": "string",
+                    "example": "a4b04454-f426-44d2-843e-1331510b19ad"
+                },
+                "number_of_co_authored_propositions": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "number_of_common_votes": {
+                    "type": "integer",
+                    "example": 340
+                },
+                "number_of_matching_votes": {
+                    "type": "integer",
+                    "example": 289
+                },
+                "second_deputy_id": {
+                    "type": "string",
+                    "example": "c7d1e2f3-4a5b-4c6d-8e9f-0a1b2c3d4e5f"
+                },
+                "vote_agreement_rate": {
+                    "type": "number",
+                    "example": 0.85
+                }
+            }
+        },
+        "swagger.DeputyProfile": {
+            "type": "object",
+            "properties": {
+                "electoral_name": {
+                    "type": "string",
+                    "example": "José do Povo"
+                },
+                "federated_unit": {
+                    "type": "string",
+                    "example": "AL"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a4b04454-f426-44d2-843e-1331510b19ad"
+                },
+                "image_description": {
+                    "type": "string",
+                    "example": "Foto do(a) deputado(a) federal José do Povo (PVNC-AL)"
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "https://www.camara.leg.br/internet/deputado/bandep/87624.jpg"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "José da Silva Santos"
+                },
+                "party": {
+                    "$ref": "#/definitions/swagger.Party"
+                },
+                "previous_federated_unit": {
+                    "type": "string",
+                    "example": "SP"
+                },
+                "previous_party": {
+                    "$ref": "#/definitions/swagger.Party"
+                },
+                "propositions": {
+                    "$ref": "#/definitions/swagger.ArticlePagination"
+                },
+                "rapporteurships": {
+                    "$ref": "#/definitions/swagger.RapporteurshipPagination"
+                },
+                "statistics": {
+                    "$ref": "#/definitions/swagger.DeputyStatistics"
+                }
+            }
+        },
+        "swagger.DeputyResource": {
+            "type": "object",
+            "properties": {
+                "electoral_name": {
+                    "type": "string",
+                    "example": "José do Povo"
+                },
+                "federated_unit": {
+                    "type": "string",
+                    "example": "AL"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a4b04454-f426-44d2-843e-1331510b19ad"
+                },
+                "image_description": {
+                    "type": "string",
+                    "example": "Foto do(a) deputado(a) federal José do Povo (PVNC-AL)"
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "https://www.camara.leg.br/internet/deputado/bandep/87624.jpg"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "José da Silva Santos"
+                },
+                "party": {
+                    "$ref": "#/definitions/swagger.Party"
+                }
+            }
+        },
+        "swagger.DeputyStatistics": {
+            "type": "object",
+            "properties": {
+                "approval_rate": {
+                    "type": "number",
+                    "example": 0.75
+                },
+                "number_of_approved_propositions": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "number_of_propositions": {
+                    "type": "integer",
+                    "example": 57
+                },
+                "number_of_rapporteurships": {
+                    "type": "integer",
+                    "example": 27
+                },
+                "number_of_voted_propositions": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "proposition_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.PropositionTypeCount"
+                    }
+                }
+            }
+        },
+        "swagger.DeputyVote": {
+            "type": "object",
+            "properties": {
+                "deputy": {
+                    "$ref": "#/definitions/swagger.Deputy"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "3c1e2b7a-8d4f-4a6e-9b2c-5d7e8f9a0b1c"
+                },
+                "vote": {
+                    "type": "string",
+                    "example": "yes"
+                }
+            }
+        },
+        "swagger.EmailDigest": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-05T20:25:19.98031Z"
+                },
+                "frequency": {
+                    "type": "string",
+                    "example": "weekly"
+                },
+                "last_sent_at": {
+                    "type": "string",
+                    "example": "2024-01-05T20:25:19.98031Z"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-05T20:25:19.98031Z"
+                }
+            }
+        },
+        "swagger.EventAgendaItem": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "a1b5ae2e-b3e7-443d-b87d-dca789224578"
+                },
+                "proposition": {
+                    "$ref": "#/definitions/swagger.Article"
+                },
+                "rapporteur": {
+                    "$ref": "#/definitions/swagger.Deputy"
+                },
+                "regime": {
+                    "$ref": "#/definitions/swagger.AgendaItemRegime"
+                },
+                "related_proposition": {
+                    "$ref": "#/definitions/swagger.Article"
+                },
+                "situation": {
+                    "type": "string",
+                    "example": "Discussão em turno único. Encerrada a discussão..."
+                },
+                "title": {
+                    "type": "string",
+                    "example": "PL 132/4567"
+                },
+                "topic": {
+                    "type": "string",
+                    "example": "Discussão"
+                },
+                "voting": {
+                    "$ref": "#/definitions/swagger.Article"
+                }
+            }
+        },
+        "swagger.EventArticle": {
+            "type": "object",
+            "properties": {
+                "agenda_items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.EventAgendaItem"
+                    }
+                },
+                "average_rating": {
+                    "type": "number",
+                    "example": 2.5
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2023-08-22T10:39:14.465814Z"
+                },
+                "description_content": {
+                    "type": "string",
+                    "example": "Discussão e votação de propostas legislativas..."
+                },
+                "ends_at": {
+                    "type": "string",
+                    "example": "2023-08-24T18:00:00Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "e36492f7-5305-4333-9aea-05dcf3689693"
+                },
+                "is_internal": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "legislative_bodies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.LegislativeBody"
+                    }
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Plenário da Câmara dos Deputados"
+                },
+                "newsletter": {
+                    "$ref": "#/definitions/swagger.Article"
+                },
+                "number_of_ratings": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "requirements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.Article"
+                    }
+                },
+                "situation": {
+                    "$ref": "#/definitions/swagger.EventSituation"
+                },
+                "starts_at": {
+                    "type": "string",
+                    "example": "2023-08-24T13:00:00Z"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "TCU Pede Ação Urgente contra Dívida Pública..."
+                },
+                "type": {
+                    "$ref": "#/definitions/swagger.ArticleTypeWithSpecificType"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-08-22T10:39:14.465814Z"
+                },
+                "user_rating": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "video_url": {
+                    "type": "string",
+                    "example": "https://video.url.com/article/e36492f7-5305-4333-9aea-05dcf3689693/video.mp4"
+                },
+                "view_later": {
+                    "type": "boolean",
+                    "example": false
+                }
             }
         },
-        "request.UserAccountActivation": {
+        "swagger.EventSituation": {
             "type": "object",
             "properties": {
-                "activation_code": {
+                "color": {
                     "type": "string",
-                    "example": "NJBJQ3"
+                    "example": "#0047AB"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Encerrado"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "0f7f6406-bbce-4a26-aa02-c1694bda5e72"
                 }
             }
         },
-        "request.ViewLater": {
+        "swagger.EventType": {
             "type": "object",
             "properties": {
-                "view_later": {
-                    "type": "boolean",
-                    "example": true
+                "color": {
+                    "type": "string",
+                    "example": "#006767"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Reunião Deliberativa"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "2371ffd1-67d0-40b2-b6ee-dde3948cbb80"
                 }
             }
         },
-        "swagger.AgendaItemRegime": {
+        "swagger.ExternalAuthor": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "9d543e6f-20e3-4895-83e4-26b6a976580e"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Organização Você na Câmara"
+                },
+                "type": {
+                    "$ref": "#/definitions/swagger.ExternalAuthorType"
+                }
+            }
+        },
+        "swagger.ExternalAuthorType": {
             "type": "object",
             "properties": {
                 "description": {
                     "type": "string",
-                    "example": "Matéria Sobre a Mesa"
+                    "example": "Órgão Do Poder Legislativo"
                 },
                 "id": {
                     "type": "string",
-                    "example": "667084f2-eddb-495b-b50f-8f60a2949a92"
+                    "example": "344b3941-69fb-4715-a7e5-6afc21cd3e48"
                 }
             }
         },
-        "swagger.Article": {
+        "swagger.FederatedUnitActivity": {
             "type": "object",
             "properties": {
-                "average_rating": {
-                    "type": "number",
-                    "example": 3.5
-                },
-                "content": {
+                "federated_unit": {
                     "type": "string",
-                    "example": "Foi sancionado o projeto de lei que altera a Lei n° 11.033 para prorrogar o Regime Tributário..."
+                    "example": "MG"
+                },
+                "number_of_deputies": {
+                    "type": "integer",
+                    "example": 53
                 },
+                "number_of_propositions": {
+                    "type": "integer",
+                    "example": 1375
+                }
+            }
+        },
+        "swagger.FederatedUnitStatistics": {
+            "type": "object",
+            "properties": {
+                "federated_units": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FederatedUnitActivity"
+                    }
+                }
+            }
+        },
+        "swagger.Follow": {
+            "type": "object",
+            "properties": {
                 "created_at": {
                     "type": "string",
                     "example": "2024-01-05T20:25:19.98031Z"
                 },
-                "id": {
+                "resource_id": {
                     "type": "string",
-                    "example": "b27947d6-3224-4479-8da4-7917ae16b34d"
+                    "example": "a4b04454-f426-44d2-843e-1331510b19ad"
                 },
-                "multimedia_description": {
+                "resource_name": {
                     "type": "string",
-                    "example": "A imagem retrata uma pequena cidade costeira afetada por uma forte chuva..."
+                    "example": "José do Povo"
                 },
-                "multimedia_url": {
+                "resource_type": {
                     "type": "string",
-                    "example": "https://image.url.com/article/b27947d6-3224-4479-8da4-7917ae16b34d/image.png"
+                    "example": "deputy"
+                }
+            }
+        },
+        "swagger.FrequentRapporteur": {
+            "type": "object",
+            "properties": {
+                "deputy": {
+                    "$ref": "#/definitions/swagger.Deputy"
                 },
-                "number_of_ratings": {
+                "number_of_rapporteurships": {
                     "type": "integer",
-                    "example": 452
-                },
-                "situation": {
-                    "$ref": "#/definitions/swagger.ArticleSituation"
-                },
-                "title": {
+                    "example": 14
+                }
+            }
+        },
+        "swagger.HttpError": {
+            "type": "object",
+            "properties": {
+                "message": {
                     "type": "string",
-                    "example": "Novo projeto de lei impulsiona crescimento do setor portuário até 2028"
-                },
-                "type": {
-                    "$ref": "#/definitions/swagger.ArticleTypeWithSpecificType"
+                    "example": "Something went wrong..."
+                }
+            }
+        },
+        "swagger.LegislativeBody": {
+            "type": "object",
+            "properties": {
+                "acronym": {
+                    "type": "string",
+                    "example": "2009"
                 },
-                "updated_at": {
+                "id": {
                     "type": "string",
-                    "example": "2024-01-05T20:25:19.98031Z"
+                    "example": "31991060-fa3d-4647-aea5-b17b41de6a36"
                 },
-                "user_rating": {
-                    "type": "integer",
-                    "example": 3
+                "name": {
+                    "type": "string",
+                    "example": "Comissão de Educação"
                 },
-                "view_later": {
-                    "type": "boolean",
-                    "example": true
+                "type": {
+                    "$ref": "#/definitions/swagger.LegislativeBodyType"
                 }
             }
         },
-        "swagger.ArticlePagination": {
+        "swagger.LegislativeBodyProfile": {
             "type": "object",
             "properties": {
-                "data": {
+                "acronym": {
+                    "type": "string",
+                    "example": "CE"
+                },
+                "frequent_rapporteurs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.FrequentRapporteur"
+                    }
+                },
+                "id": {
+                    "type": "string",
+                    "example": "31991060-fa3d-4647-aea5-b17b41de6a36"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Comissão de Educação"
+                },
+                "recent_events": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/swagger.Article"
                     }
                 },
-                "items_per_page": {
+                "recent_votes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.Article"
+                    }
+                },
+                "type": {
+                    "$ref": "#/definitions/swagger.LegislativeBodyType"
+                },
+                "upcoming_events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.Article"
+                    }
+                }
+            }
+        },
+        "swagger.LegislativeBodyType": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Comissão Mista Permanente"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "3440489a-d787-447f-80dd-51c0c577f07f"
+                }
+            }
+        },
+        "swagger.MonthlyActivity": {
+            "type": "object",
+            "properties": {
+                "month": {
+                    "type": "string",
+                    "example": "2024-05"
+                },
+                "number_of_approved_propositions": {
                     "type": "integer",
-                    "example": 15
+                    "example": 4
                 },
-                "page": {
+                "number_of_propositions": {
+                    "type": "integer",
+                    "example": 38
+                },
+                "number_of_rejected_propositions": {
                     "type": "integer",
                     "example": 1
                 },
-                "total": {
+                "number_of_voted_propositions": {
                     "type": "integer",
-                    "example": 4562
+                    "example": 5
                 }
             }
         },
-        "swagger.ArticleSituation": {
+        "swagger.NewsletterArticle": {
             "type": "object",
             "properties": {
-                "color": {
-                    "type": "string",
-                    "example": "#D2D2D2"
+                "average_rating": {
+                    "type": "number",
+                    "example": 4.5
                 },
-                "description": {
+                "content": {
                     "type": "string",
-                    "example": "Em Andamento"
+                    "example": "O presidente enviou ao Congresso Nacional um projeto de lei que permite a concessão de descontos fiscais..."
                 },
-                "ends_at": {
+                "created_at": {
                     "type": "string",
-                    "example": "2023-02-15T17:00:00Z"
+                    "example": "2023-12-24T19:15:22.90905Z"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.Article"
+                    }
                 },
                 "id": {
                     "type": "string",
-                    "example": "16f6b271-fc96-4143-972c-0a2ac149dc55"
+                    "example": "7963a6dd-f0b8-4065-8e56-6d2a79626db7"
+                },
+                "number_of_ratings": {
+                    "type": "integer",
+                    "example": 743
+                },
+                "propositions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.Article"
+                    }
+                },
+                "reference_date": {
+                    "type": "string",
+                    "example": "2023-12-23T16:34:14.441877Z"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Boletim do dia 26/02/2024"
+                },
+                "type": {
+                    "$ref": "#/definitions/swagger.ArticleType"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2023-12-24T19:15:22.90905Z"
                 },
-                "is_approved": {
+                "user_rating": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "view_later": {
                     "type": "boolean",
                     "example": true
                 },
-                "result": {
-                    "type": "string",
-                    "example": "Aprovado o Substitutivo ao Projeto de Lei nº 1..."
-                },
-                "result_announced_at": {
-                    "type": "string",
-                    "example": "2023-01-18T20:17:32Z"
-                },
-                "starts_at": {
-                    "type": "string",
-                    "example": "2023-02-12T10:00:00Z"
+                "votes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.Article"
+                    }
                 }
             }
         },
-        "swagger.ArticleSpecificType": {
+        "swagger.Notification": {
             "type": "object",
             "properties": {
-                "color": {
+                "article": {
+                    "$ref": "#/definitions/swagger.Article"
+                },
+                "created_at": {
                     "type": "string",
-                    "example": "#C4170C"
+                    "example": "2024-01-05T20:25:19.98031Z"
                 },
                 "description": {
                     "type": "string",
-                    "example": "Projeto de Lei"
+                    "example": "A proposição \"Altera a Lei nº 9.503, de 23 de setembro de 1997\" teve uma nova votação"
                 },
                 "id": {
                     "type": "string",
-                    "example": "111c1a6d-d061-40b2-ad39-ec714f05c81c"
-                }
-            }
-        },
-        "swagger.ArticleType": {
-            "type": "object",
-            "properties": {
-                "codes": {
+                    "example": "1b5c7f7e-4b0e-4e4a-9c43-2d0c6f3a8e1d"
+                },
+                "is_read": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "proposition_id": {
                     "type": "string",
-                    "example": "proposition"
+                    "example": "e9b0f6b5-5c63-4b0e-9d43-1b2d3e2a8f1c"
                 },
-                "color": {
+                "proposition_title": {
                     "type": "string",
-                    "example": "#06D13C"
+                    "example": "Altera a Lei nº 9.503, de 23 de setembro de 1997"
                 },
-                "description": {
+                "read_at": {
                     "type": "string",
-                    "example": "Proposições"
+                    "example": "2024-01-05T20:25:19.98031Z"
                 },
-                "id": {
+                "type": {
                     "type": "string",
-                    "example": "560206f4-7360-4e21-8e45-33026f7e0953"
+                    "example": "proposition_voting"
                 }
             }
         },
-        "swagger.ArticleTypeWithArticles": {
+        "swagger.NotificationPagination": {
             "type": "object",
             "properties": {
-                "articles": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/swagger.Article"
+                        "$ref": "#/definitions/swagger.Notification"
                     }
                 },
-                "color": {
-                    "type": "string",
-                    "example": "#C4170C"
-                },
-                "description": {
-                    "type": "string",
-                    "example": "Projeto de Lei"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "111c1a6d-d061-40b2-ad39-ec714f05c81c"
-                }
-            }
-        },
-        "swagger.ArticleTypeWithSpecificType": {
-            "type": "object",
-            "properties": {
-                "codes": {
-                    "type": "string",
-                    "example": "proposition"
-                },
-                "color": {
-                    "type": "string",
-                    "example": "#06D13C"
+                "items_per_page": {
+                    "type": "integer",
+                    "example": 15
                 },
-                "description": {
-                    "type": "string",
-                    "example": "Proposições"
+                "page": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "id": {
-                    "type": "string",
-                    "example": "560206f4-7360-4e21-8e45-33026f7e0953"
+                "total": {
+                    "type": "integer",
+                    "example": 42
                 },
-                "specific_type": {
-                    "$ref": "#/definitions/swagger.ArticleSpecificType"
+                "unread_count": {
+                    "type": "integer",
+                    "example": 3
                 }
             }
         },
-        "swagger.ArticleTypeWithSpecificTypes": {
+        "swagger.NotificationPreference": {
             "type": "object",
             "properties": {
-                "codes": {
-                    "type": "string",
-                    "example": "proposition"
-                },
-                "color": {
-                    "type": "string",
-                    "example": "#06D13C"
-                },
-                "description": {
-                    "type": "string",
-                    "example": "Proposições"
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "id": {
+                "type": {
                     "type": "string",
-                    "example": "560206f4-7360-4e21-8e45-33026f7e0953"
-                },
-                "specific_types": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagger.ArticleTypeWithArticles"
-                    }
+                    "example": "proposition_voting"
                 }
             }
         },
-        "swagger.Deputy": {
+        "swagger.Party": {
             "type": "object",
             "properties": {
-                "electoral_name": {
-                    "type": "string",
-                    "example": "José do Povo"
-                },
-                "federated_unit": {
+                "acronym": {
                     "type": "string",
-                    "example": "AL"
+                    "example": "PVNC"
                 },
                 "id": {
                     "type": "string",
-                    "example": "a4b04454-f426-44d2-843e-1331510b19ad"
+                    "example": "9bb4028f-6fa8-493a-9fe8-e3bbd341c794"
                 },
                 "image_description": {
                     "type": "string",
-                    "example": "Foto do(a) deputado(a) federal José do Povo (PVNC-AL)"
+                    "example": "Logo do Partido Você na Câmara (PVNC)"
                 },
                 "image_url": {
                     "type": "string",
-                    "example": "https://www.camara.leg.br/internet/deputado/bandep/87624.jpg"
+                    "example": "https://www.camara.leg.br/internet/Deputado/img/partidos/VNC.gif"
                 },
                 "name": {
                     "type": "string",
-                    "example": "José da Silva Santos"
-                },
-                "party": {
-                    "$ref": "#/definitions/swagger.Party"
-                },
-                "previous_federated_unit": {
+                    "example": "Partido Você na Câmara"
+                }
+            }
+        },
+        "swagger.PartyOrientation": {
+            "type": "object",
+            "properties": {
+                "orientation": {
                     "type": "string",
-                    "example": "SP"
+                    "example": "yes"
                 },
-                "previous_party": {
+                "party": {
                     "$ref": "#/definitions/swagger.Party"
                 }
             }
         },
-        "swagger.DeputyResource": {
+        "swagger.PartyProfile": {
             "type": "object",
             "properties": {
-                "electoral_name": {
+                "acronym": {
                     "type": "string",
-                    "example": "José do Povo"
+                    "example": "PVNC"
                 },
-                "federated_unit": {
-                    "type": "string",
-                    "example": "AL"
+                "deputies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.Deputy"
+                    }
                 },
                 "id": {
                     "type": "string",
-                    "example": "a4b04454-f426-44d2-843e-1331510b19ad"
+                    "example": "9bb4028f-6fa8-493a-9fe8-e3bbd341c794"
                 },
                 "image_description": {
                     "type": "string",
-                    "example": "Foto do(a) deputado(a) federal José do Povo (PVNC-AL)"
+                    "example": "Logo do Partido Você na Câmara (PVNC)"
                 },
                 "image_url": {
                     "type": "string",
-                    "example": "https://www.camara.leg.br/internet/deputado/bandep/87624.jpg"
+                    "example": "https://www.camara.leg.br/internet/Deputado/img/partidos/VNC.gif"
                 },
                 "name": {
                     "type": "string",
-                    "example": "José da Silva Santos"
+                    "example": "Partido Você na Câmara"
                 },
-                "party": {
-                    "$ref": "#/definitions/swagger.Party"
+                "propositions": {
+                    "$ref": "#/definitions/swagger.ArticlePagination"
+                },
+                "statistics": {
+                    "$ref": "#/definitions/swagger.PartyStatistics"
                 }
             }
         },
-        "swagger.EventAgendaItem": {
+        "swagger.PartyStatistics": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string",
-                    "example": "a1b5ae2e-b3e7-443d-b87d-dca789224578"
+                "approval_rate": {
+                    "type": "number",
+                    "example": 0.8
                 },
-                "proposition": {
-                    "$ref": "#/definitions/swagger.Article"
+                "monthly_activity": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.MonthlyActivity"
+                    }
                 },
-                "rapporteur": {
-                    "$ref": "#/definitions/swagger.Deputy"
+                "number_of_approved_propositions": {
+                    "type": "integer",
+                    "example": 96
                 },
-                "regime": {
-                    "$ref": "#/definitions/swagger.AgendaItemRegime"
+                "number_of_deputies": {
+                    "type": "integer",
+                    "example": 68
                 },
-                "related_proposition": {
-                    "$ref": "#/definitions/swagger.Article"
+                "number_of_propositions": {
+                    "type": "integer",
+                    "example": 1254
                 },
-                "situation": {
-                    "type": "string",
-                    "example": "Discussão em turno único. Encerrada a discussão..."
+                "number_of_rejected_propositions": {
+                    "type": "integer",
+                    "example": 24
                 },
-                "title": {
-                    "type": "string",
-                    "example": "PL 132/4567"
+                "number_of_voted_propositions": {
+                    "type": "integer",
+                    "example": 120
                 },
-                "topic": {
-                    "type": "string",
-                    "example": "Discussão"
+                "proposition_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.PropositionTypeCount"
+                    }
                 },
-                "voting": {
-                    "$ref": "#/definitions/swagger.Article"
+                "rejection_rate": {
+                    "type": "number",
+                    "example": 0.2
                 }
             }
         },
-        "swagger.EventArticle": {
+        "swagger.PrivateCalendar": {
             "type": "object",
             "properties": {
-                "agenda_items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagger.EventAgendaItem"
-                    }
+                "url": {
+                    "type": "string",
+                    "example": "https://api.vocenacamara.com.br/api/v1/calendar/private/5f0d3c1a9b8e4d2fa6c7b1e0d9f8a7b6/events.ics"
                 },
+                "webcal_url": {
+                    "type": "string",
+                    "example": "webcal://api.vocenacamara.com.br/api/v1/calendar/private/5f0d3c1a9b8e4d2fa6c7b1e0d9f8a7b6/events.ics"
+                }
+            }
+        },
+        "swagger.PropositionArticle": {
+            "type": "object",
+            "properties": {
                 "average_rating": {
                     "type": "number",
                     "example": 2.5
                 },
-                "created_at": {
-                    "type": "string",
-                    "example": "2023-08-22T10:39:14.465814Z"
-                },
-                "description_content": {
+                "content": {
                     "type": "string",
-                    "example": "Discussão e votação de propostas legislativas..."
+                    "example": "O presente requerimento foi elaborado pelos deputados..."
                 },
-                "ends_at": {
+                "created_at": {
                     "type": "string",
-                    "example": "2023-08-24T18:00:00Z"
+                    "example": "2023-08-09T14:55:00.465814Z"
                 },
-                "id": {
-                    "type": "string",
-                    "example": "e36492f7-5305-4333-9aea-05dcf3689693"
+                "deputies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.Deputy"
+                    }
                 },
-                "is_internal": {
-                    "type": "boolean",
-                    "example": true
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.Article"
+                    }
                 },
-                "legislative_bodies": {
+                "external_authors": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/swagger.LegislativeBody"
+                        "$ref": "#/definitions/swagger.ExternalAuthor"
                     }
                 },
-                "location": {
+                "id": {
                     "type": "string",
-                    "example": "Plenário da Câmara dos Deputados"
+                    "example": "9dc67bd9-674f-4e4d-9536-07485335c362"
+                },
+                "image_description": {
+                    "type": "string",
+                    "example": "A imagem retrata uma pequena cidade costeira afetada por uma forte chuva..."
+                },
+                "image_url": {
+                    "type": "string",
+                    "example": "https://www.vnc.com.br/news/proposition/image/87624.jpg"
                 },
                 "newsletter": {
                     "$ref": "#/definitions/swagger.Article"
                 },
                 "number_of_ratings": {
                     "type": "integer",
-                    "example": 2
+                    "example": 249
                 },
-                "requirements": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagger.Article"
-                    }
+                "original_text_mime_type": {
+                    "type": "string",
+                    "example": "https://www.camara.leg.br/proposicoesWeb/prop_mostrarintegra?codteor=4865485"
                 },
-                "situation": {
-                    "$ref": "#/definitions/swagger.EventSituation"
+                "original_text_url": {
+                    "type": "string",
+                    "example": "https://www.camara.leg.br/proposicoesWeb/prop_mostrarintegra?codteor=4865485"
                 },
-                "starts_at": {
+                "submitted_at": {
                     "type": "string",
-                    "example": "2023-08-24T13:00:00Z"
+                    "example": "2023-08-09T14:25:00Z"
                 },
                 "title": {
                     "type": "string",
-                    "example": "TCU Pede Ação Urgente contra Dívida Pública..."
+                    "example": "Requerimento de Votação Nominal-Destaque de Emenda"
                 },
                 "type": {
                     "$ref": "#/definitions/swagger.ArticleTypeWithSpecificType"
                 },
                 "updated_at": {
                     "type": "string",
-                    "example": "2023-08-22T10:39:14.465814Z"
+                    "example": "2023-08-09T14:55:00.465814Z"
                 },
                 "user_rating": {
                     "type": "integer",
-                    "example": 4
-                },
-                "video_url": {
-                    "type": "string",
-                    "example": "https://video.url.com/article/e36492f7-5305-4333-9aea-05dcf3689693/video.mp4"
+                    "example": 5
                 },
                 "view_later": {
                     "type": "boolean",
-                    "example": false
+                    "example": true
+                },
+                "votes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.Article"
+                    }
                 }
             }
         },
-        "swagger.EventSituation": {
+        "swagger.PropositionType": {
             "type": "object",
             "properties": {
                 "color": {
                     "type": "string",
-                    "example": "#0047AB"
+                    "example": "#C4170C"
                 },
                 "description": {
                     "type": "string",
-                    "example": "Encerrado"
+                    "example": "Projeto de Lei"
                 },
                 "id": {
                     "type": "string",
-                    "example": "0f7f6406-bbce-4a26-aa02-c1694bda5e72"
+                    "example": "111c1a6d-d061-40b2-ad39-ec714f05c81c"
                 }
             }
         },
-        "swagger.EventType": {
+        "swagger.PropositionTypeCount": {
             "type": "object",
             "properties": {
-                "color": {
-                    "type": "string",
-                    "example": "#006767"
-                },
-                "description": {
-                    "type": "string",
-                    "example": "Reunião Deliberativa"
+                "number_of_propositions": {
+                    "type": "integer",
+                    "example": 42
                 },
-                "id": {
-                    "type": "string",
-                    "example": "2371ffd1-67d0-40b2-b6ee-dde3948cbb80"
+                "proposition_type": {
+                    "$ref": "#/definitions/swagger.PropositionType"
                 }
             }
         },
-        "swagger.ExternalAuthor": {
+        "swagger.Rapporteurship": {
             "type": "object",
             "properties": {
-                "id": {
+                "event_article_id": {
                     "type": "string",
-                    "example": "9d543e6f-20e3-4895-83e4-26b6a976580e"
+                    "example": "9e2d6b1c-3a4f-4b8e-9d7c-1f2e3a4b5c6d"
                 },
-                "name": {
+                "event_starts_at": {
                     "type": "string",
-                    "example": "Organização Você na Câmara"
+                    "example": "2024-05-14T10:00:00Z"
                 },
-                "type": {
-                    "$ref": "#/definitions/swagger.ExternalAuthorType"
-                }
-            }
-        },
-        "swagger.ExternalAuthorType": {
-            "type": "object",
-            "properties": {
-                "description": {
+                "event_title": {
                     "type": "string",
-                    "example": "Órgão Do Poder Legislativo"
+                    "example": "Reunião Deliberativa Ordinária"
                 },
                 "id": {
                     "type": "string",
-                    "example": "344b3941-69fb-4715-a7e5-6afc21cd3e48"
-                }
-            }
-        },
-        "swagger.HttpError": {
-            "type": "object",
-            "properties": {
-                "message": {
+                    "example": "0a4b4d4a-5d7c-4e8f-8f60-3f6a2b7c9d1e"
+                },
+                "proposition_article_id": {
                     "type": "string",
-                    "example": "Something went wrong..."
-                }
-            }
-        },
-        "swagger.LegislativeBody": {
-            "type": "object",
-            "properties": {
-                "acronym": {
+                    "example": "5f8c4a4b-7d5e-4c51-9c3f-2b2d9b4e1a6f"
+                },
+                "proposition_title": {
                     "type": "string",
-                    "example": "2009"
+                    "example": "Projeto garante acesso aberto a dados públicos"
                 },
-                "id": {
+                "regime": {
                     "type": "string",
-                    "example": "31991060-fa3d-4647-aea5-b17b41de6a36"
+                    "example": "Urgência (Art. 155, RICD)"
                 },
-                "name": {
+                "situation": {
                     "type": "string",
-                    "example": "Comissão de Educação"
+                    "example": "Aprovado o Parecer."
                 },
-                "type": {
-                    "$ref": "#/definitions/swagger.LegislativeBodyType"
-                }
-            }
-        },
-        "swagger.LegislativeBodyType": {
-            "type": "object",
-            "properties": {
-                "description": {
+                "title": {
                     "type": "string",
-                    "example": "Comissão Mista Permanente"
+                    "example": "PL 1234/2024"
                 },
-                "id": {
+                "topic": {
                     "type": "string",
-                    "example": "3440489a-d787-447f-80dd-51c0c577f07f"
+                    "example": "Dispõe sobre a transparência de dados públicos."
                 }
             }
         },
-        "swagger.NewsletterArticle": {
+        "swagger.RapporteurshipPagination": {
             "type": "object",
             "properties": {
-                "average_rating": {
-                    "type": "number",
-                    "example": 4.5
-                },
-                "content": {
-                    "type": "string",
-                    "example": "O presidente enviou ao Congresso Nacional um projeto de lei que permite a concessão de descontos fiscais..."
-                },
-                "created_at": {
-                    "type": "string",
-                    "example": "2023-12-24T19:15:22.90905Z"
-                },
-                "events": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/swagger.Article"
+                        "$ref": "#/definitions/swagger.Rapporteurship"
                     }
                 },
-                "id": {
-                    "type": "string",
-                    "example": "7963a6dd-f0b8-4065-8e56-6d2a79626db7"
+                "items_per_page": {
+                    "type": "integer",
+                    "example": 15
                 },
-                "number_of_ratings": {
+                "page": {
                     "type": "integer",
-                    "example": 743
+                    "example": 1
                 },
-                "propositions": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagger.Article"
-                    }
+                "total": {
+                    "type": "integer",
+                    "example": 27
+                }
+            }
+        },
+        "swagger.ReadingList": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-05T20:25:19.98031Z"
                 },
-                "reference_date": {
+                "description": {
                     "type": "string",
-                    "example": "2023-12-23T16:34:14.441877Z"
+                    "example": "Proposições e votações sobre a reforma tributária"
                 },
-                "title": {
+                "id": {
                     "type": "string",
-                    "example": "Boletim do dia 26/02/2024"
+                    "example": "5b4f1ad2-2c3b-4bb6-8d3a-6a3f0f5d2c7e"
                 },
-                "type": {
-                    "$ref": "#/definitions/swagger.ArticleType"
+                "is_default": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "updated_at": {
+                "is_public": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
                     "type": "string",
-                    "example": "2023-12-24T19:15:22.90905Z"
+                    "example": "Reforma tributária"
                 },
-                "user_rating": {
+                "number_of_articles": {
                     "type": "integer",
-                    "example": 5
+                    "example": 12
                 },
-                "view_later": {
-                    "type": "boolean",
-                    "example": true
+                "share_url": {
+                    "type": "string",
+                    "example": "https://vocenacamara.com.br/reading-lists/shared/9f86d081884c7d659a2feaa0c55ad015"
                 },
-                "votes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagger.Article"
-                    }
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-05T20:25:19.98031Z"
                 }
             }
         },
-        "swagger.Party": {
+        "swagger.ReadingListItem": {
             "type": "object",
             "properties": {
-                "acronym": {
-                    "type": "string",
-                    "example": "PVNC"
-                },
-                "id": {
+                "added_at": {
                     "type": "string",
-                    "example": "9bb4028f-6fa8-493a-9fe8-e3bbd341c794"
+                    "example": "2024-01-05T20:25:19.98031Z"
                 },
-                "image_description": {
-                    "type": "string",
-                    "example": "Logo do Partido Você na Câmara (PVNC)"
+                "article": {
+                    "$ref": "#/definitions/swagger.Article"
                 },
-                "image_url": {
+                "note": {
                     "type": "string",
-                    "example": "https://www.camara.leg.br/internet/Deputado/img/partidos/VNC.gif"
+                    "example": "Rever antes da votação no plenário"
                 },
-                "name": {
-                    "type": "string",
-                    "example": "Partido Você na Câmara"
+                "position": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "swagger.PropositionArticle": {
+        "swagger.ReadingListItemPagination": {
             "type": "object",
             "properties": {
-                "average_rating": {
-                    "type": "number",
-                    "example": 2.5
-                },
-                "content": {
-                    "type": "string",
-                    "example": "O presente requerimento foi elaborado pelos deputados..."
-                },
-                "created_at": {
-                    "type": "string",
-                    "example": "2023-08-09T14:55:00.465814Z"
-                },
-                "deputies": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagger.Deputy"
-                    }
-                },
-                "events": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagger.Article"
-                    }
-                },
-                "external_authors": {
+                "data": {
                     "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagger.ExternalAuthor"
-                    }
-                },
-                "id": {
-                    "type": "string",
-                    "example": "9dc67bd9-674f-4e4d-9536-07485335c362"
-                },
-                "image_description": {
-                    "type": "string",
-                    "example": "A imagem retrata uma pequena cidade costeira afetada por uma forte chuva..."
+                    "items": {
+                        "$ref": "#/definitions/swagger.ReadingListItem"
+                    }
                 },
-                "image_url": {
-                    "type": "string",
-                    "example": "https://www.vnc.com.br/news/proposition/image/87624.jpg"
+                "items_per_page": {
+                    "type": "integer",
+                    "example": 15
                 },
-                "newsletter": {
-                    "$ref": "#/definitions/swagger.Article"
+                "page": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "number_of_ratings": {
+                "total": {
                     "type": "integer",
-                    "example": 249
+                    "example": 12
+                }
+            }
+        },
+        "swagger.ReadingListWithArticles": {
+            "type": "object",
+            "properties": {
+                "articles": {
+                    "$ref": "#/definitions/swagger.ReadingListItemPagination"
                 },
-                "original_text_mime_type": {
+                "created_at": {
                     "type": "string",
-                    "example": "https://www.camara.leg.br/proposicoesWeb/prop_mostrarintegra?codteor=4865485"
+                    "example": "2024-01-05T20:25:19.98031Z"
                 },
-                "original_text_url": {
+                "description": {
                     "type": "string",
-                    "example": "https://www.camara.leg.br/proposicoesWeb/prop_mostrarintegra?codteor=4865485"
+                    "example": "Proposições e votações sobre a reforma tributária"
                 },
-                "submitted_at": {
+                "id": {
                     "type": "string",
-                    "example": "2023-08-09T14:25:00Z"
+                    "example": "5b4f1ad2-2c3b-4bb6-8d3a-6a3f0f5d2c7e"
                 },
-                "title": {
-                    "type": "string",
-                    "example": "Requerimento de Votação Nominal-Destaque de Emenda"
+                "is_default": {
+                    "type": "boolean",
+                    "example": false
                 },
-                "type": {
-                    "$ref": "#/definitions/swagger.ArticleTypeWithSpecificType"
+                "is_public": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "updated_at": {
+                "name": {
                     "type": "string",
-                    "example": "2023-08-09T14:55:00.465814Z"
+                    "example": "Reforma tributária"
                 },
-                "user_rating": {
+                "number_of_articles": {
                     "type": "integer",
-                    "example": 5
+                    "example": 12
                 },
-                "view_later": {
-                    "type": "boolean",
-                    "example": true
+                "share_url": {
+                    "type": "string",
+                    "example": "https://vocenacamara.com.br/reading-lists/shared/9f86d081884c7d659a2feaa0c55ad015"
                 },
-                "votes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/swagger.Article"
-                    }
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-05T20:25:19.98031Z"
                 }
             }
         },
-        "swagger.PropositionType": {
+        "swagger.RecommendationReason": {
             "type": "object",
             "properties": {
-                "color": {
+                "article_id": {
                     "type": "string",
-                    "example": "#C4170C"
+                    "example": "e9b0f6b5-5c63-4b0e-9d43-1b2d3e2a8f1c"
+                },
+                "article_title": {
+                    "type": "string",
+                    "example": "Altera a Lei nº 9.503, de 23 de setembro de 1997"
                 },
                 "description": {
                     "type": "string",
-                    "example": "Projeto de Lei"
+                    "example": "Porque você leu \"Altera a Lei nº 9.503, de 23 de setembro de 1997\""
                 },
-                "id": {
+                "type": {
                     "type": "string",
-                    "example": "111c1a6d-d061-40b2-ad39-ec714f05c81c"
+                    "example": "similar_content"
+                }
+            }
+        },
+        "swagger.RecommendedArticle": {
+            "type": "object",
+            "properties": {
+                "article": {
+                    "$ref": "#/definitions/swagger.Article"
+                },
+                "reason": {
+                    "$ref": "#/definitions/swagger.RecommendationReason"
                 }
             }
         },
@@ -2377,6 +7434,123 @@ const docTemplate = `{
                 }
             }
         },
+        "swagger.SavedSearch": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2024-01-05T20:25:19.98031Z"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "0f3e8a5c-6b1d-4c5e-9a7f-2d8b4e6c1a93"
+                },
+                "last_opened_at": {
+                    "type": "string",
+                    "example": "2024-01-05T20:25:19.98031Z"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Reforma tributária aprovada"
+                },
+                "notify_by_email": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "number_of_new_articles": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "query": {
+                    "type": "string",
+                    "example": "content=reforma+tribut%C3%A1ria\u0026votingResult=approved"
+                },
+                "updated_at": {
+                    "type": "string",
+                    "example": "2024-01-05T20:25:19.98031Z"
+                }
+            }
+        },
+        "swagger.Suggestion": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "PVNC-AL"
+                },
+                "filter_parameters": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "propositionDeputyId",
+                        "eventRapporteurId"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "a4b04454-f426-44d2-843e-1331510b19ad"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "José do Povo"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "deputy"
+                }
+            }
+        },
+        "swagger.TimelineItem": {
+            "type": "object",
+            "properties": {
+                "agenda_item_regime": {
+                    "type": "string",
+                    "example": "Urgência (Art. 155, RICD)"
+                },
+                "agenda_item_situation": {
+                    "type": "string",
+                    "example": "Aprovada"
+                },
+                "article_id": {
+                    "type": "string",
+                    "example": "5f8c4a4b-7d5e-4c51-9c3f-2b2d9b4e1a6f"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2024-01-05T20:25:19.98031Z"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Aprovado o Requerimento de Urgência."
+                },
+                "event_situation": {
+                    "type": "string",
+                    "example": "Encerrada"
+                },
+                "legislative_body": {
+                    "type": "string",
+                    "example": "PLEN"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Votação 2438608-41"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "voting"
+                },
+                "voting_is_approved": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "voting_result": {
+                    "type": "string",
+                    "example": "Aprovado o Requerimento."
+                }
+            }
+        },
         "swagger.User": {
             "type": "object",
             "properties": {
@@ -2471,6 +7645,12 @@ const docTemplate = `{
                     "type": "integer",
                     "example": 254
                 },
+                "party_orientations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.PartyOrientation"
+                    }
+                },
                 "related_propositions": {
                     "type": "array",
                     "items": {
@@ -2503,6 +7683,136 @@ const docTemplate = `{
                 "view_later": {
                     "type": "boolean",
                     "example": true
+                },
+                "votes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.DeputyVote"
+                    }
+                }
+            }
+        },
+        "swagger.VotingOutcome": {
+            "type": "object",
+            "properties": {
+                "legislative_body": {
+                    "$ref": "#/definitions/swagger.LegislativeBody"
+                },
+                "month": {
+                    "type": "string",
+                    "example": "2024-05"
+                },
+                "number_of_approved_votes": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "number_of_rejected_votes": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "number_of_undetermined_votes": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "number_of_votes": {
+                    "type": "integer",
+                    "example": 16
+                },
+                "proposition_type": {
+                    "$ref": "#/definitions/swagger.PropositionType"
+                }
+            }
+        },
+        "swagger.VotingRecord": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "3c1e2b7a-8d4f-4a6e-9b2c-5d7e8f9a0b1c"
+                },
+                "legislative_body": {
+                    "$ref": "#/definitions/swagger.LegislativeBody"
+                },
+                "party": {
+                    "$ref": "#/definitions/swagger.Party"
+                },
+                "party_orientation": {
+                    "type": "string",
+                    "example": "free"
+                },
+                "vote": {
+                    "type": "string",
+                    "example": "yes"
+                },
+                "voting_article_id": {
+                    "type": "string",
+                    "example": "d369b9bc-c226-4bbf-8fbb-fceed205845a"
+                },
+                "voting_is_approved": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "voting_result": {
+                    "type": "string",
+                    "example": "Aprovado o Substitutivo ao Projeto de Lei nº 1..."
+                },
+                "voting_result_announced_at": {
+                    "type": "string",
+                    "example": "2023-05-18T20:17:32Z"
+                },
+                "voting_title": {
+                    "type": "string",
+                    "example": "Votação 3457539-42"
+                }
+            }
+        },
+        "swagger.VotingRecordPagination": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.VotingRecord"
+                    }
+                },
+                "items_per_page": {
+                    "type": "integer",
+                    "example": 15
+                },
+                "page": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 312
+                }
+            }
+        },
+        "swagger.VotingStatistics": {
+            "type": "object",
+            "properties": {
+                "number_of_approved_votes": {
+                    "type": "integer",
+                    "example": 842
+                },
+                "number_of_rejected_votes": {
+                    "type": "integer",
+                    "example": 213
+                },
+                "number_of_undetermined_votes": {
+                    "type": "integer",
+                    "example": 57
+                },
+                "number_of_votes": {
+                    "type": "integer",
+                    "example": 1112
+                },
+                "outcomes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/swagger.VotingOutcome"
+                    }
                 }
             }
         }